@@ -4,11 +4,22 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/adamdecaf/rdap/pkg/cmd"
+	"github.com/adamdecaf/rdap/pkg/cmd/autnum"
 	"github.com/adamdecaf/rdap/pkg/cmd/domain"
+	"github.com/adamdecaf/rdap/pkg/cmd/entity"
+	"github.com/adamdecaf/rdap/pkg/cmd/help"
+	"github.com/adamdecaf/rdap/pkg/cmd/ip"
+	"github.com/adamdecaf/rdap/pkg/cmd/nameserver"
+	"github.com/adamdecaf/rdap/pkg/cmd/query"
+	"github.com/adamdecaf/rdap/pkg/cmd/search"
 )
 
 const Version = "0.1.0-dev"
@@ -18,41 +29,149 @@ const Version = "0.1.0-dev"
 type command struct {
 	// args is the os.Args after subcommand
 	fn func(args []string) error
-	help string
+
+	// usage is the command's arguments, e.g. "<domain>"
+	usage    string
+	help     string
+	examples []string
+
+	// details, if set, is printed by 'rdap help <command>' in place of
+	// the generated usage line.
+	details string
 }
 
 var (
-	flagInsecure = flag.Bool("insecure", false, "Disable security checks on remote servers (i.e. TLS verification)")
+	flagInsecure  = flag.Bool("insecure", false, "Disable security checks on remote servers (i.e. TLS verification)")
+	flagTrace     = flag.Bool("trace", false, "Print a waterfall of time spent in each phase of a lookup")
+	flagRegistrar = flag.Bool("registrar", false, "Merge the registrar's domain response with the registry's")
 )
 
 func main() {
+	commands := make(map[string]*command, 0)
+	flag.Usage = func() {
+		usage(os.Stderr, commands)
+	}
 	flag.Parse()
 
 	cfg := &cmd.Config{
-		Debug: true,
+		Debug:              true,
 		InsecureSkipVerify: *flagInsecure,
+		Trace:              *flagTrace,
+		Registrar:          *flagRegistrar,
 	}
 
-	commands := make(map[string]*command, 0)
 	commands["domain"] = &command{
+		fn:    lookup("domain", cfg, domain.PrintDetails),
+		usage: "<domain>",
+		help:  "Look up a domain name, bootstrapped by its TLD. IDNs are accepted in either form.",
+		examples: []string{
+			"rdap domain example.com",
+			"rdap -registrar domain google.com",
+		},
+	}
+	commands["ip"] = &command{
+		fn:    lookup("IP address or CIDR prefix", cfg, ip.PrintDetails),
+		usage: "<address|cidr>",
+		help:  "Look up the IP network containing an IPv4 or IPv6 address or CIDR prefix.",
+		examples: []string{
+			"rdap ip 8.8.8.8",
+			"rdap ip 2001:db8::/32",
+		},
+	}
+	commands["autnum"] = &command{
+		fn:    lookup("AS number", cfg, autnum.PrintDetails),
+		usage: "<asn>",
+		help:  "Look up an autonomous system number, with or without an AS prefix.",
+		examples: []string{
+			"rdap autnum AS15169",
+			"rdap autnum 15169",
+		},
+	}
+	commands["nameserver"] = &command{
+		fn:    lookup("nameserver", cfg, nameserver.PrintDetails),
+		usage: "<host>",
+		help:  "Look up a nameserver by its host name, bootstrapped by its TLD.",
+		examples: []string{
+			"rdap nameserver ns1.google.com",
+		},
+	}
+	commands["entity"] = &command{
+		fn:    lookup("entity handle", cfg, entity.PrintDetails),
+		usage: "<handle>",
+		help:  "Look up an entity (contact, registrant, registrar, etc) by a handle with an object tag.",
+		examples: []string{
+			"rdap entity GOGL-ARIN",
+		},
+	}
+	commands["query"] = &command{
+		fn:    lookup("query", cfg, query.PrintDetails),
+		usage: "<anything>",
+		help:  "Detect whether the argument is an IP, CIDR, AS number, domain, IDN, nameserver or entity handle and look it up.",
+		examples: []string{
+			"rdap query 8.8.8.8",
+			"rdap query AS15169",
+			"rdap query ns1.google.com",
+		},
+	}
+	commands["search"] = &command{
 		fn: func(args []string) error {
+			return search.Run(cfg, args)
+		},
+		usage: "<domains|nameservers|entities> [flags]",
+		help:  "Search for domains, nameservers or entities by pattern, printing the results as a table.",
+		examples: []string{
+			"rdap search domains --name 'exam*.com'",
+			"rdap search nameservers --ip 192.0.2.1 --server https://rdap.example.com/",
+			"rdap search entities --fn 'Joe*' --server https://rdap.arin.net/registry/",
+		},
+		details: search.Usage,
+	}
+	commands["help"] = &command{
+		fn: func(args []string) error {
+			fs := flag.NewFlagSet("help", flag.ContinueOnError)
+			fs.SetOutput(ioutil.Discard)
+			server := fs.String("server", "", "")
+			if err := fs.Parse(args); err != nil {
+				return err
+			}
+			if *server != "" {
+				if fs.NArg() > 0 {
+					return errors.New("a command can't be given with --server")
+				}
+				return help.PrintNotices(cfg, *server)
+			}
+			args = fs.Args()
 			if len(args) == 0 {
-				return errors.New("no domain specified")
+				usage(os.Stdout, commands)
+				return nil
 			}
-			return domain.PrintDetails(cfg, args[0])
+			name := strings.ToLower(args[0])
+			c, ok := commands[name]
+			if !ok {
+				return fmt.Errorf("command %s not found", name)
+			}
+			commandUsage(os.Stdout, name, c)
+			return nil
+		},
+		usage: "[command] | --server <url>",
+		help:  "Show usage for every command, the details of one, or a server's help notices.",
+		examples: []string{
+			"rdap help ip",
+			"rdap help --server https://rdap.arin.net/registry/",
 		},
-		help: "wat",
 	}
 	commands["version"] = &command{
 		fn: func(_ []string) error {
 			fmt.Println(Version)
 			return nil
 		},
+		help: "Print the version of rdap.",
 	}
 
 	args := flag.CommandLine.Args()
-	if len(args) == 1 {
-		fmt.Println("ERROR: You must specify a command")
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "ERROR: You must specify a command")
+		usage(os.Stderr, commands)
 		os.Exit(1)
 	}
 	raw := strings.ToLower(args[0])
@@ -63,7 +182,8 @@ func main() {
 			os.Exit(1)
 		}
 	} else {
-		fmt.Printf("command %s not found\n", raw)
+		fmt.Fprintf(os.Stderr, "command %s not found\n", raw)
+		usage(os.Stderr, commands)
 		os.Exit(1)
 	}
 	os.Exit(0)
@@ -85,6 +205,72 @@ func main() {
 	// and names of organizations and individuals.
 }
 
+// lookup returns a command fn which passes its one argument to
+// printDetails. what names the argument in errors.
+func lookup(what string, cfg *cmd.Config, printDetails func(*cmd.Config, string) error) func([]string) error {
+	return func(args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("no %s specified", what)
+		}
+		if len(args) > 1 {
+			return errors.New("only one argument can be given")
+		}
+		return printDetails(cfg, args[0])
+	}
+}
+
+// usage prints every command and global flag.
+func usage(w io.Writer, commands map[string]*command) {
+	fmt.Fprintln(w, "Usage: rdap [flags] <command> [args]")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		c := commands[name]
+		fmt.Fprintf(tw, "  %s\t%s\n", strings.TrimSpace(name+" "+c.usage), c.help)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Flags:")
+	flag.CommandLine.SetOutput(w)
+	flag.PrintDefaults()
+
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Examples:")
+	for _, name := range names {
+		for _, ex := range commands[name].examples {
+			fmt.Fprintf(w, "  %s\n", ex)
+		}
+	}
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Run 'rdap help <command>' for more about a command.")
+}
+
+// commandUsage prints the details of one command.
+func commandUsage(w io.Writer, name string, c *command) {
+	if c.details != "" {
+		fmt.Fprintln(w, c.details)
+	} else {
+		fmt.Fprintf(w, "Usage: rdap [flags] %s\n", strings.TrimSpace(name+" "+c.usage))
+	}
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, c.help)
+	if len(c.examples) > 0 {
+		fmt.Fprintln(w, "")
+		fmt.Fprintln(w, "Examples:")
+		for _, ex := range c.examples {
+			fmt.Fprintf(w, "  %s\n", ex)
+		}
+	}
+}
+
 // RFC 7482 Section
 // "Servers MUST return an HTTP 501 (Not Implemented) [RFC7231] response to
 // inform clients ofunsupported query types."
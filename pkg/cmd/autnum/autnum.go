@@ -0,0 +1,32 @@
+package autnum
+
+import (
+	"fmt"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+)
+
+// PrintDetails looks up an autonomous system number, with or without an
+// "AS" prefix.
+func PrintDetails(cfg *cmd.Config, asn string) error {
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	server, err := boot.ForASNumber(asn)
+	if err != nil {
+		return fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return fmt.Errorf("no server found for %s", asn)
+	}
+	client := cmd.Client(cfg, server, tracer)
+
+	resp, err := client.Autnum(asn)
+	if err != nil {
+		return fmt.Errorf("grabbing %s: %v", asn, err)
+	}
+	if resp != nil {
+		fmt.Println(resp)
+	}
+	return nil
+}
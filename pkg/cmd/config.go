@@ -1,7 +1,55 @@
 package cmd
 
+import (
+	"os"
+
+	"github.com/adamdecaf/rdap/pkg/httputil"
+	"github.com/adamdecaf/rdap/pkg/rdap"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+	"github.com/adamdecaf/rdap/pkg/trace"
+)
+
 type Config struct {
 	Debug bool
 
 	InsecureSkipVerify bool
+
+	// Trace prints a waterfall of each lookup's phases (bootstrap,
+	// connection setup, redirects, reading and decoding) once it's done.
+	Trace bool
+
+	// Registrar also fetches the registrar's response for domains and
+	// merges it with the registry's.
+	Registrar bool
+}
+
+// Setup prepares a lookup, returning the bootstrap Registry and Tracer to
+// use for it. done must be called once the lookup is finished, which
+// prints the trace (if enabled).
+func Setup(cfg *Config) (boot *bootstrap.Registry, tracer trace.Tracer, done func()) {
+	boot = &bootstrap.Registry{}
+	done = func() {}
+	if cfg.Trace {
+		rec := &trace.Recorder{}
+		tracer = rec
+		boot.Tracer = rec
+		done = func() { rec.Waterfall(os.Stdout) }
+	}
+	if cfg.InsecureSkipVerify {
+		transport := httputil.Transport(&httputil.Config{
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+		})
+		bootstrap.DefaultHTTPClient.Transport = transport
+		rdap.DefaultHTTPClient.Transport = transport
+	}
+	return boot, tracer, done
+}
+
+// Client returns an rdap.Client for server.
+func Client(cfg *Config, server string, tracer trace.Tracer) *rdap.Client {
+	return &rdap.Client{
+		BaseAddress: server,
+		Debug:       cfg.Debug,
+		Tracer:      tracer,
+	}
 }
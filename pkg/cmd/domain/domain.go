@@ -4,18 +4,11 @@ import (
 	"fmt"
 
 	"github.com/adamdecaf/rdap/pkg/cmd"
-	"github.com/adamdecaf/rdap/pkg/httputil"
-	"github.com/adamdecaf/rdap/pkg/rdap"
-	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
 )
 
 func PrintDetails(cfg *cmd.Config, d string) error {
-	boot := bootstrap.Registry{}
-	if cfg.InsecureSkipVerify {
-		bootstrap.DefaultHTTPClient.Transport = httputil.Transport(&httputil.Config{
-			InsecureSkipVerify: cfg.InsecureSkipVerify,
-		})
-	}
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
 
 	server, err := boot.ForDomain(d)
 	if err != nil {
@@ -24,15 +17,17 @@ func PrintDetails(cfg *cmd.Config, d string) error {
 	if server == "" {
 		return fmt.Errorf("no server found for %s", d)
 	}
+	client := cmd.Client(cfg, server, tracer)
 
-	client := rdap.Client{
-		BaseAddress: server,
-		Debug: cfg.Debug,
-	}
-	if cfg.InsecureSkipVerify {
-		rdap.DefaultHTTPClient.Transport = httputil.Transport(&httputil.Config{
-			InsecureSkipVerify: cfg.InsecureSkipVerify,
-		})
+	if cfg.Registrar {
+		merged, err := client.DomainWithRegistrar(d)
+		if merged != nil {
+			fmt.Println(merged)
+		}
+		if err != nil {
+			return fmt.Errorf("grabbing %s: %v", d, err)
+		}
+		return nil
 	}
 
 	resp, err := client.Domain(d)
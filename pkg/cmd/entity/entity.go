@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+)
+
+// PrintDetails looks up an entity by its handle. Only handles with an
+// RFC8521 object tag (e.g. "XXXX-ARIN") can be bootstrapped.
+func PrintDetails(cfg *cmd.Config, handle string) error {
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	server, err := boot.ForEntity(handle)
+	if err != nil {
+		return fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return fmt.Errorf("no server found for %s, entity handles need an object tag (e.g. XXXX-ARIN)", handle)
+	}
+	client := cmd.Client(cfg, server, tracer)
+
+	resp, err := client.Entity(handle)
+	if err != nil {
+		return fmt.Errorf("grabbing %s: %v", handle, err)
+	}
+	if resp != nil {
+		fmt.Println(resp)
+	}
+	return nil
+}
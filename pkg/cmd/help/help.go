@@ -0,0 +1,56 @@
+package help
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+	"github.com/adamdecaf/rdap/pkg/rdap"
+)
+
+// PrintNotices requests server's /help and prints the notices it returns,
+// such as its terms of service or rate limits.
+func PrintNotices(cfg *cmd.Config, server string) error {
+	_, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	client := cmd.Client(cfg, server, tracer)
+	resp, err := client.Help()
+	if err != nil {
+		return fmt.Errorf("grabbing help from %s: %v", server, err)
+	}
+	printNotices(os.Stdout, resp)
+	return nil
+}
+
+// printNotices writes each notice's title, description and links.
+func printNotices(w io.Writer, h *rdap.Help) {
+	if len(h.Notices) == 0 {
+		fmt.Fprintln(w, "No notices returned")
+		return
+	}
+	for i, n := range h.Notices {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+		title := n.Title
+		if title == "" {
+			title = "Notice"
+		}
+		fmt.Fprintln(w, title)
+		for _, line := range n.Description {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+		for _, link := range n.Links {
+			if link.Href == nil {
+				continue
+			}
+			if link.Rel != "" {
+				fmt.Fprintf(w, "  %s (%s)\n", link.Href, link.Rel)
+			} else {
+				fmt.Fprintf(w, "  %s\n", link.Href)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package help
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/rdap"
+)
+
+func TestHelp__notices(t *testing.T) {
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"rdapConformance":["rdap_level_0"],"notices":[
+{"title":"Terms of Service","description":["Service subject to terms of use."],"links":[{"value":"https://rdap.example/help","rel":"terms-of-service","href":"https://rdap.example/terms"}]},
+{"description":["Queries are limited to 10 per second."]}]}`))
+	}))
+	defer svc.Close()
+
+	client := &rdap.Client{Underlying: svc.Client(), BaseAddress: svc.URL}
+	resp, err := client.Help()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	printNotices(&buf, resp)
+	expected := `Terms of Service
+  Service subject to terms of use.
+  https://rdap.example/terms (terms-of-service)
+
+Notice
+  Queries are limited to 10 per second.
+`
+	if buf.String() != expected {
+		t.Errorf("got\n%s", buf.String())
+	}
+
+	buf.Reset()
+	printNotices(&buf, &rdap.Help{})
+	if buf.String() != "No notices returned\n" {
+		t.Errorf("got %q", buf.String())
+	}
+}
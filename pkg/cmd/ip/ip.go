@@ -0,0 +1,31 @@
+package ip
+
+import (
+	"fmt"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+)
+
+// PrintDetails looks up the IP network for an address or CIDR prefix.
+func PrintDetails(cfg *cmd.Config, addr string) error {
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	server, err := boot.ForIPNetwork(addr)
+	if err != nil {
+		return fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return fmt.Errorf("no server found for %s", addr)
+	}
+	client := cmd.Client(cfg, server, tracer)
+
+	resp, err := client.IP(addr)
+	if err != nil {
+		return fmt.Errorf("grabbing %s: %v", addr, err)
+	}
+	if resp != nil {
+		fmt.Println(resp)
+	}
+	return nil
+}
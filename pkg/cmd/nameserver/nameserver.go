@@ -0,0 +1,33 @@
+package nameserver
+
+import (
+	"fmt"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+)
+
+// PrintDetails looks up a nameserver by its host name. The server is
+// bootstrapped from the host name's domain, as nameservers are registered
+// with the registry of the zone they're in.
+func PrintDetails(cfg *cmd.Config, host string) error {
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	server, err := boot.ForDomain(host)
+	if err != nil {
+		return fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return fmt.Errorf("no server found for %s", host)
+	}
+	client := cmd.Client(cfg, server, tracer)
+
+	resp, err := client.Nameserver(host)
+	if err != nil {
+		return fmt.Errorf("grabbing %s: %v", host, err)
+	}
+	if resp != nil {
+		fmt.Println(resp)
+	}
+	return nil
+}
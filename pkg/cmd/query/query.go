@@ -0,0 +1,209 @@
+package query
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+	"github.com/adamdecaf/rdap/pkg/cmd/autnum"
+	"github.com/adamdecaf/rdap/pkg/cmd/entity"
+	"github.com/adamdecaf/rdap/pkg/cmd/ip"
+	"github.com/adamdecaf/rdap/pkg/idna"
+	"github.com/adamdecaf/rdap/pkg/rdap"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+// Type is the kind of lookup a query was detected as.
+type Type string
+
+const (
+	IP         Type = "ip"
+	CIDR       Type = "cidr"
+	Autnum     Type = "autnum"
+	Domain     Type = "domain"
+	IDN        Type = "idn"
+	Nameserver Type = "nameserver"
+	Entity     Type = "entity"
+)
+
+// nameserverLabels are leftmost labels which are taken to mean a host
+// name is a nameserver rather than a domain, optionally followed by
+// digits or a hyphen and anything (e.g. ns1.example.com or
+// ns-123.awsdns-45.com).
+var nameserverLabels = []string{"ns", "dns", "nameserver", "pdns", "sdns"}
+
+// secondLevels are labels commonly registered under by country code TLDs
+// (e.g. co.uk), so a name directly under one is a domain.
+var secondLevels = map[string]bool{
+	"ac": true, "co": true, "com": true, "edu": true, "go": true, "gov": true,
+	"ltd": true, "me": true, "mil": true, "ne": true, "net": true, "or": true,
+	"org": true, "plc": true,
+}
+
+// Detect works out which lookup q is for, returning the type along with
+// a short reason for choosing it.
+func Detect(q string) (Type, string, error) {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return "", "", fmt.Errorf("empty query")
+	}
+
+	if strings.Contains(q, "/") {
+		if _, n, err := net.ParseCIDR(q); err == nil {
+			if n.IP.To4() != nil {
+				return CIDR, "parses as an IPv4 CIDR prefix", nil
+			}
+			return CIDR, "parses as an IPv6 CIDR prefix", nil
+		}
+		return "", "", fmt.Errorf("%q contains a / but isn't a CIDR prefix", q)
+	}
+	if addr := net.ParseIP(q); addr != nil {
+		if addr.To4() != nil {
+			return IP, "parses as an IPv4 address", nil
+		}
+		return IP, "parses as an IPv6 address", nil
+	}
+
+	if n, err := bootstrap.ParseASN(q); err == nil {
+		if len(q) > 2 && strings.EqualFold(q[:2], "as") {
+			return Autnum, fmt.Sprintf("AS prefix followed by the number %d", n), nil
+		}
+		return Autnum, "a bare number, taken as an asplain AS number", nil
+	}
+
+	// Domains and nameservers have at least two labels, while entity
+	// handles don't have dots and end in an object tag.
+	if !strings.Contains(strings.Trim(q, "."), ".") {
+		if tag := bootstrap.ObjectTag(q); tag != "" {
+			return Entity, fmt.Sprintf("no dots and ends in the object tag %q", tag), nil
+		}
+		return "", "", fmt.Errorf("can't tell what %q is: it isn't an IP, AS number, domain name or entity handle with an object tag", q)
+	}
+
+	name, err := idna.ToASCII(q)
+	if err != nil {
+		return "", "", fmt.Errorf("can't tell what %q is: %v", q, err)
+	}
+	labels := strings.SplitN(strings.Trim(name, "."), ".", 2)
+	if isNameserverLabel(labels[0]) && !isPublicSuffix(labels[1]) {
+		return Nameserver, fmt.Sprintf("host name whose first label %q looks like a nameserver", labels[0]), nil
+	}
+	if idna.IsIDN(name) {
+		if name != strings.ToLower(strings.Trim(q, ".")) {
+			return IDN, fmt.Sprintf("internationalized domain name, queried as %s", name), nil
+		}
+		return IDN, "internationalized domain name in A-label form", nil
+	}
+	return Domain, "a domain name", nil
+}
+
+func isNameserverLabel(label string) bool {
+	for _, prefix := range nameserverLabels {
+		if !strings.HasPrefix(label, prefix) {
+			continue
+		}
+		rest := label[len(prefix):]
+		if strings.Trim(rest, "0123456789") == "" || strings.HasPrefix(rest, "-") {
+			return true
+		}
+	}
+	return false
+}
+
+// isPublicSuffix guesses whether name is a zone open to registrations,
+// such as a TLD or co.uk, without a copy of the Public Suffix List.
+func isPublicSuffix(name string) bool {
+	labels := strings.Split(name, ".")
+	switch len(labels) {
+	case 1:
+		return true
+	case 2:
+		return len(labels[1]) == 2 && secondLevels[labels[0]]
+	}
+	return false
+}
+
+// PrintDetails detects what q is, prints the choice and then looks it up
+// as the matching command would.
+func PrintDetails(cfg *cmd.Config, q string) error {
+	typ, reason, err := Detect(q)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Query type: %s (%s)\n", typ, reason)
+
+	q = strings.TrimSpace(q)
+	switch typ {
+	case IP, CIDR:
+		return ip.PrintDetails(cfg, q)
+	case Autnum:
+		return autnum.PrintDetails(cfg, q)
+	case Domain, IDN, Nameserver:
+		return lookupHost(cfg, q, typ)
+	case Entity:
+		return entity.PrintDetails(cfg, q)
+	}
+	return fmt.Errorf("unknown query type %q", typ)
+}
+
+// lookupHost looks up a domain or nameserver. Telling them apart is a
+// guess, so when the server has no such object it's tried as the other.
+func lookupHost(cfg *cmd.Config, q string, typ Type) error {
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	server, err := boot.ForDomain(q)
+	if err != nil {
+		return fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return fmt.Errorf("no server found for %s", q)
+	}
+	return printHost(os.Stdout, cfg, cmd.Client(cfg, server, tracer), q, typ)
+}
+
+func printHost(w io.Writer, cfg *cmd.Config, client *rdap.Client, q string, typ Type) error {
+	resp, err := getHost(cfg, client, q, typ)
+	if rdap.IsNotFound(err) {
+		tried, other := "domain", Nameserver
+		if typ == Nameserver {
+			tried, other = "nameserver", Domain
+		}
+		fmt.Fprintf(w, "Query type: %s (no %s %s was found, retrying as a %s)\n", other, tried, q, other)
+		resp, err = getHost(cfg, client, q, other)
+	}
+	if resp != nil {
+		fmt.Fprintln(w, resp)
+	}
+	if err != nil {
+		return fmt.Errorf("grabbing %s: %v", q, err)
+	}
+	return nil
+}
+
+// getHost looks up q as a nameserver or domain, returning a nil interface
+// when there's no response.
+func getHost(cfg *cmd.Config, client *rdap.Client, q string, typ Type) (interface{}, error) {
+	if typ == Nameserver {
+		ns, err := client.Nameserver(q)
+		if ns == nil {
+			return nil, err
+		}
+		return ns, err
+	}
+	if cfg.Registrar {
+		merged, err := client.DomainWithRegistrar(q)
+		if merged == nil {
+			return nil, err
+		}
+		return merged, err
+	}
+	d, err := client.Domain(q)
+	if d == nil {
+		return nil, err
+	}
+	return d, err
+}
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+	"github.com/adamdecaf/rdap/pkg/rdap"
+)
+
+func TestDetect(t *testing.T) {
+	cases := map[string]Type{
+		"8.8.8.8":                       IP,
+		"2001:db8::1":                   IP,
+		"192.0.2.0/24":                  CIDR,
+		"2001:db8::/32":                 CIDR,
+		"AS15169":                       Autnum,
+		"as15169":                       Autnum,
+		"15169":                         Autnum,
+		"5":                             Autnum,
+		"example.com":                   Domain,
+		"EXAMPLE.COM.":                  Domain,
+		"ns.example":                    Domain,
+		"nsone.example.com":             Domain,
+		"bücher.example":                IDN,
+		"xn--bcher-kva.example":         IDN,
+		"ns1.example.com":               Nameserver,
+		"DNS2.example.net":              Nameserver,
+		"ns1.bücher.example":            Nameserver,
+		"ns-123.awsdns-45.com":          Nameserver,
+		"ns-cloud-a1.googledomains.com": Nameserver,
+		"ns1.example.co.uk":             Nameserver,
+		"ns1.co.uk":                     Domain,
+		"dns.com.br":                    Domain,
+		"GOGL-ARIN":                     Entity,
+		"XXXX-YYYY-RIPE":                Entity,
+	}
+	for q, expected := range cases {
+		typ, reason, err := Detect(q)
+		if err != nil {
+			t.Errorf("%s: %v", q, err)
+			continue
+		}
+		if typ != expected {
+			t.Errorf("%s: got %s (%s), expected %s", q, typ, reason, expected)
+		}
+		if reason == "" {
+			t.Errorf("%s: no reason given", q)
+		}
+	}
+
+	for _, q := range []string{"", "  ", "localhost", "GOGL-", "192.0.2.0/33", "a/b", "-bad-.com", "AS"} {
+		if typ, _, err := Detect(q); err == nil {
+			t.Errorf("%q: expected error, got %s", q, typ)
+		}
+	}
+}
+
+func TestPrintHost__retry(t *testing.T) {
+	var paths []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/domain/ns1.example.com":
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"ns1.example.com"}`))
+		case "/nameserver/example.com":
+			w.Write([]byte(`{"objectClassName":"nameserver","ldhName":"example.com"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer svc.Close()
+	client := &rdap.Client{Underlying: svc.Client(), BaseAddress: svc.URL}
+
+	var buf bytes.Buffer
+	if err := printHost(&buf, &cmd.Config{}, client, "ns1.example.com", Nameserver); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "Query type: domain (no nameserver ns1.example.com was found, retrying as a domain)\n") {
+		t.Errorf("got %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := printHost(&buf, &cmd.Config{}, client, "example.com", Domain); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "Query type: nameserver (no domain example.com was found, retrying as a nameserver)\n") {
+		t.Errorf("got %q", buf.String())
+	}
+
+	// Missing as both is an error
+	buf.Reset()
+	if err := printHost(&buf, &cmd.Config{}, client, "missing.example", Domain); err == nil {
+		t.Error("expected error")
+	}
+	if len(paths) != 6 {
+		t.Errorf("got requests %v", paths)
+	}
+}
@@ -0,0 +1,311 @@
+package search
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/adamdecaf/rdap/pkg/cmd"
+	"github.com/adamdecaf/rdap/pkg/rdap"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+// DefaultMaxPages is how many pages of results are read when --max-pages
+// isn't given.
+var DefaultMaxPages = 10
+
+// Usage lists the searches and their flags.
+const Usage = `Usage: rdap [flags] search <domains|nameservers|entities> [search flags]
+
+  domains      --name <pattern> | --ns-ldh-name <pattern> | --ns-ip <address>
+  nameservers  --name <pattern> | --ip <address>
+  entities     --fn <pattern> | --handle <pattern>
+
+Every search also accepts:
+  --server <url>     RDAP server to search, required when it can't be
+                     bootstrapped (e.g. searches by IP address or entity name)
+  --max-pages <n>    Pages of results to read, 0 for every page (default 10)
+
+Patterns can end a label with * to match partially (e.g. exam*.com).`
+
+// search is one kind of search, e.g. for domains.
+type search struct {
+	// flags maps each flag to the RDAP query parameter it searches by.
+	flags map[string]string
+
+	// bootstrap finds the server for a search when --server isn't given.
+	bootstrap func(boot *bootstrap.Registry, by, pattern string) (string, error)
+
+	// run reads up to maxPages of results into t.
+	run func(client *rdap.Client, by, pattern string, maxPages int, t *table) error
+}
+
+var searches = map[string]search{
+	"domains": {
+		flags:     map[string]string{"name": "name", "ns-ldh-name": "nsLdhName", "ns-ip": "nsIp"},
+		bootstrap: byTLD("name", "nsLdhName"),
+		run:       domains,
+	},
+	"nameservers": {
+		flags:     map[string]string{"name": "name", "ip": "ip"},
+		bootstrap: byTLD("name"),
+		run:       nameservers,
+	},
+	"entities": {
+		flags:     map[string]string{"fn": "fn", "handle": "handle"},
+		bootstrap: byObjectTag,
+		run:       entities,
+	},
+}
+
+// Run runs the search named by args[0] (e.g. "domains") with the flags
+// which follow it, printing the results as a table.
+func Run(cfg *cmd.Config, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no search specified\n\n%s", Usage)
+	}
+	name := strings.ToLower(args[0])
+	s, ok := searches[name]
+	if !ok {
+		return fmt.Errorf("unknown search %q\n\n%s", args[0], Usage)
+	}
+
+	fs := flag.NewFlagSet("search "+name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	values := make(map[string]*string, len(s.flags))
+	for f := range s.flags {
+		values[f] = fs.String(f, "", "")
+	}
+	server := fs.String("server", "", "")
+	maxPages := fs.Int("max-pages", DefaultMaxPages, "")
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%v\n\n%s", err, Usage)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	var by, pattern string
+	for f, v := range values {
+		if *v == "" {
+			continue
+		}
+		if by != "" {
+			return fmt.Errorf("only one of %s can be given", s.flagNames())
+		}
+		by, pattern = s.flags[f], *v
+	}
+	if by == "" {
+		return fmt.Errorf("one of %s is required", s.flagNames())
+	}
+
+	boot, tracer, done := cmd.Setup(cfg)
+	defer done()
+
+	if *server == "" {
+		found, err := s.bootstrap(boot, by, pattern)
+		if err != nil {
+			return err
+		}
+		*server = found
+	}
+	client := cmd.Client(cfg, *server, tracer)
+	client.Debug = false // the raw responses would bury the table
+
+	t := newTable(os.Stdout)
+	err := s.run(client, by, pattern, *maxPages, t)
+	t.flush()
+	if err != nil {
+		return fmt.Errorf("searching %s on %s: %v", name, *server, err)
+	}
+	return nil
+}
+
+func (s search) flagNames() string {
+	var names []string
+	for f := range s.flags {
+		names = append(names, "--"+f)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// byTLD bootstraps searches by params whose pattern is a domain or host
+// name from the registry of its TLD.
+func byTLD(params ...string) func(*bootstrap.Registry, string, string) (string, error) {
+	return func(boot *bootstrap.Registry, by, pattern string) (string, error) {
+		ok := false
+		for _, p := range params {
+			ok = ok || p == by
+		}
+		if !ok {
+			return "", fmt.Errorf("searches by %s can't be bootstrapped, use --server", by)
+		}
+		name := strings.TrimSuffix(pattern, ".")
+		idx := strings.LastIndex(name, ".")
+		tld := name[idx+1:]
+		if idx < 0 || tld == "" || strings.Contains(tld, "*") {
+			return "", fmt.Errorf("can't bootstrap %q without a TLD, use --server", pattern)
+		}
+		server, err := boot.ForDomain(tld)
+		return checkServer(pattern, server, err)
+	}
+}
+
+// byObjectTag bootstraps entity searches by a handle ending in an object
+// tag (e.g. "XXXX*-ARIN").
+func byObjectTag(boot *bootstrap.Registry, by, pattern string) (string, error) {
+	if by != "handle" {
+		return "", fmt.Errorf("searches by %s can't be bootstrapped, use --server", by)
+	}
+	tag := bootstrap.ObjectTag(pattern)
+	if tag == "" || strings.Contains(tag, "*") {
+		return "", fmt.Errorf("can't bootstrap %q without an object tag (e.g. XXXX*-ARIN), use --server", pattern)
+	}
+	server, err := boot.ForEntity(pattern)
+	return checkServer(pattern, server, err)
+}
+
+// checkServer returns the result of bootstrapping pattern, failing when
+// no server was found.
+func checkServer(pattern, server string, err error) (string, error) {
+	if err != nil {
+		return "", fmt.Errorf("getting boot strap files: %v", err)
+	}
+	if server == "" {
+		return "", fmt.Errorf("no server found for %s, use --server", pattern)
+	}
+	return server, nil
+}
+
+func domains(client *rdap.Client, by, pattern string, maxPages int, t *table) error {
+	t.header("LDH NAME", "UNICODE NAME", "HANDLE", "STATUS")
+	page, err := client.DomainSearch(by, pattern)
+	for pages := 1; err == nil; pages++ {
+		for _, d := range page.Results {
+			t.row(d.LDHName, d.UnicodeName, d.Handle, d.Status.String())
+		}
+		if t.last(&page.Common, pages, maxPages) {
+			return nil
+		}
+		page, err = client.DomainSearchNext(page)
+	}
+	return err
+}
+
+func nameservers(client *rdap.Client, by, pattern string, maxPages int, t *table) error {
+	t.header("LDH NAME", "HANDLE", "IPV4", "IPV6", "STATUS")
+	page, err := client.NameserverSearch(by, pattern)
+	for pages := 1; err == nil; pages++ {
+		for _, ns := range page.Results {
+			var v4, v6 []string
+			if ns.IPAddresses != nil {
+				v4, v6 = ns.IPAddresses.V4, ns.IPAddresses.V6
+			}
+			t.row(ns.LDHName, ns.Handle, strings.Join(v4, ","), strings.Join(v6, ","), ns.Status.String())
+		}
+		if t.last(&page.Common, pages, maxPages) {
+			return nil
+		}
+		page, err = client.NameserverSearchNext(page)
+	}
+	return err
+}
+
+func entities(client *rdap.Client, by, pattern string, maxPages int, t *table) error {
+	t.header("HANDLE", "NAME", "ROLES", "STATUS")
+	page, err := client.EntitySearch(by, pattern)
+	for pages := 1; err == nil; pages++ {
+		for i := range page.Results {
+			e := &page.Results[i]
+			var name string
+			if contact, _ := e.Contact(); contact != nil {
+				name = contact.FullName
+			}
+			roles := make([]string, len(e.Roles))
+			for j := range e.Roles {
+				roles[j] = string(e.Roles[j])
+			}
+			t.row(e.Handle, name, strings.Join(roles, ","), e.Status.String())
+		}
+		if t.last(&page.Common, pages, maxPages) {
+			return nil
+		}
+		page, err = client.EntitySearchNext(page)
+	}
+	return err
+}
+
+// table prints search results as aligned columns, followed by a count.
+type table struct {
+	out io.Writer
+	w   *tabwriter.Writer
+
+	rows  int
+	total int // from the paging extension, zero if unknown
+
+	// more is set when there are pages left after --max-pages
+	more bool
+
+	// followed holds every next link read, so a server repeating one
+	// doesn't page forever.
+	followed map[string]bool
+}
+
+func newTable(w io.Writer) *table {
+	return &table{
+		out:      w,
+		w:        tabwriter.NewWriter(w, 0, 4, 2, ' ', 0),
+		followed: make(map[string]bool),
+	}
+}
+
+func (t *table) header(columns ...string) {
+	fmt.Fprintln(t.w, strings.Join(columns, "\t"))
+}
+
+func (t *table) row(columns ...string) {
+	for i := range columns {
+		if columns[i] == "" {
+			columns[i] = "-"
+		}
+	}
+	fmt.Fprintln(t.w, strings.Join(columns, "\t"))
+	t.rows++
+}
+
+// last records a page of results was read and returns true if it's the
+// last one which should be. A next link which was already followed ends the
+// results too.
+func (t *table) last(c *rdap.Common, pages, maxPages int) bool {
+	if paging, _ := c.Paging(); paging != nil && paging.TotalCount > 0 {
+		t.total = paging.TotalCount
+	}
+	next := c.NextPage()
+	if next == nil || t.followed[next.String()] {
+		return true
+	}
+	if maxPages > 0 && pages >= maxPages {
+		t.more = true
+		return true
+	}
+	t.followed[next.String()] = true
+	return false
+}
+
+func (t *table) flush() {
+	t.w.Flush()
+	switch {
+	case t.more && t.total > 0:
+		fmt.Fprintf(t.out, "\n%d of %d results, use --max-pages to read more\n", t.rows, t.total)
+	case t.more:
+		fmt.Fprintf(t.out, "\n%d results, use --max-pages to read more\n", t.rows)
+	default:
+		fmt.Fprintf(t.out, "\n%d results\n", t.rows)
+	}
+}
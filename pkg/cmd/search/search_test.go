@@ -0,0 +1,127 @@
+package search
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/rdap"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+func TestSearch__domainsTable(t *testing.T) {
+	var requests int
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		fmt.Fprintf(w, `{"rdapConformance":["paging"],
+"domainSearchResults":[{"objectClassName":"domain","ldhName":"example%[1]s.com","handle":"D%[1]s","status":["active"]}],
+"paging_metadata":{"totalCount":5,"links":[{"rel":"next","href":"http://%[2]s/domains?name=exam*.com&page=%[1]s0"}]}}`, page, r.Host)
+	}))
+	defer svc.Close()
+
+	client := &rdap.Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	var buf bytes.Buffer
+	tbl := newTable(&buf)
+	if err := domains(client, "name", "exam*.com", 2, tbl); err != nil {
+		t.Fatal(err)
+	}
+	tbl.flush()
+
+	if requests != 2 {
+		t.Errorf("made %d requests", requests)
+	}
+	expected := `LDH NAME       UNICODE NAME  HANDLE  STATUS
+example1.com   -             D1      active
+example10.com  -             D10     active
+
+2 of 5 results, use --max-pages to read more
+`
+	if buf.String() != expected {
+		t.Errorf("got\n%s", buf.String())
+	}
+}
+
+func TestSearch__repeatedNext(t *testing.T) {
+	var requests int
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"nameserverSearchResults":[{"objectClassName":"nameserver","ldhName":"ns%d.example.com"}],
+"links":[{"rel":"next","href":"http://%s/nameservers?name=ns*.example.com&page=2"}]}`, requests, r.Host)
+	}))
+	defer svc.Close()
+
+	client := &rdap.Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	var buf bytes.Buffer
+	tbl := newTable(&buf)
+	if err := nameservers(client, "name", "ns*.example.com", 0, tbl); err != nil {
+		t.Fatal(err)
+	}
+	tbl.flush()
+	if requests != 2 || tbl.rows != 2 || tbl.more {
+		t.Errorf("made %d requests for %d rows:\n%s", requests, tbl.rows, buf.String())
+	}
+}
+
+func TestSearch__bootstrap(t *testing.T) {
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"1.0","services":[[["com"],["https://rdap.example.com/"]]]}`))
+	}))
+	defer svc.Close()
+	boot := &bootstrap.Registry{DNSEndpoint: svc.URL}
+
+	domains := searches["domains"]
+	server, err := domains.bootstrap(boot, "nsLdhName", "ns*.example.com")
+	if err != nil || server != "https://rdap.example.com/" {
+		t.Errorf("got %q: %v", server, err)
+	}
+	for _, c := range []struct{ by, pattern string }{
+		{"nsIp", "192.0.2.1"},
+		{"name", "example.*"},
+		{"name", "example"},
+		{"name", "example.org"},
+	} {
+		if _, err := domains.bootstrap(boot, c.by, c.pattern); err == nil || !strings.Contains(err.Error(), "--server") {
+			t.Errorf("%s=%s: got %v", c.by, c.pattern, err)
+		}
+	}
+
+	entities := searches["entities"]
+	for _, c := range []struct{ by, pattern string }{
+		{"fn", "Joe*"},
+		{"handle", "XXXX*"},
+		{"handle", "XXXX-AR*"},
+	} {
+		if _, err := entities.bootstrap(boot, c.by, c.pattern); err == nil {
+			t.Errorf("%s=%s: expected error", c.by, c.pattern)
+		}
+	}
+}
+
+func TestSearch__flags(t *testing.T) {
+	cases := [][]string{
+		nil,
+		{"autnums"},
+		{"domains"},
+		{"domains", "--name", "a*.com", "--ns-ip", "192.0.2.1"},
+		{"nameservers", "--fn", "Joe"},
+		{"entities", "--fn", "Joe*", "extra"},
+	}
+	for _, args := range cases {
+		if err := Run(nil, args); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
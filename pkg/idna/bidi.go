@@ -0,0 +1,111 @@
+package idna
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Bidi_Class values, see bidiClasses.
+const (
+	bidiL uint8 = iota
+	bidiR
+	bidiAL
+	bidiEN
+	bidiES
+	bidiET
+	bidiAN
+	bidiCS
+	bidiNSM
+	bidiBN
+	bidiB
+	bidiS
+	bidiWS
+	bidiON
+	bidiLRE
+	bidiLRO
+	bidiRLE
+	bidiRLO
+	bidiPDF
+	bidiLRI
+	bidiRLI
+	bidiFSI
+	bidiPDI
+)
+
+func bidiClass(r rune) uint8 {
+	return lookupClass(bidiClasses, r)
+}
+
+// isBidiDomain returns true if any label has right-to-left characters,
+// which RFC5893 Section 1.4 calls a "Bidi domain name".
+func isBidiDomain(labels [][]rune) bool {
+	for _, label := range labels {
+		for _, r := range label {
+			switch bidiClass(r) {
+			case bidiR, bidiAL, bidiAN:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkBidi applies the Bidi rule of RFC5893 Section 2 to a label of a
+// Bidi domain name.
+func checkBidi(label []rune) error {
+	if len(label) == 0 {
+		return nil
+	}
+	// Rule 1, the direction of a label is set by its first character.
+	rtl := false
+	switch bidiClass(label[0]) {
+	case bidiL:
+	case bidiR, bidiAL:
+		rtl = true
+	default:
+		return errors.New("doesn't start with a left-to-right or right-to-left character")
+	}
+
+	// Rules 3 and 6, the last character other than NSMs
+	last := len(label) - 1
+	for last > 0 && bidiClass(label[last]) == bidiNSM {
+		last--
+	}
+	var en, an bool
+	for i, r := range label {
+		class := bidiClass(r)
+		switch class {
+		case bidiEN:
+			en = true
+		case bidiAN:
+			an = true
+		}
+		// Rules 2 and 5
+		switch class {
+		case bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM, bidiEN:
+		case bidiR, bidiAL, bidiAN:
+			if !rtl {
+				return fmt.Errorf("left-to-right label has right-to-left character %U", r)
+			}
+		case bidiL:
+			if rtl {
+				return fmt.Errorf("right-to-left label has left-to-right character %U", r)
+			}
+		default:
+			return fmt.Errorf("has character %U not allowed by the Bidi rule", r)
+		}
+		if i == last {
+			switch {
+			case rtl && class != bidiR && class != bidiAL && class != bidiEN && class != bidiAN:
+				return errors.New("right-to-left label doesn't end with a right-to-left character or digit")
+			case !rtl && class != bidiL && class != bidiEN:
+				return errors.New("left-to-right label doesn't end with a left-to-right character or digit")
+			}
+		}
+	}
+	// Rule 4
+	if rtl && en && an {
+		return errors.New("right-to-left label mixes European and Arabic-Indic digits")
+	}
+	return nil
+}
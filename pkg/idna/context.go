@@ -0,0 +1,152 @@
+package idna
+
+import "fmt"
+
+const (
+	zeroWidthNonJoiner = '\u200C'
+	zeroWidthJoiner    = '\u200D'
+	middleDot          = '\u00B7'
+	greekKeraia        = '\u0375'
+	hebrewGeresh       = '\u05F3'
+	hebrewGershayim    = '\u05F4'
+	katakanaMiddleDot  = '\u30FB'
+
+	// combiningClassVirama is the Canonical_Combining_Class of viramas.
+	combiningClassVirama = 9
+)
+
+// Joining_Type values, see joiningTypes.
+const (
+	joiningU uint8 = iota
+	joiningC
+	joiningD
+	joiningL
+	joiningR
+	joiningT
+)
+
+// Script values, see scripts.
+const (
+	scriptOther uint8 = iota
+	scriptGreek
+	scriptHan
+	scriptHebrew
+	scriptHiragana
+	scriptKatakana
+)
+
+// checkContext applies the rules of RFC5892 Appendix A to the code points
+// of label which need them.
+func checkContext(label []rune) error {
+	for i, r := range label {
+		var before, after rune = -1, -1
+		if i > 0 {
+			before = label[i-1]
+		}
+		if i+1 < len(label) {
+			after = label[i+1]
+		}
+
+		ok := true
+		switch {
+		case r == zeroWidthNonJoiner:
+			// Appendix A.1, after a virama or between joining characters
+			ok = combiningClasses[before] == combiningClassVirama || joinsAround(label, i)
+		case r == zeroWidthJoiner:
+			// Appendix A.2
+			ok = combiningClasses[before] == combiningClassVirama
+		case r == middleDot:
+			// Appendix A.3, as in Catalan's l·l
+			ok = before == 'l' && after == 'l'
+		case r == greekKeraia:
+			// Appendix A.4
+			ok = after >= 0 && lookupClass(scripts, after) == scriptGreek
+		case r == hebrewGeresh, r == hebrewGershayim:
+			// Appendix A.5 and A.6
+			ok = before >= 0 && lookupClass(scripts, before) == scriptHebrew
+		case r == katakanaMiddleDot:
+			// Appendix A.7
+			ok = false
+			for _, c := range label {
+				switch lookupClass(scripts, c) {
+				case scriptHiragana, scriptKatakana, scriptHan:
+					ok = ok || c != katakanaMiddleDot
+				}
+			}
+		case r >= '\u0660' && r <= '\u0669':
+			// Appendix A.8, Arabic-Indic digits can't be mixed with
+			// Extended Arabic-Indic digits
+			ok = !containsRange(label, '\u06F0', '\u06F9')
+		case r >= '\u06F0' && r <= '\u06F9':
+			// Appendix A.9
+			ok = !containsRange(label, '\u0660', '\u0669')
+		}
+		if !ok {
+			return fmt.Errorf("%U isn't allowed in this context", r)
+		}
+	}
+	return nil
+}
+
+// joinsAround checks the zero width non-joiner at label[i] is preceded by
+// a left or dual joining character and followed by a right or dual
+// joining one, skipping transparent characters, matching the regular
+// expression of RFC5892 Appendix A.1.
+func joinsAround(label []rune, i int) bool {
+	j := i - 1
+	for j >= 0 && joiningType(label[j]) == joiningT {
+		j--
+	}
+	if j < 0 {
+		return false
+	}
+	if t := joiningType(label[j]); t != joiningL && t != joiningD {
+		return false
+	}
+	k := i + 1
+	for k < len(label) && joiningType(label[k]) == joiningT {
+		k++
+	}
+	if k == len(label) {
+		return false
+	}
+	t := joiningType(label[k])
+	return t == joiningR || t == joiningD
+}
+
+func joiningType(r rune) uint8 {
+	return lookupClass(joiningTypes, r)
+}
+
+func containsRange(label []rune, lo, hi rune) bool {
+	for _, r := range label {
+		if r >= lo && r <= hi {
+			return true
+		}
+	}
+	return false
+}
+
+// classRange is a range of code points with the same property value.
+type classRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+// lookupClass returns the class of r in ranges, or zero if it isn't in
+// any of them.
+func lookupClass(ranges []classRange, r rune) uint8 {
+	lo, hi := 0, len(ranges)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		switch {
+		case r < ranges[mid].lo:
+			hi = mid
+		case r > ranges[mid].hi:
+			lo = mid + 1
+		default:
+			return ranges[mid].class
+		}
+	}
+	return 0
+}
@@ -0,0 +1,388 @@
+//go:build ignore
+// +build ignore
+
+// gen.go writes tables.go from the Unicode Character Database, for
+// lowercasing, NFC normalization, the RFC5892 derived property values of
+// code points, the CONTEXTJ and CONTEXTO rules and the Bidi rule.
+//
+//	go run gen.go [-version 15.1.0] [-ucd dir]
+//
+// The database is downloaded from unicode.org unless -ucd names a local
+// copy of its directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	flagVersion = flag.String("version", "15.1.0", "Unicode version")
+	flagUCD     = flag.String("ucd", "", "directory of the Unicode Character Database, instead of downloading it")
+	flagOutput  = flag.String("output", "tables.go", "file to write")
+)
+
+func main() {
+	flag.Parse()
+
+	decomps := make(map[rune][]rune)
+	classes := make(map[rune]int)
+	categories := make(map[rune]string)
+	lowercase := make(map[rune]rune)
+	parse("UnicodeData.txt", func(fields []string) {
+		lo, hi := codePoints(fields[0])
+		for r := lo; r <= hi; r++ {
+			categories[r] = fields[2]
+		}
+		if fields[13] != "" {
+			lowercase[lo], _ = codePoints(fields[13])
+		}
+		if n, _ := strconv.Atoi(fields[3]); n > 0 {
+			for r := lo; r <= hi; r++ {
+				classes[r] = n
+			}
+		}
+		// Compatibility decompositions start with a <tag>.
+		if fields[5] != "" && !strings.HasPrefix(fields[5], "<") {
+			for _, cp := range strings.Fields(fields[5]) {
+				r, _ := codePoints(cp)
+				decomps[lo] = append(decomps[lo], r)
+			}
+		}
+	})
+
+	// UAX15, a canonical decomposition of two code points is recomposed
+	// unless it's excluded or starts with a non-starter.
+	excluded := make(map[rune]bool)
+	parse("CompositionExclusions.txt", func(fields []string) {
+		r, _ := codePoints(fields[0])
+		excluded[r] = true
+	})
+	comps := make(map[[2]rune]rune)
+	for r, d := range decomps {
+		if len(d) == 2 && !excluded[r] && classes[r] == 0 && classes[d[0]] == 0 {
+			comps[[2]rune{d[0], d[1]}] = r
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from Unicode %s; DO NOT EDIT.\n\npackage idna\n\n", *flagVersion)
+
+	fmt.Fprintln(&buf, "// lowercase holds the Simple_Lowercase_Mapping of each code point which")
+	fmt.Fprintln(&buf, "// has one.")
+	fmt.Fprintln(&buf, "var lowercase = map[rune]rune{")
+	writeEntries(&buf, sortedRunes(lowercase), func(r rune) string {
+		return fmt.Sprintf("0x%X: 0x%X", r, lowercase[r])
+	})
+	fmt.Fprintln(&buf, "}")
+
+	fmt.Fprintln(&buf, "\n// decompositions holds the canonical decomposition of each code point")
+	fmt.Fprintln(&buf, "// which has one, which may decompose further.")
+	fmt.Fprintln(&buf, "var decompositions = map[rune]string{")
+	writeEntries(&buf, sortedRunes(decomps), func(r rune) string {
+		return fmt.Sprintf("0x%X: %+q", r, string(decomps[r]))
+	})
+	fmt.Fprintln(&buf, "}")
+
+	fmt.Fprintln(&buf, "\n// combiningClasses holds every non-zero Canonical_Combining_Class.")
+	fmt.Fprintln(&buf, "var combiningClasses = map[rune]uint8{")
+	writeEntries(&buf, sortedRunes(classes), func(r rune) string {
+		return fmt.Sprintf("0x%X: %d", r, classes[r])
+	})
+	fmt.Fprintln(&buf, "}")
+
+	fmt.Fprintln(&buf, "\n// compositions maps pairs of code points to their primary composite.")
+	fmt.Fprintln(&buf, "var compositions = map[[2]rune]rune{")
+	var pairs [][2]rune
+	for p := range comps {
+		pairs = append(pairs, p)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1]
+	})
+	for i := 0; i < len(pairs); i += 4 {
+		var line []string
+		for _, p := range pairs[i:min(i+4, len(pairs))] {
+			line = append(line, fmt.Sprintf("{0x%X, 0x%X}: 0x%X,", p[0], p[1], comps[p]))
+		}
+		fmt.Fprintf(&buf, "\t%s\n", strings.Join(line, " "))
+	}
+	fmt.Fprintln(&buf, "}")
+
+	fmt.Fprintln(&buf, "\n// derivedProperties holds the ranges of code points which are PVALID,")
+	fmt.Fprintln(&buf, "// CONTEXTJ or CONTEXTO (RFC5892 Section 3), sorted and without overlaps.")
+	fmt.Fprintln(&buf, "// The rest are DISALLOWED or UNASSIGNED.")
+	writeRanges(&buf, "derivedProperties", "derived", derivedProperties(categories))
+
+	marks := make(map[rune]string)
+	for r, gc := range categories {
+		if strings.HasPrefix(gc, "M") {
+			marks[r] = gc
+		}
+	}
+	fmt.Fprintln(&buf, "\n// marks holds the ranges of code points with each General_Category of")
+	fmt.Fprintln(&buf, "// combining marks, sorted and without overlaps.")
+	writeRanges(&buf, "marks", "mark", marks)
+
+	// Bidi_Class L and Joining_Type U are the defaults, and left out.
+	fmt.Fprintln(&buf, "\n// bidiClasses holds the ranges of code points with each Bidi_Class,")
+	fmt.Fprintln(&buf, "// other than L, sorted and without overlaps.")
+	writeProperty(&buf, "bidiClasses", "extracted/DerivedBidiClass.txt", "bidi", func(v string) bool {
+		return v != "L"
+	})
+	fmt.Fprintln(&buf, "\n// joiningTypes holds the ranges of code points with each Joining_Type,")
+	fmt.Fprintln(&buf, "// other than U, sorted and without overlaps.")
+	writeProperty(&buf, "joiningTypes", "extracted/DerivedJoiningType.txt", "joining", func(v string) bool {
+		return v != "U"
+	})
+	fmt.Fprintln(&buf, "\n// scripts holds the ranges of code points with each Script the rules of")
+	fmt.Fprintln(&buf, "// RFC5892 Appendix A name, sorted and without overlaps.")
+	writeProperty(&buf, "scripts", "Scripts.txt", "script", func(v string) bool {
+		switch v {
+		case "Greek", "Han", "Hebrew", "Hiragana", "Katakana":
+			return true
+		}
+		return false
+	})
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting tables: %v", err)
+	}
+	if err := ioutil.WriteFile(*flagOutput, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeProperty writes the ranges of code points given each value of a
+// property in file which keep returns true for.
+func writeProperty(w io.Writer, name, file, prefix string, keep func(value string) bool) {
+	values := make(map[rune]string)
+	parse(file, func(fields []string) {
+		if !keep(fields[1]) {
+			return
+		}
+		lo, hi := codePoints(fields[0])
+		for r := lo; r <= hi; r++ {
+			values[r] = fields[1]
+		}
+	})
+	writeRanges(w, name, prefix, values)
+}
+
+// writeRanges writes the ranges of code points with each value, naming
+// each value's constant prefix+value.
+func writeRanges(w io.Writer, name, prefix string, values map[rune]string) {
+	runes := make([]rune, 0, len(values))
+	for r := range values {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	fmt.Fprintf(w, "var %s = []classRange{\n", name)
+	for i := 0; i < len(runes); {
+		// Join adjacent code points with the same value.
+		lo, hi, value := runes[i], runes[i], values[runes[i]]
+		for i++; i < len(runes) && runes[i] == hi+1 && values[runes[i]] == value; i++ {
+			hi = runes[i]
+		}
+		fmt.Fprintf(w, "\t{0x%X, 0x%X, %s%s},\n", lo, hi, prefix, value)
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// RFC5892 Section 2.6, code points whose derived property value is fixed
+var exceptions = map[rune]string{
+	0x00DF: "PVALID", 0x03C2: "PVALID", 0x06FD: "PVALID", 0x06FE: "PVALID", 0x0F0B: "PVALID", 0x3007: "PVALID",
+	0x00B7: "CONTEXTO", 0x0375: "CONTEXTO", 0x05F3: "CONTEXTO", 0x05F4: "CONTEXTO", 0x30FB: "CONTEXTO",
+	0x0640: "DISALLOWED", 0x07FA: "DISALLOWED", 0x302E: "DISALLOWED", 0x302F: "DISALLOWED",
+	0x3031: "DISALLOWED", 0x3032: "DISALLOWED", 0x3033: "DISALLOWED", 0x3034: "DISALLOWED",
+	0x3035: "DISALLOWED", 0x303B: "DISALLOWED",
+}
+
+func init() {
+	// Arabic-Indic and Extended Arabic-Indic digits
+	for r := rune(0x0660); r <= 0x0669; r++ {
+		exceptions[r] = "CONTEXTO"
+	}
+	for r := rune(0x06F0); r <= 0x06F9; r++ {
+		exceptions[r] = "CONTEXTO"
+	}
+}
+
+// derivedProperties returns the RFC5892 derived property value of each code
+// point which isn't DISALLOWED or UNASSIGNED, following Section 3.
+func derivedProperties(categories map[rune]string) map[rune]string {
+	// Section 2.3
+	unstable := readProperty("DerivedNormalizationProps.txt", "Changes_When_NFKC_Casefolded")
+	// Section 2.4
+	ignorable := readProperty("DerivedCoreProperties.txt", "Default_Ignorable_Code_Point")
+	for r := range readProperty("PropList.txt", "White_Space", "Noncharacter_Code_Point") {
+		ignorable[r] = true
+	}
+	// Section 2.5
+	for r := range readProperty("Blocks.txt", "Combining Diacritical Marks for Symbols", "Musical Symbols", "Ancient Greek Musical Notation") {
+		ignorable[r] = true
+	}
+	// Section 2.9
+	oldHangulJamo := readProperty("HangulSyllableType.txt", "L", "V", "T")
+
+	out := make(map[rune]string)
+	for r := rune(0); r <= 0x10FFFF; r++ {
+		var value string
+		gc, assigned := categories[r]
+		switch {
+		case exceptions[r] != "":
+			value = exceptions[r]
+		case !assigned:
+			// UNASSIGNED, or a noncharacter which is DISALLOWED
+		case r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z':
+			value = "PVALID"
+		case r == 0x200C || r == 0x200D:
+			value = "CONTEXTJ"
+		case unstable[r], ignorable[r], oldHangulJamo[r]:
+		case gc == "Ll", gc == "Lu", gc == "Lo", gc == "Nd", gc == "Lm", gc == "Mn", gc == "Mc":
+			value = "PVALID"
+		}
+		if value != "" && value != "DISALLOWED" {
+			out[r] = value
+		}
+	}
+	return out
+}
+
+// readProperty returns the code points given any of values in file.
+func readProperty(file string, values ...string) map[rune]bool {
+	out := make(map[rune]bool)
+	parse(file, func(fields []string) {
+		for _, v := range values {
+			if fields[1] == v {
+				lo, hi := codePoints(fields[0])
+				for r := lo; r <= hi; r++ {
+					out[r] = true
+				}
+			}
+		}
+	})
+	return out
+}
+
+func writeEntries(w io.Writer, runes []rune, entry func(rune) string) {
+	for i := 0; i < len(runes); i += 4 {
+		var line []string
+		for _, r := range runes[i:min(i+4, len(runes))] {
+			line = append(line, entry(r)+",")
+		}
+		fmt.Fprintf(w, "\t%s\n", strings.Join(line, " "))
+	}
+}
+
+func sortedRunes(m interface{}) []rune {
+	var out []rune
+	switch m := m.(type) {
+	case map[rune][]rune:
+		for r := range m {
+			out = append(out, r)
+		}
+	case map[rune]int:
+		for r := range m {
+			out = append(out, r)
+		}
+	case map[rune]rune:
+		for r := range m {
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// parse calls fn with the fields of each line of a UCD file, handling the
+// <..., First> and <..., Last> ranges of UnicodeData.txt.
+func parse(file string, fn func(fields []string)) {
+	rc, err := open(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer rc.Close()
+
+	var first string
+	s := bufio.NewScanner(rc)
+	for s.Scan() {
+		line := s.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(fields) > 1 && strings.HasSuffix(fields[1], ", First>") {
+			first = fields[0]
+			continue
+		}
+		if first != "" {
+			fields[0] = first + ".." + fields[0]
+			first = ""
+		}
+		fn(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatalf("reading %s: %v", file, err)
+	}
+}
+
+func open(file string) (io.ReadCloser, error) {
+	if *flagUCD != "" {
+		return os.Open(filepath.Join(*flagUCD, filepath.FromSlash(file)))
+	}
+	resp, err := http.Get("https://www.unicode.org/Public/" + *flagVersion + "/ucd/" + file)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s fetching %s", resp.Status, file)
+	}
+	return resp.Body, nil
+}
+
+// codePoints parses a code point (e.g. "00C0") or range ("0600..0605").
+func codePoints(s string) (lo, hi rune) {
+	parts := strings.SplitN(s, "..", 2)
+	n, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		log.Fatalf("invalid code point %q", s)
+	}
+	lo, hi = rune(n), rune(n)
+	if len(parts) == 2 {
+		n, err = strconv.ParseUint(parts[1], 16, 32)
+		if err != nil {
+			log.Fatalf("invalid code point %q", s)
+		}
+		hi = rune(n)
+	}
+	return lo, hi
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
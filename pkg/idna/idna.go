@@ -0,0 +1,250 @@
+// idna converts Internationalized Domain Names between their U-label
+// (Unicode) and A-label ("xn--" Punycode) forms and validates them.
+//
+// RFC7482 Section 3.1.3 allows domain and nameserver queries with labels
+// in either form, while RFC7484 bootstrap files and most servers only
+// match A-labels.
+//
+// Validation follows IDNA2008 (RFC5891 Section 5.4): labels are lowercased
+// and normalized to NFC, then must only have PVALID code points and
+// CONTEXTJ or CONTEXTO ones in the contexts RFC5892 Appendix A allows,
+// must not begin with a combining mark and must satisfy the hyphen and
+// length rules and, in names with right-to-left labels, the Bidi rule
+// (RFC5893). Labels aren't otherwise mapped as UTS46 does, so fullwidth
+// and compatibility forms are rejected.
+//
+// Every table, from the lowercase mappings to the RFC5892 derived property
+// values, is generated from one version of the Unicode Character Database
+// by gen.go.
+package idna
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	acePrefix = "xn--"
+
+	// RFC1034 Section 3.1 limits, in octets of the A-label form
+	maxLabelLength = 63
+	maxNameLength  = 253
+)
+
+// RFC5892 derived property values, see derivedProperties. DISALLOWED
+// covers UNASSIGNED code points too.
+const (
+	derivedDISALLOWED uint8 = iota
+	derivedPVALID
+	derivedCONTEXTJ
+	derivedCONTEXTO
+)
+
+// General_Category values of combining marks, see marks.
+const (
+	markNone uint8 = iota
+	markMn
+	markMc
+	markMe
+)
+
+// ToASCII returns name with every label as an A-label or LDH label, in
+// lowercase and without a trailing dot. An error is returned for malformed
+// names and labels.
+func ToASCII(name string) (string, error) {
+	labels, err := split(name)
+	if err != nil {
+		return "", err
+	}
+	ulabels := make([][]rune, len(labels))
+	for i := range labels {
+		var ulabel string
+		if labels[i], ulabel, err = labelToASCII(labels[i]); err != nil {
+			return "", fmt.Errorf("invalid domain name %q: %v", name, err)
+		}
+		ulabels[i] = []rune(ulabel)
+	}
+	if isBidiDomain(ulabels) {
+		for i := range ulabels {
+			if err := checkBidi(ulabels[i]); err != nil {
+				return "", fmt.Errorf("invalid domain name %q: label %q %v", name, string(ulabels[i]), err)
+			}
+		}
+	}
+	out := strings.Join(labels, ".")
+	if len(out) > maxNameLength {
+		return "", fmt.Errorf("invalid domain name %q: longer than %d octets", name, maxNameLength)
+	}
+	return out, nil
+}
+
+// ToUnicode returns name with every A-label converted to its U-label, in
+// lowercase and without a trailing dot. An error is returned for malformed
+// names and labels.
+func ToUnicode(name string) (string, error) {
+	ascii, err := ToASCII(name)
+	if err != nil {
+		return "", err
+	}
+	labels := strings.Split(ascii, ".")
+	for i := range labels {
+		if isACE(labels[i]) {
+			labels[i], _ = decode(labels[i][len(acePrefix):]) // checked by ToASCII
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// IsIDN returns true if name has any U-labels or A-labels.
+func IsIDN(name string) bool {
+	for _, label := range strings.Split(name, ".") {
+		if isACE(label) {
+			return true
+		}
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// split lowercases name and returns its labels, accepting the full stops
+// IDNA treats as dots (RFC3490 Section 3.1).
+func split(name string) ([]string, error) {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '。', '．', '｡':
+			return '.'
+		}
+		if lower, ok := lowercase[r]; ok {
+			return lower
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if !utf8.ValidString(name) {
+		return nil, errors.New("domain name isn't valid UTF-8")
+	}
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return nil, errors.New("empty domain name")
+	}
+	labels := strings.Split(name, ".")
+	for i := range labels {
+		if labels[i] == "" {
+			return nil, fmt.Errorf("invalid domain name %q: empty label", name)
+		}
+	}
+	return labels, nil
+}
+
+func isACE(label string) bool {
+	return len(label) >= len(acePrefix) && strings.EqualFold(label[:len(acePrefix)], acePrefix)
+}
+
+// labelToASCII returns the A-label (or LDH label) and U-label forms of
+// label.
+func labelToASCII(label string) (alabel, ulabel string, err error) {
+	ascii := true
+	for i := 0; i < len(label); i++ {
+		if label[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+
+	if ascii {
+		if err := checkLDH(label); err != nil {
+			return "", "", err
+		}
+		if !isACE(label) {
+			return label, label, nil
+		}
+		// RFC5891 Section 5.4, A-labels must decode to a valid U-label
+		// which encodes back to the same A-label.
+		ulabel, err := decode(label[len(acePrefix):])
+		if err != nil {
+			return "", "", fmt.Errorf("label %q: %v", label, err)
+		}
+		if err := checkULabel(ulabel); err != nil {
+			return "", "", fmt.Errorf("label %q: %v", label, err)
+		}
+		if !IsIDN(ulabel) {
+			return "", "", fmt.Errorf("label %q doesn't decode to a U-label", label)
+		}
+		if again, err := encode(ulabel); err != nil || acePrefix+again != label {
+			return "", "", fmt.Errorf("label %q isn't a canonical A-label", label)
+		}
+		return label, ulabel, nil
+	}
+
+	// RFC5891 Section 5.2, U-labels are looked up in NFC.
+	ulabel = nfc(label)
+	if err := checkULabel(ulabel); err != nil {
+		return "", "", fmt.Errorf("label %q: %v", label, err)
+	}
+	encoded, err := encode(ulabel)
+	if err != nil {
+		return "", "", fmt.Errorf("label %q: %v", label, err)
+	}
+	alabel = acePrefix + encoded
+	if len(alabel) > maxLabelLength {
+		return "", "", fmt.Errorf("label %q is longer than %d octets", label, maxLabelLength)
+	}
+	return alabel, ulabel, nil
+}
+
+// checkLDH validates the "letters, digits, hyphen" syntax of RFC5890
+// Section 2.3.1 for an ASCII label.
+func checkLDH(label string) error {
+	if len(label) > maxLabelLength {
+		return fmt.Errorf("label %q is longer than %d octets", label, maxLabelLength)
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			return fmt.Errorf("label %q has invalid character %q", label, c)
+		}
+	}
+	return checkHyphens(label, !isACE(label))
+}
+
+// checkHyphens applies RFC5891 Section 4.2.3.1: no leading or trailing
+// hyphen, and hyphens in the third and fourth positions are reserved for
+// A-labels.
+func checkHyphens(label string, reserved bool) error {
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return fmt.Errorf("label %q begins or ends with a hyphen", label)
+	}
+	if reserved && len(label) >= 4 && label[2:4] == "--" {
+		return fmt.Errorf("label %q has hyphens in the third and fourth positions", label)
+	}
+	return nil
+}
+
+func checkULabel(label string) error {
+	if label == "" {
+		return errors.New("empty label")
+	}
+	if !isNFC(label) {
+		return errors.New("isn't in Unicode Normalization Form C")
+	}
+	for i, r := range label {
+		if i == 0 && lookupClass(marks, r) != markNone {
+			return fmt.Errorf("begins with combining mark %U", r)
+		}
+		// CONTEXTJ and CONTEXTO code points are checked by checkContext.
+		if lookupClass(derivedProperties, r) == derivedDISALLOWED {
+			return fmt.Errorf("has disallowed character %U", r)
+		}
+	}
+	if err := checkContext([]rune(label)); err != nil {
+		return err
+	}
+	return checkHyphens(label, true)
+}
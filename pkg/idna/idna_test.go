@@ -0,0 +1,189 @@
+package idna
+
+import (
+	"testing"
+)
+
+func TestPunycode(t *testing.T) {
+	// RFC3492 Section 7.1 sample strings
+	cases := map[string]string{
+		"ليهمابتكلموشعربي؟":      "egbpdaj6bu4bxfgehfvwxn",
+		"他们为什么不说中文":              "ihqwcrb4cv8a8dqg056pqjye",
+		"Pročprostěnemluvíčesky": "Proprostnemluvesky-uyb24dma41a",
+		"なぜみんな日本語を話してくれないのか":     "n8jok5ay5dzabd5bym9f0cm5685rrjetr6pdxa",
+		"bücher":  "bcher-kva",
+		"münchen": "mnchen-3ya",
+	}
+	for in, expected := range cases {
+		got, err := encode(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("encode(%s): got %q, expected %q", in, got, expected)
+		}
+		back, err := decode(expected)
+		if err != nil || back != in {
+			t.Errorf("decode(%s): got %q (err=%v)", expected, back, err)
+		}
+	}
+
+	for _, in := range []string{"99999999999999999999", "abc-!", "a-é"} {
+		if _, err := decode(in); err == nil {
+			t.Errorf("decode(%q): expected error", in)
+		}
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	cases := map[string]string{
+		"example.com":             "example.com",
+		"EXAMPLE.COM.":            "example.com",
+		"bücher.example":          "xn--bcher-kva.example",
+		"BÜCHER.example":          "xn--bcher-kva.example",
+		"xn--bcher-kva.example":   "xn--bcher-kva.example",
+		"XN--BCHER-KVA.EXAMPLE":   "xn--bcher-kva.example",
+		"例え。テスト":                  "xn--r8jz45g.xn--zckzah",
+		"1.0.192.in-addr.arpa":    "1.0.192.in-addr.arpa",
+		"ns1.münchen.de":          "ns1.xn--mnchen-3ya.de",
+		"xn--mnchen-3ya.de":       "xn--mnchen-3ya.de",
+		"a-b.example":             "a-b.example",
+		"xn--80ak6aa92e.com":      "xn--80ak6aa92e.com",
+		"рф.example":              "xn--p1ai.example",
+		"123.example":             "123.example",
+		"ab-c.xn--80ak6aa92e.com": "ab-c.xn--80ak6aa92e.com",
+		"straße.example":          "xn--strae-oqa.example", // RFC5892 Section 2.6 exceptions
+		"ς.example":               "xn--3xa.example",
+		"〇.example":               "xn--w6j.example",
+	}
+	for in, expected := range cases {
+		got, err := ToASCII(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("%s: got %q, expected %q", in, got, expected)
+		}
+	}
+
+	invalid := []string{
+		"",
+		".",
+		"example..com",
+		"-example.com",
+		"example-.com",
+		"ab--c.com",
+		"exa mple.com",
+		"exa_mple.com",
+		"example.com/path",
+		"xn--.com",
+		"xn--abc-.com",        // decodes to ASCII
+		"xn--bcher-kva-.com",  // trailing hyphen
+		"xn--zzzzzzzzzzz.com", // not valid punycode
+		"́a.com",              // leading combining mark
+		"☃.com",               // symbol
+		"ﬁ.com",               // compatibility ligature
+		"１２３.com",             // fullwidth digits
+		"a\u0640b.com",        // Arabic tatweel, DISALLOWED by exception
+		"\u1100.com",          // old Hangul jamo
+		"a\u00ADb.com",        // soft hyphen, default ignorable
+		"a b.com",
+		string(make([]byte, 64)) + ".com",
+	}
+	for _, in := range invalid {
+		if got, err := ToASCII(in); err == nil {
+			t.Errorf("%q: expected error, got %q", in, got)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	cases := map[string]string{
+		"xn--bcher-kva.example":  "bücher.example",
+		"bücher.example":         "bücher.example",
+		"xn--r8jz45g.xn--zckzah": "例え.テスト",
+		"example.com":            "example.com",
+	}
+	for in, expected := range cases {
+		got, err := ToUnicode(in)
+		if err != nil || got != expected {
+			t.Errorf("%s: got %q (err=%v)", in, got, err)
+		}
+	}
+	if !IsIDN("xn--bcher-kva.example") || !IsIDN("bücher.example") || IsIDN("example.com") {
+		t.Error("IsIDN")
+	}
+}
+
+func TestNFC(t *testing.T) {
+	cases := map[string]string{
+		"example":             "example",
+		"e\u0301":             "\u00e9",
+		"\u1100\u1161\u11a8":  "\uac01", // Hangul jamo
+		"\uac00\u11a8":        "\uac01", // LV syllable and trailing jamo
+		"\u212b":              "\u00c5", // singleton
+		"a\u0323\u0302":       "\u1ead",
+		"a\u0302\u0323":       "\u1ead", // reordered
+		"\u0958":              "\u0915\u093c",
+		"a\u0301\u0301":       "\u00e1\u0301", // second mark is blocked
+		"\u0344":              "\u0308\u0301", // non-starter decomposition
+		"\u1e0b\u0323":        "\u1e0d\u0307",
+		"\u0301a":             "\u0301a",
+		"\u05d0\u0301\u05d1":  "\u05d0\u0301\u05d1",
+		"\u00e9\u00e9e\u0301": "\u00e9\u00e9\u00e9",
+	}
+	for in, expected := range cases {
+		if got := nfc(in); got != expected {
+			t.Errorf("%+q: got %+q, expected %+q", in, got, expected)
+		}
+	}
+}
+
+func TestToASCII__idna2008(t *testing.T) {
+	cases := map[string]string{
+		"cafe\u0301.example":         "xn--caf-dma.example", // normalized to NFC
+		"\u1100\u1161\u11a8.example": "xn--p39a.example",
+		"क\u094d\u200cष.example":     "xn--11b2ezcs70k.example", // ZWNJ after a virama
+		"ب\u200cب.example":           "xn--ngba799q.example",    // ZWNJ between joining letters
+		"क\u094d\u200dष.example":     "xn--11b2ezcw70k.example",
+		"l·l.example":                "xn--ll-0ea.example",
+		"͵α.example":                 "xn--wva4j.example",
+		"א׳ב.example":                "xn--4dbc5h.example",
+		"ア・イ.example":                "xn--ccke4x.example",
+		"אב.example":                 "xn--4dbc.example",
+		"אב1.example":                "xn--1-zhcd.example",
+		"א\u0301ב.example":           "xn--lsa15lea.example",
+		"abc.بب":                     "abc.xn--ngba",
+	}
+	for in, expected := range cases {
+		got, err := ToASCII(in)
+		if err != nil {
+			t.Errorf("%+q: %v", in, err)
+			continue
+		}
+		if got != expected {
+			t.Errorf("%+q: got %q, expected %q", in, got, expected)
+		}
+	}
+
+	invalid := []string{
+		"xn--cafe-yvc.example", // A-label of a U-label not in NFC
+		"a\u200cb.example",     // CONTEXTJ
+		"क\u200dष.example",     // ZWJ without a virama
+		"a·b.example",          // CONTEXTO
+		"͵a.example",
+		"׳א.example",
+		"a・.example",
+		"ب٠۰.example",
+		"אa.example",  // Bidi rule 2
+		"1example.אב", // rule 1, in a Bidi domain name
+		"ب1١.example", // rule 4
+	}
+	for _, in := range invalid {
+		if got, err := ToASCII(in); err == nil {
+			t.Errorf("%+q: expected error, got %q", in, got)
+		}
+	}
+}
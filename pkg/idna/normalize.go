@@ -0,0 +1,130 @@
+package idna
+
+import (
+	"sort"
+)
+
+// Hangul syllables are composed and decomposed algorithmically (Unicode
+// Standard Section 3.12), rather than from the tables.
+const (
+	hangulBase  = 0xAC00
+	leadingBase = 0x1100
+	vowelBase   = 0x1161
+	trailBase   = 0x11A7
+
+	leadingCount  = 19
+	vowelCount    = 21
+	trailCount    = 28
+	syllableCount = leadingCount * vowelCount * trailCount
+)
+
+// nfc returns s in Unicode Normalization Form C (UAX15), which RFC5891
+// Section 5.2 requires of U-labels.
+func nfc(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+	return string(compose(decompose(s)))
+}
+
+// decompose returns the canonical decomposition of s, with each run of
+// combining marks in canonical order.
+func decompose(s string) []rune {
+	var out []rune
+	for _, r := range s {
+		out = appendDecomposed(out, r)
+	}
+	// Canonical ordering: a stable sort of each run of non-starters by
+	// their combining class.
+	for i := 0; i < len(out); {
+		if combiningClasses[out[i]] == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(out) && combiningClasses[out[j]] != 0 {
+			j++
+		}
+		run := out[i:j]
+		sort.SliceStable(run, func(a, b int) bool {
+			return combiningClasses[run[a]] < combiningClasses[run[b]]
+		})
+		i = j
+	}
+	return out
+}
+
+func appendDecomposed(out []rune, r rune) []rune {
+	if s := r - hangulBase; s >= 0 && s < syllableCount {
+		out = append(out, leadingBase+s/(vowelCount*trailCount), vowelBase+(s%(vowelCount*trailCount))/trailCount)
+		if t := s % trailCount; t > 0 {
+			out = append(out, trailBase+t)
+		}
+		return out
+	}
+	d, ok := decompositions[r]
+	if !ok {
+		return append(out, r)
+	}
+	for _, c := range d {
+		out = appendDecomposed(out, c)
+	}
+	return out
+}
+
+// compose applies the canonical composition algorithm (UAX15 Section 3.11)
+// to decomposed runes, in place.
+func compose(runes []rune) []rune {
+	if len(runes) == 0 {
+		return runes
+	}
+	out := runes[:1]
+	starter := 0 // index in out of the last starter
+	lastClass := combiningClasses[runes[0]]
+	if lastClass != 0 {
+		starter = -1
+	}
+	for _, r := range runes[1:] {
+		class := combiningClasses[r]
+		// A character can combine with the last starter when it directly
+		// follows it, or nothing between them has the same or a higher
+		// combining class.
+		if starter >= 0 && (lastClass < class || lastClass == 0) {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(out)
+		}
+		lastClass = class
+		out = append(out, r)
+	}
+	return out
+}
+
+func composePair(a, b rune) (rune, bool) {
+	// Hangul leading consonant and vowel, then LV syllable and trailing
+	// consonant.
+	if l, v := a-leadingBase, b-vowelBase; l >= 0 && l < leadingCount && v >= 0 && v < vowelCount {
+		return hangulBase + (l*vowelCount+v)*trailCount, true
+	}
+	if s, t := a-hangulBase, b-trailBase; s >= 0 && s < syllableCount && s%trailCount == 0 && t > 0 && t < trailCount {
+		return a + t, true
+	}
+	c, ok := compositions[[2]rune{a, b}]
+	return c, ok
+}
+
+// isNFC returns true if s is already in Normalization Form C.
+func isNFC(s string) bool {
+	return nfc(s) == s
+}
@@ -0,0 +1,186 @@
+package idna
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// RFC3492 Section 5
+// Parameter values for Punycode
+const (
+	base        = 36
+	tmin        = 1
+	tmax        = 26
+	skew        = 38
+	damp        = 700
+	initialBias = 72
+	initialN    = 128
+	delimiter   = '-'
+)
+
+var errOverflow = errors.New("punycode overflow")
+
+// RFC3492 Section 6.1
+func adapt(delta, numPoints int, firstTime bool) int {
+	if firstTime {
+		delta /= damp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((base-tmin)*tmax)/2 {
+		delta /= base - tmin
+		k += base
+	}
+	return k + (base-tmin+1)*delta/(delta+skew)
+}
+
+func encodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func decodeDigit(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c-'0') + 26, true
+	case c >= 'a' && c <= 'z':
+		return int(c - 'a'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c - 'A'), true
+	}
+	return 0, false
+}
+
+func threshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return tmin
+	case k >= bias+tmax:
+		return tmax
+	}
+	return k - bias
+}
+
+// encode converts a label to Punycode, without the "xn--" prefix.
+// RFC3492 Section 6.3
+func encode(label string) (string, error) {
+	runes := []rune(label)
+	var out strings.Builder
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out.WriteByte(byte(r))
+		}
+	}
+	b := out.Len()
+	h := b
+	if b > 0 {
+		out.WriteByte(delimiter)
+	}
+
+	n, delta, bias := initialN, 0, initialBias
+	for h < len(runes) {
+		// the smallest code point >= n in the input
+		m := int(utf8.MaxRune) + 1
+		for _, r := range runes {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+		if (m - n) > (maxInt-delta)/(h+1) {
+			return "", errOverflow
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+				if delta == maxInt {
+					return "", errOverflow
+				}
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := base; ; k += base {
+				t := threshold(k, bias)
+				if q < t {
+					break
+				}
+				out.WriteByte(encodeDigit(t + (q-t)%(base-t)))
+				q = (q - t) / (base - t)
+			}
+			out.WriteByte(encodeDigit(q))
+			bias = adapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return out.String(), nil
+}
+
+// decode converts Punycode, without the "xn--" prefix, to a label.
+// RFC3492 Section 6.2
+func decode(encoded string) (string, error) {
+	var output []rune
+	pos := 0
+	if i := strings.LastIndexByte(encoded, delimiter); i >= 0 {
+		for j := 0; j < i; j++ {
+			if encoded[j] >= utf8.RuneSelf {
+				return "", errors.New("punycode has non-ASCII basic code points")
+			}
+			output = append(output, rune(encoded[j]))
+		}
+		pos = i + 1
+	}
+
+	n, i, bias := initialN, 0, initialBias
+	for pos < len(encoded) {
+		oldi, w := i, 1
+		for k := base; ; k += base {
+			if pos >= len(encoded) {
+				return "", errors.New("truncated punycode")
+			}
+			digit, ok := decodeDigit(encoded[pos])
+			pos++
+			if !ok {
+				return "", errors.New("invalid punycode digit")
+			}
+			if digit > (maxInt-i)/w {
+				return "", errOverflow
+			}
+			i += digit * w
+			t := threshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > maxInt/(base-t) {
+				return "", errOverflow
+			}
+			w *= base - t
+		}
+		bias = adapt(i-oldi, len(output)+1, oldi == 0)
+		if i/(len(output)+1) > maxInt-n {
+			return "", errOverflow
+		}
+		n += i / (len(output) + 1)
+		i %= len(output) + 1
+		if n > utf8.MaxRune || (n >= 0xD800 && n <= 0xDFFF) {
+			return "", errors.New("punycode decodes to an invalid code point")
+		}
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+	return string(output), nil
+}
+
+const maxInt = int(^uint32(0) >> 1)
@@ -0,0 +1,4494 @@
+// Code generated by gen.go from Unicode 15.1.0; DO NOT EDIT.
+
+package idna
+
+// lowercase holds the Simple_Lowercase_Mapping of each code point which
+// has one.
+var lowercase = map[rune]rune{
+	0x41: 0x61, 0x42: 0x62, 0x43: 0x63, 0x44: 0x64,
+	0x45: 0x65, 0x46: 0x66, 0x47: 0x67, 0x48: 0x68,
+	0x49: 0x69, 0x4A: 0x6A, 0x4B: 0x6B, 0x4C: 0x6C,
+	0x4D: 0x6D, 0x4E: 0x6E, 0x4F: 0x6F, 0x50: 0x70,
+	0x51: 0x71, 0x52: 0x72, 0x53: 0x73, 0x54: 0x74,
+	0x55: 0x75, 0x56: 0x76, 0x57: 0x77, 0x58: 0x78,
+	0x59: 0x79, 0x5A: 0x7A, 0xC0: 0xE0, 0xC1: 0xE1,
+	0xC2: 0xE2, 0xC3: 0xE3, 0xC4: 0xE4, 0xC5: 0xE5,
+	0xC6: 0xE6, 0xC7: 0xE7, 0xC8: 0xE8, 0xC9: 0xE9,
+	0xCA: 0xEA, 0xCB: 0xEB, 0xCC: 0xEC, 0xCD: 0xED,
+	0xCE: 0xEE, 0xCF: 0xEF, 0xD0: 0xF0, 0xD1: 0xF1,
+	0xD2: 0xF2, 0xD3: 0xF3, 0xD4: 0xF4, 0xD5: 0xF5,
+	0xD6: 0xF6, 0xD8: 0xF8, 0xD9: 0xF9, 0xDA: 0xFA,
+	0xDB: 0xFB, 0xDC: 0xFC, 0xDD: 0xFD, 0xDE: 0xFE,
+	0x100: 0x101, 0x102: 0x103, 0x104: 0x105, 0x106: 0x107,
+	0x108: 0x109, 0x10A: 0x10B, 0x10C: 0x10D, 0x10E: 0x10F,
+	0x110: 0x111, 0x112: 0x113, 0x114: 0x115, 0x116: 0x117,
+	0x118: 0x119, 0x11A: 0x11B, 0x11C: 0x11D, 0x11E: 0x11F,
+	0x120: 0x121, 0x122: 0x123, 0x124: 0x125, 0x126: 0x127,
+	0x128: 0x129, 0x12A: 0x12B, 0x12C: 0x12D, 0x12E: 0x12F,
+	0x130: 0x69, 0x132: 0x133, 0x134: 0x135, 0x136: 0x137,
+	0x139: 0x13A, 0x13B: 0x13C, 0x13D: 0x13E, 0x13F: 0x140,
+	0x141: 0x142, 0x143: 0x144, 0x145: 0x146, 0x147: 0x148,
+	0x14A: 0x14B, 0x14C: 0x14D, 0x14E: 0x14F, 0x150: 0x151,
+	0x152: 0x153, 0x154: 0x155, 0x156: 0x157, 0x158: 0x159,
+	0x15A: 0x15B, 0x15C: 0x15D, 0x15E: 0x15F, 0x160: 0x161,
+	0x162: 0x163, 0x164: 0x165, 0x166: 0x167, 0x168: 0x169,
+	0x16A: 0x16B, 0x16C: 0x16D, 0x16E: 0x16F, 0x170: 0x171,
+	0x172: 0x173, 0x174: 0x175, 0x176: 0x177, 0x178: 0xFF,
+	0x179: 0x17A, 0x17B: 0x17C, 0x17D: 0x17E, 0x181: 0x253,
+	0x182: 0x183, 0x184: 0x185, 0x186: 0x254, 0x187: 0x188,
+	0x189: 0x256, 0x18A: 0x257, 0x18B: 0x18C, 0x18E: 0x1DD,
+	0x18F: 0x259, 0x190: 0x25B, 0x191: 0x192, 0x193: 0x260,
+	0x194: 0x263, 0x196: 0x269, 0x197: 0x268, 0x198: 0x199,
+	0x19C: 0x26F, 0x19D: 0x272, 0x19F: 0x275, 0x1A0: 0x1A1,
+	0x1A2: 0x1A3, 0x1A4: 0x1A5, 0x1A6: 0x280, 0x1A7: 0x1A8,
+	0x1A9: 0x283, 0x1AC: 0x1AD, 0x1AE: 0x288, 0x1AF: 0x1B0,
+	0x1B1: 0x28A, 0x1B2: 0x28B, 0x1B3: 0x1B4, 0x1B5: 0x1B6,
+	0x1B7: 0x292, 0x1B8: 0x1B9, 0x1BC: 0x1BD, 0x1C4: 0x1C6,
+	0x1C5: 0x1C6, 0x1C7: 0x1C9, 0x1C8: 0x1C9, 0x1CA: 0x1CC,
+	0x1CB: 0x1CC, 0x1CD: 0x1CE, 0x1CF: 0x1D0, 0x1D1: 0x1D2,
+	0x1D3: 0x1D4, 0x1D5: 0x1D6, 0x1D7: 0x1D8, 0x1D9: 0x1DA,
+	0x1DB: 0x1DC, 0x1DE: 0x1DF, 0x1E0: 0x1E1, 0x1E2: 0x1E3,
+	0x1E4: 0x1E5, 0x1E6: 0x1E7, 0x1E8: 0x1E9, 0x1EA: 0x1EB,
+	0x1EC: 0x1ED, 0x1EE: 0x1EF, 0x1F1: 0x1F3, 0x1F2: 0x1F3,
+	0x1F4: 0x1F5, 0x1F6: 0x195, 0x1F7: 0x1BF, 0x1F8: 0x1F9,
+	0x1FA: 0x1FB, 0x1FC: 0x1FD, 0x1FE: 0x1FF, 0x200: 0x201,
+	0x202: 0x203, 0x204: 0x205, 0x206: 0x207, 0x208: 0x209,
+	0x20A: 0x20B, 0x20C: 0x20D, 0x20E: 0x20F, 0x210: 0x211,
+	0x212: 0x213, 0x214: 0x215, 0x216: 0x217, 0x218: 0x219,
+	0x21A: 0x21B, 0x21C: 0x21D, 0x21E: 0x21F, 0x220: 0x19E,
+	0x222: 0x223, 0x224: 0x225, 0x226: 0x227, 0x228: 0x229,
+	0x22A: 0x22B, 0x22C: 0x22D, 0x22E: 0x22F, 0x230: 0x231,
+	0x232: 0x233, 0x23A: 0x2C65, 0x23B: 0x23C, 0x23D: 0x19A,
+	0x23E: 0x2C66, 0x241: 0x242, 0x243: 0x180, 0x244: 0x289,
+	0x245: 0x28C, 0x246: 0x247, 0x248: 0x249, 0x24A: 0x24B,
+	0x24C: 0x24D, 0x24E: 0x24F, 0x370: 0x371, 0x372: 0x373,
+	0x376: 0x377, 0x37F: 0x3F3, 0x386: 0x3AC, 0x388: 0x3AD,
+	0x389: 0x3AE, 0x38A: 0x3AF, 0x38C: 0x3CC, 0x38E: 0x3CD,
+	0x38F: 0x3CE, 0x391: 0x3B1, 0x392: 0x3B2, 0x393: 0x3B3,
+	0x394: 0x3B4, 0x395: 0x3B5, 0x396: 0x3B6, 0x397: 0x3B7,
+	0x398: 0x3B8, 0x399: 0x3B9, 0x39A: 0x3BA, 0x39B: 0x3BB,
+	0x39C: 0x3BC, 0x39D: 0x3BD, 0x39E: 0x3BE, 0x39F: 0x3BF,
+	0x3A0: 0x3C0, 0x3A1: 0x3C1, 0x3A3: 0x3C3, 0x3A4: 0x3C4,
+	0x3A5: 0x3C5, 0x3A6: 0x3C6, 0x3A7: 0x3C7, 0x3A8: 0x3C8,
+	0x3A9: 0x3C9, 0x3AA: 0x3CA, 0x3AB: 0x3CB, 0x3CF: 0x3D7,
+	0x3D8: 0x3D9, 0x3DA: 0x3DB, 0x3DC: 0x3DD, 0x3DE: 0x3DF,
+	0x3E0: 0x3E1, 0x3E2: 0x3E3, 0x3E4: 0x3E5, 0x3E6: 0x3E7,
+	0x3E8: 0x3E9, 0x3EA: 0x3EB, 0x3EC: 0x3ED, 0x3EE: 0x3EF,
+	0x3F4: 0x3B8, 0x3F7: 0x3F8, 0x3F9: 0x3F2, 0x3FA: 0x3FB,
+	0x3FD: 0x37B, 0x3FE: 0x37C, 0x3FF: 0x37D, 0x400: 0x450,
+	0x401: 0x451, 0x402: 0x452, 0x403: 0x453, 0x404: 0x454,
+	0x405: 0x455, 0x406: 0x456, 0x407: 0x457, 0x408: 0x458,
+	0x409: 0x459, 0x40A: 0x45A, 0x40B: 0x45B, 0x40C: 0x45C,
+	0x40D: 0x45D, 0x40E: 0x45E, 0x40F: 0x45F, 0x410: 0x430,
+	0x411: 0x431, 0x412: 0x432, 0x413: 0x433, 0x414: 0x434,
+	0x415: 0x435, 0x416: 0x436, 0x417: 0x437, 0x418: 0x438,
+	0x419: 0x439, 0x41A: 0x43A, 0x41B: 0x43B, 0x41C: 0x43C,
+	0x41D: 0x43D, 0x41E: 0x43E, 0x41F: 0x43F, 0x420: 0x440,
+	0x421: 0x441, 0x422: 0x442, 0x423: 0x443, 0x424: 0x444,
+	0x425: 0x445, 0x426: 0x446, 0x427: 0x447, 0x428: 0x448,
+	0x429: 0x449, 0x42A: 0x44A, 0x42B: 0x44B, 0x42C: 0x44C,
+	0x42D: 0x44D, 0x42E: 0x44E, 0x42F: 0x44F, 0x460: 0x461,
+	0x462: 0x463, 0x464: 0x465, 0x466: 0x467, 0x468: 0x469,
+	0x46A: 0x46B, 0x46C: 0x46D, 0x46E: 0x46F, 0x470: 0x471,
+	0x472: 0x473, 0x474: 0x475, 0x476: 0x477, 0x478: 0x479,
+	0x47A: 0x47B, 0x47C: 0x47D, 0x47E: 0x47F, 0x480: 0x481,
+	0x48A: 0x48B, 0x48C: 0x48D, 0x48E: 0x48F, 0x490: 0x491,
+	0x492: 0x493, 0x494: 0x495, 0x496: 0x497, 0x498: 0x499,
+	0x49A: 0x49B, 0x49C: 0x49D, 0x49E: 0x49F, 0x4A0: 0x4A1,
+	0x4A2: 0x4A3, 0x4A4: 0x4A5, 0x4A6: 0x4A7, 0x4A8: 0x4A9,
+	0x4AA: 0x4AB, 0x4AC: 0x4AD, 0x4AE: 0x4AF, 0x4B0: 0x4B1,
+	0x4B2: 0x4B3, 0x4B4: 0x4B5, 0x4B6: 0x4B7, 0x4B8: 0x4B9,
+	0x4BA: 0x4BB, 0x4BC: 0x4BD, 0x4BE: 0x4BF, 0x4C0: 0x4CF,
+	0x4C1: 0x4C2, 0x4C3: 0x4C4, 0x4C5: 0x4C6, 0x4C7: 0x4C8,
+	0x4C9: 0x4CA, 0x4CB: 0x4CC, 0x4CD: 0x4CE, 0x4D0: 0x4D1,
+	0x4D2: 0x4D3, 0x4D4: 0x4D5, 0x4D6: 0x4D7, 0x4D8: 0x4D9,
+	0x4DA: 0x4DB, 0x4DC: 0x4DD, 0x4DE: 0x4DF, 0x4E0: 0x4E1,
+	0x4E2: 0x4E3, 0x4E4: 0x4E5, 0x4E6: 0x4E7, 0x4E8: 0x4E9,
+	0x4EA: 0x4EB, 0x4EC: 0x4ED, 0x4EE: 0x4EF, 0x4F0: 0x4F1,
+	0x4F2: 0x4F3, 0x4F4: 0x4F5, 0x4F6: 0x4F7, 0x4F8: 0x4F9,
+	0x4FA: 0x4FB, 0x4FC: 0x4FD, 0x4FE: 0x4FF, 0x500: 0x501,
+	0x502: 0x503, 0x504: 0x505, 0x506: 0x507, 0x508: 0x509,
+	0x50A: 0x50B, 0x50C: 0x50D, 0x50E: 0x50F, 0x510: 0x511,
+	0x512: 0x513, 0x514: 0x515, 0x516: 0x517, 0x518: 0x519,
+	0x51A: 0x51B, 0x51C: 0x51D, 0x51E: 0x51F, 0x520: 0x521,
+	0x522: 0x523, 0x524: 0x525, 0x526: 0x527, 0x528: 0x529,
+	0x52A: 0x52B, 0x52C: 0x52D, 0x52E: 0x52F, 0x531: 0x561,
+	0x532: 0x562, 0x533: 0x563, 0x534: 0x564, 0x535: 0x565,
+	0x536: 0x566, 0x537: 0x567, 0x538: 0x568, 0x539: 0x569,
+	0x53A: 0x56A, 0x53B: 0x56B, 0x53C: 0x56C, 0x53D: 0x56D,
+	0x53E: 0x56E, 0x53F: 0x56F, 0x540: 0x570, 0x541: 0x571,
+	0x542: 0x572, 0x543: 0x573, 0x544: 0x574, 0x545: 0x575,
+	0x546: 0x576, 0x547: 0x577, 0x548: 0x578, 0x549: 0x579,
+	0x54A: 0x57A, 0x54B: 0x57B, 0x54C: 0x57C, 0x54D: 0x57D,
+	0x54E: 0x57E, 0x54F: 0x57F, 0x550: 0x580, 0x551: 0x581,
+	0x552: 0x582, 0x553: 0x583, 0x554: 0x584, 0x555: 0x585,
+	0x556: 0x586, 0x10A0: 0x2D00, 0x10A1: 0x2D01, 0x10A2: 0x2D02,
+	0x10A3: 0x2D03, 0x10A4: 0x2D04, 0x10A5: 0x2D05, 0x10A6: 0x2D06,
+	0x10A7: 0x2D07, 0x10A8: 0x2D08, 0x10A9: 0x2D09, 0x10AA: 0x2D0A,
+	0x10AB: 0x2D0B, 0x10AC: 0x2D0C, 0x10AD: 0x2D0D, 0x10AE: 0x2D0E,
+	0x10AF: 0x2D0F, 0x10B0: 0x2D10, 0x10B1: 0x2D11, 0x10B2: 0x2D12,
+	0x10B3: 0x2D13, 0x10B4: 0x2D14, 0x10B5: 0x2D15, 0x10B6: 0x2D16,
+	0x10B7: 0x2D17, 0x10B8: 0x2D18, 0x10B9: 0x2D19, 0x10BA: 0x2D1A,
+	0x10BB: 0x2D1B, 0x10BC: 0x2D1C, 0x10BD: 0x2D1D, 0x10BE: 0x2D1E,
+	0x10BF: 0x2D1F, 0x10C0: 0x2D20, 0x10C1: 0x2D21, 0x10C2: 0x2D22,
+	0x10C3: 0x2D23, 0x10C4: 0x2D24, 0x10C5: 0x2D25, 0x10C7: 0x2D27,
+	0x10CD: 0x2D2D, 0x13A0: 0xAB70, 0x13A1: 0xAB71, 0x13A2: 0xAB72,
+	0x13A3: 0xAB73, 0x13A4: 0xAB74, 0x13A5: 0xAB75, 0x13A6: 0xAB76,
+	0x13A7: 0xAB77, 0x13A8: 0xAB78, 0x13A9: 0xAB79, 0x13AA: 0xAB7A,
+	0x13AB: 0xAB7B, 0x13AC: 0xAB7C, 0x13AD: 0xAB7D, 0x13AE: 0xAB7E,
+	0x13AF: 0xAB7F, 0x13B0: 0xAB80, 0x13B1: 0xAB81, 0x13B2: 0xAB82,
+	0x13B3: 0xAB83, 0x13B4: 0xAB84, 0x13B5: 0xAB85, 0x13B6: 0xAB86,
+	0x13B7: 0xAB87, 0x13B8: 0xAB88, 0x13B9: 0xAB89, 0x13BA: 0xAB8A,
+	0x13BB: 0xAB8B, 0x13BC: 0xAB8C, 0x13BD: 0xAB8D, 0x13BE: 0xAB8E,
+	0x13BF: 0xAB8F, 0x13C0: 0xAB90, 0x13C1: 0xAB91, 0x13C2: 0xAB92,
+	0x13C3: 0xAB93, 0x13C4: 0xAB94, 0x13C5: 0xAB95, 0x13C6: 0xAB96,
+	0x13C7: 0xAB97, 0x13C8: 0xAB98, 0x13C9: 0xAB99, 0x13CA: 0xAB9A,
+	0x13CB: 0xAB9B, 0x13CC: 0xAB9C, 0x13CD: 0xAB9D, 0x13CE: 0xAB9E,
+	0x13CF: 0xAB9F, 0x13D0: 0xABA0, 0x13D1: 0xABA1, 0x13D2: 0xABA2,
+	0x13D3: 0xABA3, 0x13D4: 0xABA4, 0x13D5: 0xABA5, 0x13D6: 0xABA6,
+	0x13D7: 0xABA7, 0x13D8: 0xABA8, 0x13D9: 0xABA9, 0x13DA: 0xABAA,
+	0x13DB: 0xABAB, 0x13DC: 0xABAC, 0x13DD: 0xABAD, 0x13DE: 0xABAE,
+	0x13DF: 0xABAF, 0x13E0: 0xABB0, 0x13E1: 0xABB1, 0x13E2: 0xABB2,
+	0x13E3: 0xABB3, 0x13E4: 0xABB4, 0x13E5: 0xABB5, 0x13E6: 0xABB6,
+	0x13E7: 0xABB7, 0x13E8: 0xABB8, 0x13E9: 0xABB9, 0x13EA: 0xABBA,
+	0x13EB: 0xABBB, 0x13EC: 0xABBC, 0x13ED: 0xABBD, 0x13EE: 0xABBE,
+	0x13EF: 0xABBF, 0x13F0: 0x13F8, 0x13F1: 0x13F9, 0x13F2: 0x13FA,
+	0x13F3: 0x13FB, 0x13F4: 0x13FC, 0x13F5: 0x13FD, 0x1C90: 0x10D0,
+	0x1C91: 0x10D1, 0x1C92: 0x10D2, 0x1C93: 0x10D3, 0x1C94: 0x10D4,
+	0x1C95: 0x10D5, 0x1C96: 0x10D6, 0x1C97: 0x10D7, 0x1C98: 0x10D8,
+	0x1C99: 0x10D9, 0x1C9A: 0x10DA, 0x1C9B: 0x10DB, 0x1C9C: 0x10DC,
+	0x1C9D: 0x10DD, 0x1C9E: 0x10DE, 0x1C9F: 0x10DF, 0x1CA0: 0x10E0,
+	0x1CA1: 0x10E1, 0x1CA2: 0x10E2, 0x1CA3: 0x10E3, 0x1CA4: 0x10E4,
+	0x1CA5: 0x10E5, 0x1CA6: 0x10E6, 0x1CA7: 0x10E7, 0x1CA8: 0x10E8,
+	0x1CA9: 0x10E9, 0x1CAA: 0x10EA, 0x1CAB: 0x10EB, 0x1CAC: 0x10EC,
+	0x1CAD: 0x10ED, 0x1CAE: 0x10EE, 0x1CAF: 0x10EF, 0x1CB0: 0x10F0,
+	0x1CB1: 0x10F1, 0x1CB2: 0x10F2, 0x1CB3: 0x10F3, 0x1CB4: 0x10F4,
+	0x1CB5: 0x10F5, 0x1CB6: 0x10F6, 0x1CB7: 0x10F7, 0x1CB8: 0x10F8,
+	0x1CB9: 0x10F9, 0x1CBA: 0x10FA, 0x1CBD: 0x10FD, 0x1CBE: 0x10FE,
+	0x1CBF: 0x10FF, 0x1E00: 0x1E01, 0x1E02: 0x1E03, 0x1E04: 0x1E05,
+	0x1E06: 0x1E07, 0x1E08: 0x1E09, 0x1E0A: 0x1E0B, 0x1E0C: 0x1E0D,
+	0x1E0E: 0x1E0F, 0x1E10: 0x1E11, 0x1E12: 0x1E13, 0x1E14: 0x1E15,
+	0x1E16: 0x1E17, 0x1E18: 0x1E19, 0x1E1A: 0x1E1B, 0x1E1C: 0x1E1D,
+	0x1E1E: 0x1E1F, 0x1E20: 0x1E21, 0x1E22: 0x1E23, 0x1E24: 0x1E25,
+	0x1E26: 0x1E27, 0x1E28: 0x1E29, 0x1E2A: 0x1E2B, 0x1E2C: 0x1E2D,
+	0x1E2E: 0x1E2F, 0x1E30: 0x1E31, 0x1E32: 0x1E33, 0x1E34: 0x1E35,
+	0x1E36: 0x1E37, 0x1E38: 0x1E39, 0x1E3A: 0x1E3B, 0x1E3C: 0x1E3D,
+	0x1E3E: 0x1E3F, 0x1E40: 0x1E41, 0x1E42: 0x1E43, 0x1E44: 0x1E45,
+	0x1E46: 0x1E47, 0x1E48: 0x1E49, 0x1E4A: 0x1E4B, 0x1E4C: 0x1E4D,
+	0x1E4E: 0x1E4F, 0x1E50: 0x1E51, 0x1E52: 0x1E53, 0x1E54: 0x1E55,
+	0x1E56: 0x1E57, 0x1E58: 0x1E59, 0x1E5A: 0x1E5B, 0x1E5C: 0x1E5D,
+	0x1E5E: 0x1E5F, 0x1E60: 0x1E61, 0x1E62: 0x1E63, 0x1E64: 0x1E65,
+	0x1E66: 0x1E67, 0x1E68: 0x1E69, 0x1E6A: 0x1E6B, 0x1E6C: 0x1E6D,
+	0x1E6E: 0x1E6F, 0x1E70: 0x1E71, 0x1E72: 0x1E73, 0x1E74: 0x1E75,
+	0x1E76: 0x1E77, 0x1E78: 0x1E79, 0x1E7A: 0x1E7B, 0x1E7C: 0x1E7D,
+	0x1E7E: 0x1E7F, 0x1E80: 0x1E81, 0x1E82: 0x1E83, 0x1E84: 0x1E85,
+	0x1E86: 0x1E87, 0x1E88: 0x1E89, 0x1E8A: 0x1E8B, 0x1E8C: 0x1E8D,
+	0x1E8E: 0x1E8F, 0x1E90: 0x1E91, 0x1E92: 0x1E93, 0x1E94: 0x1E95,
+	0x1E9E: 0xDF, 0x1EA0: 0x1EA1, 0x1EA2: 0x1EA3, 0x1EA4: 0x1EA5,
+	0x1EA6: 0x1EA7, 0x1EA8: 0x1EA9, 0x1EAA: 0x1EAB, 0x1EAC: 0x1EAD,
+	0x1EAE: 0x1EAF, 0x1EB0: 0x1EB1, 0x1EB2: 0x1EB3, 0x1EB4: 0x1EB5,
+	0x1EB6: 0x1EB7, 0x1EB8: 0x1EB9, 0x1EBA: 0x1EBB, 0x1EBC: 0x1EBD,
+	0x1EBE: 0x1EBF, 0x1EC0: 0x1EC1, 0x1EC2: 0x1EC3, 0x1EC4: 0x1EC5,
+	0x1EC6: 0x1EC7, 0x1EC8: 0x1EC9, 0x1ECA: 0x1ECB, 0x1ECC: 0x1ECD,
+	0x1ECE: 0x1ECF, 0x1ED0: 0x1ED1, 0x1ED2: 0x1ED3, 0x1ED4: 0x1ED5,
+	0x1ED6: 0x1ED7, 0x1ED8: 0x1ED9, 0x1EDA: 0x1EDB, 0x1EDC: 0x1EDD,
+	0x1EDE: 0x1EDF, 0x1EE0: 0x1EE1, 0x1EE2: 0x1EE3, 0x1EE4: 0x1EE5,
+	0x1EE6: 0x1EE7, 0x1EE8: 0x1EE9, 0x1EEA: 0x1EEB, 0x1EEC: 0x1EED,
+	0x1EEE: 0x1EEF, 0x1EF0: 0x1EF1, 0x1EF2: 0x1EF3, 0x1EF4: 0x1EF5,
+	0x1EF6: 0x1EF7, 0x1EF8: 0x1EF9, 0x1EFA: 0x1EFB, 0x1EFC: 0x1EFD,
+	0x1EFE: 0x1EFF, 0x1F08: 0x1F00, 0x1F09: 0x1F01, 0x1F0A: 0x1F02,
+	0x1F0B: 0x1F03, 0x1F0C: 0x1F04, 0x1F0D: 0x1F05, 0x1F0E: 0x1F06,
+	0x1F0F: 0x1F07, 0x1F18: 0x1F10, 0x1F19: 0x1F11, 0x1F1A: 0x1F12,
+	0x1F1B: 0x1F13, 0x1F1C: 0x1F14, 0x1F1D: 0x1F15, 0x1F28: 0x1F20,
+	0x1F29: 0x1F21, 0x1F2A: 0x1F22, 0x1F2B: 0x1F23, 0x1F2C: 0x1F24,
+	0x1F2D: 0x1F25, 0x1F2E: 0x1F26, 0x1F2F: 0x1F27, 0x1F38: 0x1F30,
+	0x1F39: 0x1F31, 0x1F3A: 0x1F32, 0x1F3B: 0x1F33, 0x1F3C: 0x1F34,
+	0x1F3D: 0x1F35, 0x1F3E: 0x1F36, 0x1F3F: 0x1F37, 0x1F48: 0x1F40,
+	0x1F49: 0x1F41, 0x1F4A: 0x1F42, 0x1F4B: 0x1F43, 0x1F4C: 0x1F44,
+	0x1F4D: 0x1F45, 0x1F59: 0x1F51, 0x1F5B: 0x1F53, 0x1F5D: 0x1F55,
+	0x1F5F: 0x1F57, 0x1F68: 0x1F60, 0x1F69: 0x1F61, 0x1F6A: 0x1F62,
+	0x1F6B: 0x1F63, 0x1F6C: 0x1F64, 0x1F6D: 0x1F65, 0x1F6E: 0x1F66,
+	0x1F6F: 0x1F67, 0x1F88: 0x1F80, 0x1F89: 0x1F81, 0x1F8A: 0x1F82,
+	0x1F8B: 0x1F83, 0x1F8C: 0x1F84, 0x1F8D: 0x1F85, 0x1F8E: 0x1F86,
+	0x1F8F: 0x1F87, 0x1F98: 0x1F90, 0x1F99: 0x1F91, 0x1F9A: 0x1F92,
+	0x1F9B: 0x1F93, 0x1F9C: 0x1F94, 0x1F9D: 0x1F95, 0x1F9E: 0x1F96,
+	0x1F9F: 0x1F97, 0x1FA8: 0x1FA0, 0x1FA9: 0x1FA1, 0x1FAA: 0x1FA2,
+	0x1FAB: 0x1FA3, 0x1FAC: 0x1FA4, 0x1FAD: 0x1FA5, 0x1FAE: 0x1FA6,
+	0x1FAF: 0x1FA7, 0x1FB8: 0x1FB0, 0x1FB9: 0x1FB1, 0x1FBA: 0x1F70,
+	0x1FBB: 0x1F71, 0x1FBC: 0x1FB3, 0x1FC8: 0x1F72, 0x1FC9: 0x1F73,
+	0x1FCA: 0x1F74, 0x1FCB: 0x1F75, 0x1FCC: 0x1FC3, 0x1FD8: 0x1FD0,
+	0x1FD9: 0x1FD1, 0x1FDA: 0x1F76, 0x1FDB: 0x1F77, 0x1FE8: 0x1FE0,
+	0x1FE9: 0x1FE1, 0x1FEA: 0x1F7A, 0x1FEB: 0x1F7B, 0x1FEC: 0x1FE5,
+	0x1FF8: 0x1F78, 0x1FF9: 0x1F79, 0x1FFA: 0x1F7C, 0x1FFB: 0x1F7D,
+	0x1FFC: 0x1FF3, 0x2126: 0x3C9, 0x212A: 0x6B, 0x212B: 0xE5,
+	0x2132: 0x214E, 0x2160: 0x2170, 0x2161: 0x2171, 0x2162: 0x2172,
+	0x2163: 0x2173, 0x2164: 0x2174, 0x2165: 0x2175, 0x2166: 0x2176,
+	0x2167: 0x2177, 0x2168: 0x2178, 0x2169: 0x2179, 0x216A: 0x217A,
+	0x216B: 0x217B, 0x216C: 0x217C, 0x216D: 0x217D, 0x216E: 0x217E,
+	0x216F: 0x217F, 0x2183: 0x2184, 0x24B6: 0x24D0, 0x24B7: 0x24D1,
+	0x24B8: 0x24D2, 0x24B9: 0x24D3, 0x24BA: 0x24D4, 0x24BB: 0x24D5,
+	0x24BC: 0x24D6, 0x24BD: 0x24D7, 0x24BE: 0x24D8, 0x24BF: 0x24D9,
+	0x24C0: 0x24DA, 0x24C1: 0x24DB, 0x24C2: 0x24DC, 0x24C3: 0x24DD,
+	0x24C4: 0x24DE, 0x24C5: 0x24DF, 0x24C6: 0x24E0, 0x24C7: 0x24E1,
+	0x24C8: 0x24E2, 0x24C9: 0x24E3, 0x24CA: 0x24E4, 0x24CB: 0x24E5,
+	0x24CC: 0x24E6, 0x24CD: 0x24E7, 0x24CE: 0x24E8, 0x24CF: 0x24E9,
+	0x2C00: 0x2C30, 0x2C01: 0x2C31, 0x2C02: 0x2C32, 0x2C03: 0x2C33,
+	0x2C04: 0x2C34, 0x2C05: 0x2C35, 0x2C06: 0x2C36, 0x2C07: 0x2C37,
+	0x2C08: 0x2C38, 0x2C09: 0x2C39, 0x2C0A: 0x2C3A, 0x2C0B: 0x2C3B,
+	0x2C0C: 0x2C3C, 0x2C0D: 0x2C3D, 0x2C0E: 0x2C3E, 0x2C0F: 0x2C3F,
+	0x2C10: 0x2C40, 0x2C11: 0x2C41, 0x2C12: 0x2C42, 0x2C13: 0x2C43,
+	0x2C14: 0x2C44, 0x2C15: 0x2C45, 0x2C16: 0x2C46, 0x2C17: 0x2C47,
+	0x2C18: 0x2C48, 0x2C19: 0x2C49, 0x2C1A: 0x2C4A, 0x2C1B: 0x2C4B,
+	0x2C1C: 0x2C4C, 0x2C1D: 0x2C4D, 0x2C1E: 0x2C4E, 0x2C1F: 0x2C4F,
+	0x2C20: 0x2C50, 0x2C21: 0x2C51, 0x2C22: 0x2C52, 0x2C23: 0x2C53,
+	0x2C24: 0x2C54, 0x2C25: 0x2C55, 0x2C26: 0x2C56, 0x2C27: 0x2C57,
+	0x2C28: 0x2C58, 0x2C29: 0x2C59, 0x2C2A: 0x2C5A, 0x2C2B: 0x2C5B,
+	0x2C2C: 0x2C5C, 0x2C2D: 0x2C5D, 0x2C2E: 0x2C5E, 0x2C2F: 0x2C5F,
+	0x2C60: 0x2C61, 0x2C62: 0x26B, 0x2C63: 0x1D7D, 0x2C64: 0x27D,
+	0x2C67: 0x2C68, 0x2C69: 0x2C6A, 0x2C6B: 0x2C6C, 0x2C6D: 0x251,
+	0x2C6E: 0x271, 0x2C6F: 0x250, 0x2C70: 0x252, 0x2C72: 0x2C73,
+	0x2C75: 0x2C76, 0x2C7E: 0x23F, 0x2C7F: 0x240, 0x2C80: 0x2C81,
+	0x2C82: 0x2C83, 0x2C84: 0x2C85, 0x2C86: 0x2C87, 0x2C88: 0x2C89,
+	0x2C8A: 0x2C8B, 0x2C8C: 0x2C8D, 0x2C8E: 0x2C8F, 0x2C90: 0x2C91,
+	0x2C92: 0x2C93, 0x2C94: 0x2C95, 0x2C96: 0x2C97, 0x2C98: 0x2C99,
+	0x2C9A: 0x2C9B, 0x2C9C: 0x2C9D, 0x2C9E: 0x2C9F, 0x2CA0: 0x2CA1,
+	0x2CA2: 0x2CA3, 0x2CA4: 0x2CA5, 0x2CA6: 0x2CA7, 0x2CA8: 0x2CA9,
+	0x2CAA: 0x2CAB, 0x2CAC: 0x2CAD, 0x2CAE: 0x2CAF, 0x2CB0: 0x2CB1,
+	0x2CB2: 0x2CB3, 0x2CB4: 0x2CB5, 0x2CB6: 0x2CB7, 0x2CB8: 0x2CB9,
+	0x2CBA: 0x2CBB, 0x2CBC: 0x2CBD, 0x2CBE: 0x2CBF, 0x2CC0: 0x2CC1,
+	0x2CC2: 0x2CC3, 0x2CC4: 0x2CC5, 0x2CC6: 0x2CC7, 0x2CC8: 0x2CC9,
+	0x2CCA: 0x2CCB, 0x2CCC: 0x2CCD, 0x2CCE: 0x2CCF, 0x2CD0: 0x2CD1,
+	0x2CD2: 0x2CD3, 0x2CD4: 0x2CD5, 0x2CD6: 0x2CD7, 0x2CD8: 0x2CD9,
+	0x2CDA: 0x2CDB, 0x2CDC: 0x2CDD, 0x2CDE: 0x2CDF, 0x2CE0: 0x2CE1,
+	0x2CE2: 0x2CE3, 0x2CEB: 0x2CEC, 0x2CED: 0x2CEE, 0x2CF2: 0x2CF3,
+	0xA640: 0xA641, 0xA642: 0xA643, 0xA644: 0xA645, 0xA646: 0xA647,
+	0xA648: 0xA649, 0xA64A: 0xA64B, 0xA64C: 0xA64D, 0xA64E: 0xA64F,
+	0xA650: 0xA651, 0xA652: 0xA653, 0xA654: 0xA655, 0xA656: 0xA657,
+	0xA658: 0xA659, 0xA65A: 0xA65B, 0xA65C: 0xA65D, 0xA65E: 0xA65F,
+	0xA660: 0xA661, 0xA662: 0xA663, 0xA664: 0xA665, 0xA666: 0xA667,
+	0xA668: 0xA669, 0xA66A: 0xA66B, 0xA66C: 0xA66D, 0xA680: 0xA681,
+	0xA682: 0xA683, 0xA684: 0xA685, 0xA686: 0xA687, 0xA688: 0xA689,
+	0xA68A: 0xA68B, 0xA68C: 0xA68D, 0xA68E: 0xA68F, 0xA690: 0xA691,
+	0xA692: 0xA693, 0xA694: 0xA695, 0xA696: 0xA697, 0xA698: 0xA699,
+	0xA69A: 0xA69B, 0xA722: 0xA723, 0xA724: 0xA725, 0xA726: 0xA727,
+	0xA728: 0xA729, 0xA72A: 0xA72B, 0xA72C: 0xA72D, 0xA72E: 0xA72F,
+	0xA732: 0xA733, 0xA734: 0xA735, 0xA736: 0xA737, 0xA738: 0xA739,
+	0xA73A: 0xA73B, 0xA73C: 0xA73D, 0xA73E: 0xA73F, 0xA740: 0xA741,
+	0xA742: 0xA743, 0xA744: 0xA745, 0xA746: 0xA747, 0xA748: 0xA749,
+	0xA74A: 0xA74B, 0xA74C: 0xA74D, 0xA74E: 0xA74F, 0xA750: 0xA751,
+	0xA752: 0xA753, 0xA754: 0xA755, 0xA756: 0xA757, 0xA758: 0xA759,
+	0xA75A: 0xA75B, 0xA75C: 0xA75D, 0xA75E: 0xA75F, 0xA760: 0xA761,
+	0xA762: 0xA763, 0xA764: 0xA765, 0xA766: 0xA767, 0xA768: 0xA769,
+	0xA76A: 0xA76B, 0xA76C: 0xA76D, 0xA76E: 0xA76F, 0xA779: 0xA77A,
+	0xA77B: 0xA77C, 0xA77D: 0x1D79, 0xA77E: 0xA77F, 0xA780: 0xA781,
+	0xA782: 0xA783, 0xA784: 0xA785, 0xA786: 0xA787, 0xA78B: 0xA78C,
+	0xA78D: 0x265, 0xA790: 0xA791, 0xA792: 0xA793, 0xA796: 0xA797,
+	0xA798: 0xA799, 0xA79A: 0xA79B, 0xA79C: 0xA79D, 0xA79E: 0xA79F,
+	0xA7A0: 0xA7A1, 0xA7A2: 0xA7A3, 0xA7A4: 0xA7A5, 0xA7A6: 0xA7A7,
+	0xA7A8: 0xA7A9, 0xA7AA: 0x266, 0xA7AB: 0x25C, 0xA7AC: 0x261,
+	0xA7AD: 0x26C, 0xA7AE: 0x26A, 0xA7B0: 0x29E, 0xA7B1: 0x287,
+	0xA7B2: 0x29D, 0xA7B3: 0xAB53, 0xA7B4: 0xA7B5, 0xA7B6: 0xA7B7,
+	0xA7B8: 0xA7B9, 0xA7BA: 0xA7BB, 0xA7BC: 0xA7BD, 0xA7BE: 0xA7BF,
+	0xA7C0: 0xA7C1, 0xA7C2: 0xA7C3, 0xA7C4: 0xA794, 0xA7C5: 0x282,
+	0xA7C6: 0x1D8E, 0xA7C7: 0xA7C8, 0xA7C9: 0xA7CA, 0xA7D0: 0xA7D1,
+	0xA7D6: 0xA7D7, 0xA7D8: 0xA7D9, 0xA7F5: 0xA7F6, 0xFF21: 0xFF41,
+	0xFF22: 0xFF42, 0xFF23: 0xFF43, 0xFF24: 0xFF44, 0xFF25: 0xFF45,
+	0xFF26: 0xFF46, 0xFF27: 0xFF47, 0xFF28: 0xFF48, 0xFF29: 0xFF49,
+	0xFF2A: 0xFF4A, 0xFF2B: 0xFF4B, 0xFF2C: 0xFF4C, 0xFF2D: 0xFF4D,
+	0xFF2E: 0xFF4E, 0xFF2F: 0xFF4F, 0xFF30: 0xFF50, 0xFF31: 0xFF51,
+	0xFF32: 0xFF52, 0xFF33: 0xFF53, 0xFF34: 0xFF54, 0xFF35: 0xFF55,
+	0xFF36: 0xFF56, 0xFF37: 0xFF57, 0xFF38: 0xFF58, 0xFF39: 0xFF59,
+	0xFF3A: 0xFF5A, 0x10400: 0x10428, 0x10401: 0x10429, 0x10402: 0x1042A,
+	0x10403: 0x1042B, 0x10404: 0x1042C, 0x10405: 0x1042D, 0x10406: 0x1042E,
+	0x10407: 0x1042F, 0x10408: 0x10430, 0x10409: 0x10431, 0x1040A: 0x10432,
+	0x1040B: 0x10433, 0x1040C: 0x10434, 0x1040D: 0x10435, 0x1040E: 0x10436,
+	0x1040F: 0x10437, 0x10410: 0x10438, 0x10411: 0x10439, 0x10412: 0x1043A,
+	0x10413: 0x1043B, 0x10414: 0x1043C, 0x10415: 0x1043D, 0x10416: 0x1043E,
+	0x10417: 0x1043F, 0x10418: 0x10440, 0x10419: 0x10441, 0x1041A: 0x10442,
+	0x1041B: 0x10443, 0x1041C: 0x10444, 0x1041D: 0x10445, 0x1041E: 0x10446,
+	0x1041F: 0x10447, 0x10420: 0x10448, 0x10421: 0x10449, 0x10422: 0x1044A,
+	0x10423: 0x1044B, 0x10424: 0x1044C, 0x10425: 0x1044D, 0x10426: 0x1044E,
+	0x10427: 0x1044F, 0x104B0: 0x104D8, 0x104B1: 0x104D9, 0x104B2: 0x104DA,
+	0x104B3: 0x104DB, 0x104B4: 0x104DC, 0x104B5: 0x104DD, 0x104B6: 0x104DE,
+	0x104B7: 0x104DF, 0x104B8: 0x104E0, 0x104B9: 0x104E1, 0x104BA: 0x104E2,
+	0x104BB: 0x104E3, 0x104BC: 0x104E4, 0x104BD: 0x104E5, 0x104BE: 0x104E6,
+	0x104BF: 0x104E7, 0x104C0: 0x104E8, 0x104C1: 0x104E9, 0x104C2: 0x104EA,
+	0x104C3: 0x104EB, 0x104C4: 0x104EC, 0x104C5: 0x104ED, 0x104C6: 0x104EE,
+	0x104C7: 0x104EF, 0x104C8: 0x104F0, 0x104C9: 0x104F1, 0x104CA: 0x104F2,
+	0x104CB: 0x104F3, 0x104CC: 0x104F4, 0x104CD: 0x104F5, 0x104CE: 0x104F6,
+	0x104CF: 0x104F7, 0x104D0: 0x104F8, 0x104D1: 0x104F9, 0x104D2: 0x104FA,
+	0x104D3: 0x104FB, 0x10570: 0x10597, 0x10571: 0x10598, 0x10572: 0x10599,
+	0x10573: 0x1059A, 0x10574: 0x1059B, 0x10575: 0x1059C, 0x10576: 0x1059D,
+	0x10577: 0x1059E, 0x10578: 0x1059F, 0x10579: 0x105A0, 0x1057A: 0x105A1,
+	0x1057C: 0x105A3, 0x1057D: 0x105A4, 0x1057E: 0x105A5, 0x1057F: 0x105A6,
+	0x10580: 0x105A7, 0x10581: 0x105A8, 0x10582: 0x105A9, 0x10583: 0x105AA,
+	0x10584: 0x105AB, 0x10585: 0x105AC, 0x10586: 0x105AD, 0x10587: 0x105AE,
+	0x10588: 0x105AF, 0x10589: 0x105B0, 0x1058A: 0x105B1, 0x1058C: 0x105B3,
+	0x1058D: 0x105B4, 0x1058E: 0x105B5, 0x1058F: 0x105B6, 0x10590: 0x105B7,
+	0x10591: 0x105B8, 0x10592: 0x105B9, 0x10594: 0x105BB, 0x10595: 0x105BC,
+	0x10C80: 0x10CC0, 0x10C81: 0x10CC1, 0x10C82: 0x10CC2, 0x10C83: 0x10CC3,
+	0x10C84: 0x10CC4, 0x10C85: 0x10CC5, 0x10C86: 0x10CC6, 0x10C87: 0x10CC7,
+	0x10C88: 0x10CC8, 0x10C89: 0x10CC9, 0x10C8A: 0x10CCA, 0x10C8B: 0x10CCB,
+	0x10C8C: 0x10CCC, 0x10C8D: 0x10CCD, 0x10C8E: 0x10CCE, 0x10C8F: 0x10CCF,
+	0x10C90: 0x10CD0, 0x10C91: 0x10CD1, 0x10C92: 0x10CD2, 0x10C93: 0x10CD3,
+	0x10C94: 0x10CD4, 0x10C95: 0x10CD5, 0x10C96: 0x10CD6, 0x10C97: 0x10CD7,
+	0x10C98: 0x10CD8, 0x10C99: 0x10CD9, 0x10C9A: 0x10CDA, 0x10C9B: 0x10CDB,
+	0x10C9C: 0x10CDC, 0x10C9D: 0x10CDD, 0x10C9E: 0x10CDE, 0x10C9F: 0x10CDF,
+	0x10CA0: 0x10CE0, 0x10CA1: 0x10CE1, 0x10CA2: 0x10CE2, 0x10CA3: 0x10CE3,
+	0x10CA4: 0x10CE4, 0x10CA5: 0x10CE5, 0x10CA6: 0x10CE6, 0x10CA7: 0x10CE7,
+	0x10CA8: 0x10CE8, 0x10CA9: 0x10CE9, 0x10CAA: 0x10CEA, 0x10CAB: 0x10CEB,
+	0x10CAC: 0x10CEC, 0x10CAD: 0x10CED, 0x10CAE: 0x10CEE, 0x10CAF: 0x10CEF,
+	0x10CB0: 0x10CF0, 0x10CB1: 0x10CF1, 0x10CB2: 0x10CF2, 0x118A0: 0x118C0,
+	0x118A1: 0x118C1, 0x118A2: 0x118C2, 0x118A3: 0x118C3, 0x118A4: 0x118C4,
+	0x118A5: 0x118C5, 0x118A6: 0x118C6, 0x118A7: 0x118C7, 0x118A8: 0x118C8,
+	0x118A9: 0x118C9, 0x118AA: 0x118CA, 0x118AB: 0x118CB, 0x118AC: 0x118CC,
+	0x118AD: 0x118CD, 0x118AE: 0x118CE, 0x118AF: 0x118CF, 0x118B0: 0x118D0,
+	0x118B1: 0x118D1, 0x118B2: 0x118D2, 0x118B3: 0x118D3, 0x118B4: 0x118D4,
+	0x118B5: 0x118D5, 0x118B6: 0x118D6, 0x118B7: 0x118D7, 0x118B8: 0x118D8,
+	0x118B9: 0x118D9, 0x118BA: 0x118DA, 0x118BB: 0x118DB, 0x118BC: 0x118DC,
+	0x118BD: 0x118DD, 0x118BE: 0x118DE, 0x118BF: 0x118DF, 0x16E40: 0x16E60,
+	0x16E41: 0x16E61, 0x16E42: 0x16E62, 0x16E43: 0x16E63, 0x16E44: 0x16E64,
+	0x16E45: 0x16E65, 0x16E46: 0x16E66, 0x16E47: 0x16E67, 0x16E48: 0x16E68,
+	0x16E49: 0x16E69, 0x16E4A: 0x16E6A, 0x16E4B: 0x16E6B, 0x16E4C: 0x16E6C,
+	0x16E4D: 0x16E6D, 0x16E4E: 0x16E6E, 0x16E4F: 0x16E6F, 0x16E50: 0x16E70,
+	0x16E51: 0x16E71, 0x16E52: 0x16E72, 0x16E53: 0x16E73, 0x16E54: 0x16E74,
+	0x16E55: 0x16E75, 0x16E56: 0x16E76, 0x16E57: 0x16E77, 0x16E58: 0x16E78,
+	0x16E59: 0x16E79, 0x16E5A: 0x16E7A, 0x16E5B: 0x16E7B, 0x16E5C: 0x16E7C,
+	0x16E5D: 0x16E7D, 0x16E5E: 0x16E7E, 0x16E5F: 0x16E7F, 0x1E900: 0x1E922,
+	0x1E901: 0x1E923, 0x1E902: 0x1E924, 0x1E903: 0x1E925, 0x1E904: 0x1E926,
+	0x1E905: 0x1E927, 0x1E906: 0x1E928, 0x1E907: 0x1E929, 0x1E908: 0x1E92A,
+	0x1E909: 0x1E92B, 0x1E90A: 0x1E92C, 0x1E90B: 0x1E92D, 0x1E90C: 0x1E92E,
+	0x1E90D: 0x1E92F, 0x1E90E: 0x1E930, 0x1E90F: 0x1E931, 0x1E910: 0x1E932,
+	0x1E911: 0x1E933, 0x1E912: 0x1E934, 0x1E913: 0x1E935, 0x1E914: 0x1E936,
+	0x1E915: 0x1E937, 0x1E916: 0x1E938, 0x1E917: 0x1E939, 0x1E918: 0x1E93A,
+	0x1E919: 0x1E93B, 0x1E91A: 0x1E93C, 0x1E91B: 0x1E93D, 0x1E91C: 0x1E93E,
+	0x1E91D: 0x1E93F, 0x1E91E: 0x1E940, 0x1E91F: 0x1E941, 0x1E920: 0x1E942,
+	0x1E921: 0x1E943,
+}
+
+// decompositions holds the canonical decomposition of each code point
+// which has one, which may decompose further.
+var decompositions = map[rune]string{
+	0xC0: "A\u0300", 0xC1: "A\u0301", 0xC2: "A\u0302", 0xC3: "A\u0303",
+	0xC4: "A\u0308", 0xC5: "A\u030a", 0xC7: "C\u0327", 0xC8: "E\u0300",
+	0xC9: "E\u0301", 0xCA: "E\u0302", 0xCB: "E\u0308", 0xCC: "I\u0300",
+	0xCD: "I\u0301", 0xCE: "I\u0302", 0xCF: "I\u0308", 0xD1: "N\u0303",
+	0xD2: "O\u0300", 0xD3: "O\u0301", 0xD4: "O\u0302", 0xD5: "O\u0303",
+	0xD6: "O\u0308", 0xD9: "U\u0300", 0xDA: "U\u0301", 0xDB: "U\u0302",
+	0xDC: "U\u0308", 0xDD: "Y\u0301", 0xE0: "a\u0300", 0xE1: "a\u0301",
+	0xE2: "a\u0302", 0xE3: "a\u0303", 0xE4: "a\u0308", 0xE5: "a\u030a",
+	0xE7: "c\u0327", 0xE8: "e\u0300", 0xE9: "e\u0301", 0xEA: "e\u0302",
+	0xEB: "e\u0308", 0xEC: "i\u0300", 0xED: "i\u0301", 0xEE: "i\u0302",
+	0xEF: "i\u0308", 0xF1: "n\u0303", 0xF2: "o\u0300", 0xF3: "o\u0301",
+	0xF4: "o\u0302", 0xF5: "o\u0303", 0xF6: "o\u0308", 0xF9: "u\u0300",
+	0xFA: "u\u0301", 0xFB: "u\u0302", 0xFC: "u\u0308", 0xFD: "y\u0301",
+	0xFF: "y\u0308", 0x100: "A\u0304", 0x101: "a\u0304", 0x102: "A\u0306",
+	0x103: "a\u0306", 0x104: "A\u0328", 0x105: "a\u0328", 0x106: "C\u0301",
+	0x107: "c\u0301", 0x108: "C\u0302", 0x109: "c\u0302", 0x10A: "C\u0307",
+	0x10B: "c\u0307", 0x10C: "C\u030c", 0x10D: "c\u030c", 0x10E: "D\u030c",
+	0x10F: "d\u030c", 0x112: "E\u0304", 0x113: "e\u0304", 0x114: "E\u0306",
+	0x115: "e\u0306", 0x116: "E\u0307", 0x117: "e\u0307", 0x118: "E\u0328",
+	0x119: "e\u0328", 0x11A: "E\u030c", 0x11B: "e\u030c", 0x11C: "G\u0302",
+	0x11D: "g\u0302", 0x11E: "G\u0306", 0x11F: "g\u0306", 0x120: "G\u0307",
+	0x121: "g\u0307", 0x122: "G\u0327", 0x123: "g\u0327", 0x124: "H\u0302",
+	0x125: "h\u0302", 0x128: "I\u0303", 0x129: "i\u0303", 0x12A: "I\u0304",
+	0x12B: "i\u0304", 0x12C: "I\u0306", 0x12D: "i\u0306", 0x12E: "I\u0328",
+	0x12F: "i\u0328", 0x130: "I\u0307", 0x134: "J\u0302", 0x135: "j\u0302",
+	0x136: "K\u0327", 0x137: "k\u0327", 0x139: "L\u0301", 0x13A: "l\u0301",
+	0x13B: "L\u0327", 0x13C: "l\u0327", 0x13D: "L\u030c", 0x13E: "l\u030c",
+	0x143: "N\u0301", 0x144: "n\u0301", 0x145: "N\u0327", 0x146: "n\u0327",
+	0x147: "N\u030c", 0x148: "n\u030c", 0x14C: "O\u0304", 0x14D: "o\u0304",
+	0x14E: "O\u0306", 0x14F: "o\u0306", 0x150: "O\u030b", 0x151: "o\u030b",
+	0x154: "R\u0301", 0x155: "r\u0301", 0x156: "R\u0327", 0x157: "r\u0327",
+	0x158: "R\u030c", 0x159: "r\u030c", 0x15A: "S\u0301", 0x15B: "s\u0301",
+	0x15C: "S\u0302", 0x15D: "s\u0302", 0x15E: "S\u0327", 0x15F: "s\u0327",
+	0x160: "S\u030c", 0x161: "s\u030c", 0x162: "T\u0327", 0x163: "t\u0327",
+	0x164: "T\u030c", 0x165: "t\u030c", 0x168: "U\u0303", 0x169: "u\u0303",
+	0x16A: "U\u0304", 0x16B: "u\u0304", 0x16C: "U\u0306", 0x16D: "u\u0306",
+	0x16E: "U\u030a", 0x16F: "u\u030a", 0x170: "U\u030b", 0x171: "u\u030b",
+	0x172: "U\u0328", 0x173: "u\u0328", 0x174: "W\u0302", 0x175: "w\u0302",
+	0x176: "Y\u0302", 0x177: "y\u0302", 0x178: "Y\u0308", 0x179: "Z\u0301",
+	0x17A: "z\u0301", 0x17B: "Z\u0307", 0x17C: "z\u0307", 0x17D: "Z\u030c",
+	0x17E: "z\u030c", 0x1A0: "O\u031b", 0x1A1: "o\u031b", 0x1AF: "U\u031b",
+	0x1B0: "u\u031b", 0x1CD: "A\u030c", 0x1CE: "a\u030c", 0x1CF: "I\u030c",
+	0x1D0: "i\u030c", 0x1D1: "O\u030c", 0x1D2: "o\u030c", 0x1D3: "U\u030c",
+	0x1D4: "u\u030c", 0x1D5: "\u00dc\u0304", 0x1D6: "\u00fc\u0304", 0x1D7: "\u00dc\u0301",
+	0x1D8: "\u00fc\u0301", 0x1D9: "\u00dc\u030c", 0x1DA: "\u00fc\u030c", 0x1DB: "\u00dc\u0300",
+	0x1DC: "\u00fc\u0300", 0x1DE: "\u00c4\u0304", 0x1DF: "\u00e4\u0304", 0x1E0: "\u0226\u0304",
+	0x1E1: "\u0227\u0304", 0x1E2: "\u00c6\u0304", 0x1E3: "\u00e6\u0304", 0x1E6: "G\u030c",
+	0x1E7: "g\u030c", 0x1E8: "K\u030c", 0x1E9: "k\u030c", 0x1EA: "O\u0328",
+	0x1EB: "o\u0328", 0x1EC: "\u01ea\u0304", 0x1ED: "\u01eb\u0304", 0x1EE: "\u01b7\u030c",
+	0x1EF: "\u0292\u030c", 0x1F0: "j\u030c", 0x1F4: "G\u0301", 0x1F5: "g\u0301",
+	0x1F8: "N\u0300", 0x1F9: "n\u0300", 0x1FA: "\u00c5\u0301", 0x1FB: "\u00e5\u0301",
+	0x1FC: "\u00c6\u0301", 0x1FD: "\u00e6\u0301", 0x1FE: "\u00d8\u0301", 0x1FF: "\u00f8\u0301",
+	0x200: "A\u030f", 0x201: "a\u030f", 0x202: "A\u0311", 0x203: "a\u0311",
+	0x204: "E\u030f", 0x205: "e\u030f", 0x206: "E\u0311", 0x207: "e\u0311",
+	0x208: "I\u030f", 0x209: "i\u030f", 0x20A: "I\u0311", 0x20B: "i\u0311",
+	0x20C: "O\u030f", 0x20D: "o\u030f", 0x20E: "O\u0311", 0x20F: "o\u0311",
+	0x210: "R\u030f", 0x211: "r\u030f", 0x212: "R\u0311", 0x213: "r\u0311",
+	0x214: "U\u030f", 0x215: "u\u030f", 0x216: "U\u0311", 0x217: "u\u0311",
+	0x218: "S\u0326", 0x219: "s\u0326", 0x21A: "T\u0326", 0x21B: "t\u0326",
+	0x21E: "H\u030c", 0x21F: "h\u030c", 0x226: "A\u0307", 0x227: "a\u0307",
+	0x228: "E\u0327", 0x229: "e\u0327", 0x22A: "\u00d6\u0304", 0x22B: "\u00f6\u0304",
+	0x22C: "\u00d5\u0304", 0x22D: "\u00f5\u0304", 0x22E: "O\u0307", 0x22F: "o\u0307",
+	0x230: "\u022e\u0304", 0x231: "\u022f\u0304", 0x232: "Y\u0304", 0x233: "y\u0304",
+	0x340: "\u0300", 0x341: "\u0301", 0x343: "\u0313", 0x344: "\u0308\u0301",
+	0x374: "\u02b9", 0x37E: ";", 0x385: "\u00a8\u0301", 0x386: "\u0391\u0301",
+	0x387: "\u00b7", 0x388: "\u0395\u0301", 0x389: "\u0397\u0301", 0x38A: "\u0399\u0301",
+	0x38C: "\u039f\u0301", 0x38E: "\u03a5\u0301", 0x38F: "\u03a9\u0301", 0x390: "\u03ca\u0301",
+	0x3AA: "\u0399\u0308", 0x3AB: "\u03a5\u0308", 0x3AC: "\u03b1\u0301", 0x3AD: "\u03b5\u0301",
+	0x3AE: "\u03b7\u0301", 0x3AF: "\u03b9\u0301", 0x3B0: "\u03cb\u0301", 0x3CA: "\u03b9\u0308",
+	0x3CB: "\u03c5\u0308", 0x3CC: "\u03bf\u0301", 0x3CD: "\u03c5\u0301", 0x3CE: "\u03c9\u0301",
+	0x3D3: "\u03d2\u0301", 0x3D4: "\u03d2\u0308", 0x400: "\u0415\u0300", 0x401: "\u0415\u0308",
+	0x403: "\u0413\u0301", 0x407: "\u0406\u0308", 0x40C: "\u041a\u0301", 0x40D: "\u0418\u0300",
+	0x40E: "\u0423\u0306", 0x419: "\u0418\u0306", 0x439: "\u0438\u0306", 0x450: "\u0435\u0300",
+	0x451: "\u0435\u0308", 0x453: "\u0433\u0301", 0x457: "\u0456\u0308", 0x45C: "\u043a\u0301",
+	0x45D: "\u0438\u0300", 0x45E: "\u0443\u0306", 0x476: "\u0474\u030f", 0x477: "\u0475\u030f",
+	0x4C1: "\u0416\u0306", 0x4C2: "\u0436\u0306", 0x4D0: "\u0410\u0306", 0x4D1: "\u0430\u0306",
+	0x4D2: "\u0410\u0308", 0x4D3: "\u0430\u0308", 0x4D6: "\u0415\u0306", 0x4D7: "\u0435\u0306",
+	0x4DA: "\u04d8\u0308", 0x4DB: "\u04d9\u0308", 0x4DC: "\u0416\u0308", 0x4DD: "\u0436\u0308",
+	0x4DE: "\u0417\u0308", 0x4DF: "\u0437\u0308", 0x4E2: "\u0418\u0304", 0x4E3: "\u0438\u0304",
+	0x4E4: "\u0418\u0308", 0x4E5: "\u0438\u0308", 0x4E6: "\u041e\u0308", 0x4E7: "\u043e\u0308",
+	0x4EA: "\u04e8\u0308", 0x4EB: "\u04e9\u0308", 0x4EC: "\u042d\u0308", 0x4ED: "\u044d\u0308",
+	0x4EE: "\u0423\u0304", 0x4EF: "\u0443\u0304", 0x4F0: "\u0423\u0308", 0x4F1: "\u0443\u0308",
+	0x4F2: "\u0423\u030b", 0x4F3: "\u0443\u030b", 0x4F4: "\u0427\u0308", 0x4F5: "\u0447\u0308",
+	0x4F8: "\u042b\u0308", 0x4F9: "\u044b\u0308", 0x622: "\u0627\u0653", 0x623: "\u0627\u0654",
+	0x624: "\u0648\u0654", 0x625: "\u0627\u0655", 0x626: "\u064a\u0654", 0x6C0: "\u06d5\u0654",
+	0x6C2: "\u06c1\u0654", 0x6D3: "\u06d2\u0654", 0x929: "\u0928\u093c", 0x931: "\u0930\u093c",
+	0x934: "\u0933\u093c", 0x958: "\u0915\u093c", 0x959: "\u0916\u093c", 0x95A: "\u0917\u093c",
+	0x95B: "\u091c\u093c", 0x95C: "\u0921\u093c", 0x95D: "\u0922\u093c", 0x95E: "\u092b\u093c",
+	0x95F: "\u092f\u093c", 0x9CB: "\u09c7\u09be", 0x9CC: "\u09c7\u09d7", 0x9DC: "\u09a1\u09bc",
+	0x9DD: "\u09a2\u09bc", 0x9DF: "\u09af\u09bc", 0xA33: "\u0a32\u0a3c", 0xA36: "\u0a38\u0a3c",
+	0xA59: "\u0a16\u0a3c", 0xA5A: "\u0a17\u0a3c", 0xA5B: "\u0a1c\u0a3c", 0xA5E: "\u0a2b\u0a3c",
+	0xB48: "\u0b47\u0b56", 0xB4B: "\u0b47\u0b3e", 0xB4C: "\u0b47\u0b57", 0xB5C: "\u0b21\u0b3c",
+	0xB5D: "\u0b22\u0b3c", 0xB94: "\u0b92\u0bd7", 0xBCA: "\u0bc6\u0bbe", 0xBCB: "\u0bc7\u0bbe",
+	0xBCC: "\u0bc6\u0bd7", 0xC48: "\u0c46\u0c56", 0xCC0: "\u0cbf\u0cd5", 0xCC7: "\u0cc6\u0cd5",
+	0xCC8: "\u0cc6\u0cd6", 0xCCA: "\u0cc6\u0cc2", 0xCCB: "\u0cca\u0cd5", 0xD4A: "\u0d46\u0d3e",
+	0xD4B: "\u0d47\u0d3e", 0xD4C: "\u0d46\u0d57", 0xDDA: "\u0dd9\u0dca", 0xDDC: "\u0dd9\u0dcf",
+	0xDDD: "\u0ddc\u0dca", 0xDDE: "\u0dd9\u0ddf", 0xF43: "\u0f42\u0fb7", 0xF4D: "\u0f4c\u0fb7",
+	0xF52: "\u0f51\u0fb7", 0xF57: "\u0f56\u0fb7", 0xF5C: "\u0f5b\u0fb7", 0xF69: "\u0f40\u0fb5",
+	0xF73: "\u0f71\u0f72", 0xF75: "\u0f71\u0f74", 0xF76: "\u0fb2\u0f80", 0xF78: "\u0fb3\u0f80",
+	0xF81: "\u0f71\u0f80", 0xF93: "\u0f92\u0fb7", 0xF9D: "\u0f9c\u0fb7", 0xFA2: "\u0fa1\u0fb7",
+	0xFA7: "\u0fa6\u0fb7", 0xFAC: "\u0fab\u0fb7", 0xFB9: "\u0f90\u0fb5", 0x1026: "\u1025\u102e",
+	0x1B06: "\u1b05\u1b35", 0x1B08: "\u1b07\u1b35", 0x1B0A: "\u1b09\u1b35", 0x1B0C: "\u1b0b\u1b35",
+	0x1B0E: "\u1b0d\u1b35", 0x1B12: "\u1b11\u1b35", 0x1B3B: "\u1b3a\u1b35", 0x1B3D: "\u1b3c\u1b35",
+	0x1B40: "\u1b3e\u1b35", 0x1B41: "\u1b3f\u1b35", 0x1B43: "\u1b42\u1b35", 0x1E00: "A\u0325",
+	0x1E01: "a\u0325", 0x1E02: "B\u0307", 0x1E03: "b\u0307", 0x1E04: "B\u0323",
+	0x1E05: "b\u0323", 0x1E06: "B\u0331", 0x1E07: "b\u0331", 0x1E08: "\u00c7\u0301",
+	0x1E09: "\u00e7\u0301", 0x1E0A: "D\u0307", 0x1E0B: "d\u0307", 0x1E0C: "D\u0323",
+	0x1E0D: "d\u0323", 0x1E0E: "D\u0331", 0x1E0F: "d\u0331", 0x1E10: "D\u0327",
+	0x1E11: "d\u0327", 0x1E12: "D\u032d", 0x1E13: "d\u032d", 0x1E14: "\u0112\u0300",
+	0x1E15: "\u0113\u0300", 0x1E16: "\u0112\u0301", 0x1E17: "\u0113\u0301", 0x1E18: "E\u032d",
+	0x1E19: "e\u032d", 0x1E1A: "E\u0330", 0x1E1B: "e\u0330", 0x1E1C: "\u0228\u0306",
+	0x1E1D: "\u0229\u0306", 0x1E1E: "F\u0307", 0x1E1F: "f\u0307", 0x1E20: "G\u0304",
+	0x1E21: "g\u0304", 0x1E22: "H\u0307", 0x1E23: "h\u0307", 0x1E24: "H\u0323",
+	0x1E25: "h\u0323", 0x1E26: "H\u0308", 0x1E27: "h\u0308", 0x1E28: "H\u0327",
+	0x1E29: "h\u0327", 0x1E2A: "H\u032e", 0x1E2B: "h\u032e", 0x1E2C: "I\u0330",
+	0x1E2D: "i\u0330", 0x1E2E: "\u00cf\u0301", 0x1E2F: "\u00ef\u0301", 0x1E30: "K\u0301",
+	0x1E31: "k\u0301", 0x1E32: "K\u0323", 0x1E33: "k\u0323", 0x1E34: "K\u0331",
+	0x1E35: "k\u0331", 0x1E36: "L\u0323", 0x1E37: "l\u0323", 0x1E38: "\u1e36\u0304",
+	0x1E39: "\u1e37\u0304", 0x1E3A: "L\u0331", 0x1E3B: "l\u0331", 0x1E3C: "L\u032d",
+	0x1E3D: "l\u032d", 0x1E3E: "M\u0301", 0x1E3F: "m\u0301", 0x1E40: "M\u0307",
+	0x1E41: "m\u0307", 0x1E42: "M\u0323", 0x1E43: "m\u0323", 0x1E44: "N\u0307",
+	0x1E45: "n\u0307", 0x1E46: "N\u0323", 0x1E47: "n\u0323", 0x1E48: "N\u0331",
+	0x1E49: "n\u0331", 0x1E4A: "N\u032d", 0x1E4B: "n\u032d", 0x1E4C: "\u00d5\u0301",
+	0x1E4D: "\u00f5\u0301", 0x1E4E: "\u00d5\u0308", 0x1E4F: "\u00f5\u0308", 0x1E50: "\u014c\u0300",
+	0x1E51: "\u014d\u0300", 0x1E52: "\u014c\u0301", 0x1E53: "\u014d\u0301", 0x1E54: "P\u0301",
+	0x1E55: "p\u0301", 0x1E56: "P\u0307", 0x1E57: "p\u0307", 0x1E58: "R\u0307",
+	0x1E59: "r\u0307", 0x1E5A: "R\u0323", 0x1E5B: "r\u0323", 0x1E5C: "\u1e5a\u0304",
+	0x1E5D: "\u1e5b\u0304", 0x1E5E: "R\u0331", 0x1E5F: "r\u0331", 0x1E60: "S\u0307",
+	0x1E61: "s\u0307", 0x1E62: "S\u0323", 0x1E63: "s\u0323", 0x1E64: "\u015a\u0307",
+	0x1E65: "\u015b\u0307", 0x1E66: "\u0160\u0307", 0x1E67: "\u0161\u0307", 0x1E68: "\u1e62\u0307",
+	0x1E69: "\u1e63\u0307", 0x1E6A: "T\u0307", 0x1E6B: "t\u0307", 0x1E6C: "T\u0323",
+	0x1E6D: "t\u0323", 0x1E6E: "T\u0331", 0x1E6F: "t\u0331", 0x1E70: "T\u032d",
+	0x1E71: "t\u032d", 0x1E72: "U\u0324", 0x1E73: "u\u0324", 0x1E74: "U\u0330",
+	0x1E75: "u\u0330", 0x1E76: "U\u032d", 0x1E77: "u\u032d", 0x1E78: "\u0168\u0301",
+	0x1E79: "\u0169\u0301", 0x1E7A: "\u016a\u0308", 0x1E7B: "\u016b\u0308", 0x1E7C: "V\u0303",
+	0x1E7D: "v\u0303", 0x1E7E: "V\u0323", 0x1E7F: "v\u0323", 0x1E80: "W\u0300",
+	0x1E81: "w\u0300", 0x1E82: "W\u0301", 0x1E83: "w\u0301", 0x1E84: "W\u0308",
+	0x1E85: "w\u0308", 0x1E86: "W\u0307", 0x1E87: "w\u0307", 0x1E88: "W\u0323",
+	0x1E89: "w\u0323", 0x1E8A: "X\u0307", 0x1E8B: "x\u0307", 0x1E8C: "X\u0308",
+	0x1E8D: "x\u0308", 0x1E8E: "Y\u0307", 0x1E8F: "y\u0307", 0x1E90: "Z\u0302",
+	0x1E91: "z\u0302", 0x1E92: "Z\u0323", 0x1E93: "z\u0323", 0x1E94: "Z\u0331",
+	0x1E95: "z\u0331", 0x1E96: "h\u0331", 0x1E97: "t\u0308", 0x1E98: "w\u030a",
+	0x1E99: "y\u030a", 0x1E9B: "\u017f\u0307", 0x1EA0: "A\u0323", 0x1EA1: "a\u0323",
+	0x1EA2: "A\u0309", 0x1EA3: "a\u0309", 0x1EA4: "\u00c2\u0301", 0x1EA5: "\u00e2\u0301",
+	0x1EA6: "\u00c2\u0300", 0x1EA7: "\u00e2\u0300", 0x1EA8: "\u00c2\u0309", 0x1EA9: "\u00e2\u0309",
+	0x1EAA: "\u00c2\u0303", 0x1EAB: "\u00e2\u0303", 0x1EAC: "\u1ea0\u0302", 0x1EAD: "\u1ea1\u0302",
+	0x1EAE: "\u0102\u0301", 0x1EAF: "\u0103\u0301", 0x1EB0: "\u0102\u0300", 0x1EB1: "\u0103\u0300",
+	0x1EB2: "\u0102\u0309", 0x1EB3: "\u0103\u0309", 0x1EB4: "\u0102\u0303", 0x1EB5: "\u0103\u0303",
+	0x1EB6: "\u1ea0\u0306", 0x1EB7: "\u1ea1\u0306", 0x1EB8: "E\u0323", 0x1EB9: "e\u0323",
+	0x1EBA: "E\u0309", 0x1EBB: "e\u0309", 0x1EBC: "E\u0303", 0x1EBD: "e\u0303",
+	0x1EBE: "\u00ca\u0301", 0x1EBF: "\u00ea\u0301", 0x1EC0: "\u00ca\u0300", 0x1EC1: "\u00ea\u0300",
+	0x1EC2: "\u00ca\u0309", 0x1EC3: "\u00ea\u0309", 0x1EC4: "\u00ca\u0303", 0x1EC5: "\u00ea\u0303",
+	0x1EC6: "\u1eb8\u0302", 0x1EC7: "\u1eb9\u0302", 0x1EC8: "I\u0309", 0x1EC9: "i\u0309",
+	0x1ECA: "I\u0323", 0x1ECB: "i\u0323", 0x1ECC: "O\u0323", 0x1ECD: "o\u0323",
+	0x1ECE: "O\u0309", 0x1ECF: "o\u0309", 0x1ED0: "\u00d4\u0301", 0x1ED1: "\u00f4\u0301",
+	0x1ED2: "\u00d4\u0300", 0x1ED3: "\u00f4\u0300", 0x1ED4: "\u00d4\u0309", 0x1ED5: "\u00f4\u0309",
+	0x1ED6: "\u00d4\u0303", 0x1ED7: "\u00f4\u0303", 0x1ED8: "\u1ecc\u0302", 0x1ED9: "\u1ecd\u0302",
+	0x1EDA: "\u01a0\u0301", 0x1EDB: "\u01a1\u0301", 0x1EDC: "\u01a0\u0300", 0x1EDD: "\u01a1\u0300",
+	0x1EDE: "\u01a0\u0309", 0x1EDF: "\u01a1\u0309", 0x1EE0: "\u01a0\u0303", 0x1EE1: "\u01a1\u0303",
+	0x1EE2: "\u01a0\u0323", 0x1EE3: "\u01a1\u0323", 0x1EE4: "U\u0323", 0x1EE5: "u\u0323",
+	0x1EE6: "U\u0309", 0x1EE7: "u\u0309", 0x1EE8: "\u01af\u0301", 0x1EE9: "\u01b0\u0301",
+	0x1EEA: "\u01af\u0300", 0x1EEB: "\u01b0\u0300", 0x1EEC: "\u01af\u0309", 0x1EED: "\u01b0\u0309",
+	0x1EEE: "\u01af\u0303", 0x1EEF: "\u01b0\u0303", 0x1EF0: "\u01af\u0323", 0x1EF1: "\u01b0\u0323",
+	0x1EF2: "Y\u0300", 0x1EF3: "y\u0300", 0x1EF4: "Y\u0323", 0x1EF5: "y\u0323",
+	0x1EF6: "Y\u0309", 0x1EF7: "y\u0309", 0x1EF8: "Y\u0303", 0x1EF9: "y\u0303",
+	0x1F00: "\u03b1\u0313", 0x1F01: "\u03b1\u0314", 0x1F02: "\u1f00\u0300", 0x1F03: "\u1f01\u0300",
+	0x1F04: "\u1f00\u0301", 0x1F05: "\u1f01\u0301", 0x1F06: "\u1f00\u0342", 0x1F07: "\u1f01\u0342",
+	0x1F08: "\u0391\u0313", 0x1F09: "\u0391\u0314", 0x1F0A: "\u1f08\u0300", 0x1F0B: "\u1f09\u0300",
+	0x1F0C: "\u1f08\u0301", 0x1F0D: "\u1f09\u0301", 0x1F0E: "\u1f08\u0342", 0x1F0F: "\u1f09\u0342",
+	0x1F10: "\u03b5\u0313", 0x1F11: "\u03b5\u0314", 0x1F12: "\u1f10\u0300", 0x1F13: "\u1f11\u0300",
+	0x1F14: "\u1f10\u0301", 0x1F15: "\u1f11\u0301", 0x1F18: "\u0395\u0313", 0x1F19: "\u0395\u0314",
+	0x1F1A: "\u1f18\u0300", 0x1F1B: "\u1f19\u0300", 0x1F1C: "\u1f18\u0301", 0x1F1D: "\u1f19\u0301",
+	0x1F20: "\u03b7\u0313", 0x1F21: "\u03b7\u0314", 0x1F22: "\u1f20\u0300", 0x1F23: "\u1f21\u0300",
+	0x1F24: "\u1f20\u0301", 0x1F25: "\u1f21\u0301", 0x1F26: "\u1f20\u0342", 0x1F27: "\u1f21\u0342",
+	0x1F28: "\u0397\u0313", 0x1F29: "\u0397\u0314", 0x1F2A: "\u1f28\u0300", 0x1F2B: "\u1f29\u0300",
+	0x1F2C: "\u1f28\u0301", 0x1F2D: "\u1f29\u0301", 0x1F2E: "\u1f28\u0342", 0x1F2F: "\u1f29\u0342",
+	0x1F30: "\u03b9\u0313", 0x1F31: "\u03b9\u0314", 0x1F32: "\u1f30\u0300", 0x1F33: "\u1f31\u0300",
+	0x1F34: "\u1f30\u0301", 0x1F35: "\u1f31\u0301", 0x1F36: "\u1f30\u0342", 0x1F37: "\u1f31\u0342",
+	0x1F38: "\u0399\u0313", 0x1F39: "\u0399\u0314", 0x1F3A: "\u1f38\u0300", 0x1F3B: "\u1f39\u0300",
+	0x1F3C: "\u1f38\u0301", 0x1F3D: "\u1f39\u0301", 0x1F3E: "\u1f38\u0342", 0x1F3F: "\u1f39\u0342",
+	0x1F40: "\u03bf\u0313", 0x1F41: "\u03bf\u0314", 0x1F42: "\u1f40\u0300", 0x1F43: "\u1f41\u0300",
+	0x1F44: "\u1f40\u0301", 0x1F45: "\u1f41\u0301", 0x1F48: "\u039f\u0313", 0x1F49: "\u039f\u0314",
+	0x1F4A: "\u1f48\u0300", 0x1F4B: "\u1f49\u0300", 0x1F4C: "\u1f48\u0301", 0x1F4D: "\u1f49\u0301",
+	0x1F50: "\u03c5\u0313", 0x1F51: "\u03c5\u0314", 0x1F52: "\u1f50\u0300", 0x1F53: "\u1f51\u0300",
+	0x1F54: "\u1f50\u0301", 0x1F55: "\u1f51\u0301", 0x1F56: "\u1f50\u0342", 0x1F57: "\u1f51\u0342",
+	0x1F59: "\u03a5\u0314", 0x1F5B: "\u1f59\u0300", 0x1F5D: "\u1f59\u0301", 0x1F5F: "\u1f59\u0342",
+	0x1F60: "\u03c9\u0313", 0x1F61: "\u03c9\u0314", 0x1F62: "\u1f60\u0300", 0x1F63: "\u1f61\u0300",
+	0x1F64: "\u1f60\u0301", 0x1F65: "\u1f61\u0301", 0x1F66: "\u1f60\u0342", 0x1F67: "\u1f61\u0342",
+	0x1F68: "\u03a9\u0313", 0x1F69: "\u03a9\u0314", 0x1F6A: "\u1f68\u0300", 0x1F6B: "\u1f69\u0300",
+	0x1F6C: "\u1f68\u0301", 0x1F6D: "\u1f69\u0301", 0x1F6E: "\u1f68\u0342", 0x1F6F: "\u1f69\u0342",
+	0x1F70: "\u03b1\u0300", 0x1F71: "\u03ac", 0x1F72: "\u03b5\u0300", 0x1F73: "\u03ad",
+	0x1F74: "\u03b7\u0300", 0x1F75: "\u03ae", 0x1F76: "\u03b9\u0300", 0x1F77: "\u03af",
+	0x1F78: "\u03bf\u0300", 0x1F79: "\u03cc", 0x1F7A: "\u03c5\u0300", 0x1F7B: "\u03cd",
+	0x1F7C: "\u03c9\u0300", 0x1F7D: "\u03ce", 0x1F80: "\u1f00\u0345", 0x1F81: "\u1f01\u0345",
+	0x1F82: "\u1f02\u0345", 0x1F83: "\u1f03\u0345", 0x1F84: "\u1f04\u0345", 0x1F85: "\u1f05\u0345",
+	0x1F86: "\u1f06\u0345", 0x1F87: "\u1f07\u0345", 0x1F88: "\u1f08\u0345", 0x1F89: "\u1f09\u0345",
+	0x1F8A: "\u1f0a\u0345", 0x1F8B: "\u1f0b\u0345", 0x1F8C: "\u1f0c\u0345", 0x1F8D: "\u1f0d\u0345",
+	0x1F8E: "\u1f0e\u0345", 0x1F8F: "\u1f0f\u0345", 0x1F90: "\u1f20\u0345", 0x1F91: "\u1f21\u0345",
+	0x1F92: "\u1f22\u0345", 0x1F93: "\u1f23\u0345", 0x1F94: "\u1f24\u0345", 0x1F95: "\u1f25\u0345",
+	0x1F96: "\u1f26\u0345", 0x1F97: "\u1f27\u0345", 0x1F98: "\u1f28\u0345", 0x1F99: "\u1f29\u0345",
+	0x1F9A: "\u1f2a\u0345", 0x1F9B: "\u1f2b\u0345", 0x1F9C: "\u1f2c\u0345", 0x1F9D: "\u1f2d\u0345",
+	0x1F9E: "\u1f2e\u0345", 0x1F9F: "\u1f2f\u0345", 0x1FA0: "\u1f60\u0345", 0x1FA1: "\u1f61\u0345",
+	0x1FA2: "\u1f62\u0345", 0x1FA3: "\u1f63\u0345", 0x1FA4: "\u1f64\u0345", 0x1FA5: "\u1f65\u0345",
+	0x1FA6: "\u1f66\u0345", 0x1FA7: "\u1f67\u0345", 0x1FA8: "\u1f68\u0345", 0x1FA9: "\u1f69\u0345",
+	0x1FAA: "\u1f6a\u0345", 0x1FAB: "\u1f6b\u0345", 0x1FAC: "\u1f6c\u0345", 0x1FAD: "\u1f6d\u0345",
+	0x1FAE: "\u1f6e\u0345", 0x1FAF: "\u1f6f\u0345", 0x1FB0: "\u03b1\u0306", 0x1FB1: "\u03b1\u0304",
+	0x1FB2: "\u1f70\u0345", 0x1FB3: "\u03b1\u0345", 0x1FB4: "\u03ac\u0345", 0x1FB6: "\u03b1\u0342",
+	0x1FB7: "\u1fb6\u0345", 0x1FB8: "\u0391\u0306", 0x1FB9: "\u0391\u0304", 0x1FBA: "\u0391\u0300",
+	0x1FBB: "\u0386", 0x1FBC: "\u0391\u0345", 0x1FBE: "\u03b9", 0x1FC1: "\u00a8\u0342",
+	0x1FC2: "\u1f74\u0345", 0x1FC3: "\u03b7\u0345", 0x1FC4: "\u03ae\u0345", 0x1FC6: "\u03b7\u0342",
+	0x1FC7: "\u1fc6\u0345", 0x1FC8: "\u0395\u0300", 0x1FC9: "\u0388", 0x1FCA: "\u0397\u0300",
+	0x1FCB: "\u0389", 0x1FCC: "\u0397\u0345", 0x1FCD: "\u1fbf\u0300", 0x1FCE: "\u1fbf\u0301",
+	0x1FCF: "\u1fbf\u0342", 0x1FD0: "\u03b9\u0306", 0x1FD1: "\u03b9\u0304", 0x1FD2: "\u03ca\u0300",
+	0x1FD3: "\u0390", 0x1FD6: "\u03b9\u0342", 0x1FD7: "\u03ca\u0342", 0x1FD8: "\u0399\u0306",
+	0x1FD9: "\u0399\u0304", 0x1FDA: "\u0399\u0300", 0x1FDB: "\u038a", 0x1FDD: "\u1ffe\u0300",
+	0x1FDE: "\u1ffe\u0301", 0x1FDF: "\u1ffe\u0342", 0x1FE0: "\u03c5\u0306", 0x1FE1: "\u03c5\u0304",
+	0x1FE2: "\u03cb\u0300", 0x1FE3: "\u03b0", 0x1FE4: "\u03c1\u0313", 0x1FE5: "\u03c1\u0314",
+	0x1FE6: "\u03c5\u0342", 0x1FE7: "\u03cb\u0342", 0x1FE8: "\u03a5\u0306", 0x1FE9: "\u03a5\u0304",
+	0x1FEA: "\u03a5\u0300", 0x1FEB: "\u038e", 0x1FEC: "\u03a1\u0314", 0x1FED: "\u00a8\u0300",
+	0x1FEE: "\u0385", 0x1FEF: "`", 0x1FF2: "\u1f7c\u0345", 0x1FF3: "\u03c9\u0345",
+	0x1FF4: "\u03ce\u0345", 0x1FF6: "\u03c9\u0342", 0x1FF7: "\u1ff6\u0345", 0x1FF8: "\u039f\u0300",
+	0x1FF9: "\u038c", 0x1FFA: "\u03a9\u0300", 0x1FFB: "\u038f", 0x1FFC: "\u03a9\u0345",
+	0x1FFD: "\u00b4", 0x2000: "\u2002", 0x2001: "\u2003", 0x2126: "\u03a9",
+	0x212A: "K", 0x212B: "\u00c5", 0x219A: "\u2190\u0338", 0x219B: "\u2192\u0338",
+	0x21AE: "\u2194\u0338", 0x21CD: "\u21d0\u0338", 0x21CE: "\u21d4\u0338", 0x21CF: "\u21d2\u0338",
+	0x2204: "\u2203\u0338", 0x2209: "\u2208\u0338", 0x220C: "\u220b\u0338", 0x2224: "\u2223\u0338",
+	0x2226: "\u2225\u0338", 0x2241: "\u223c\u0338", 0x2244: "\u2243\u0338", 0x2247: "\u2245\u0338",
+	0x2249: "\u2248\u0338", 0x2260: "=\u0338", 0x2262: "\u2261\u0338", 0x226D: "\u224d\u0338",
+	0x226E: "<\u0338", 0x226F: ">\u0338", 0x2270: "\u2264\u0338", 0x2271: "\u2265\u0338",
+	0x2274: "\u2272\u0338", 0x2275: "\u2273\u0338", 0x2278: "\u2276\u0338", 0x2279: "\u2277\u0338",
+	0x2280: "\u227a\u0338", 0x2281: "\u227b\u0338", 0x2284: "\u2282\u0338", 0x2285: "\u2283\u0338",
+	0x2288: "\u2286\u0338", 0x2289: "\u2287\u0338", 0x22AC: "\u22a2\u0338", 0x22AD: "\u22a8\u0338",
+	0x22AE: "\u22a9\u0338", 0x22AF: "\u22ab\u0338", 0x22E0: "\u227c\u0338", 0x22E1: "\u227d\u0338",
+	0x22E2: "\u2291\u0338", 0x22E3: "\u2292\u0338", 0x22EA: "\u22b2\u0338", 0x22EB: "\u22b3\u0338",
+	0x22EC: "\u22b4\u0338", 0x22ED: "\u22b5\u0338", 0x2329: "\u3008", 0x232A: "\u3009",
+	0x2ADC: "\u2add\u0338", 0x304C: "\u304b\u3099", 0x304E: "\u304d\u3099", 0x3050: "\u304f\u3099",
+	0x3052: "\u3051\u3099", 0x3054: "\u3053\u3099", 0x3056: "\u3055\u3099", 0x3058: "\u3057\u3099",
+	0x305A: "\u3059\u3099", 0x305C: "\u305b\u3099", 0x305E: "\u305d\u3099", 0x3060: "\u305f\u3099",
+	0x3062: "\u3061\u3099", 0x3065: "\u3064\u3099", 0x3067: "\u3066\u3099", 0x3069: "\u3068\u3099",
+	0x3070: "\u306f\u3099", 0x3071: "\u306f\u309a", 0x3073: "\u3072\u3099", 0x3074: "\u3072\u309a",
+	0x3076: "\u3075\u3099", 0x3077: "\u3075\u309a", 0x3079: "\u3078\u3099", 0x307A: "\u3078\u309a",
+	0x307C: "\u307b\u3099", 0x307D: "\u307b\u309a", 0x3094: "\u3046\u3099", 0x309E: "\u309d\u3099",
+	0x30AC: "\u30ab\u3099", 0x30AE: "\u30ad\u3099", 0x30B0: "\u30af\u3099", 0x30B2: "\u30b1\u3099",
+	0x30B4: "\u30b3\u3099", 0x30B6: "\u30b5\u3099", 0x30B8: "\u30b7\u3099", 0x30BA: "\u30b9\u3099",
+	0x30BC: "\u30bb\u3099", 0x30BE: "\u30bd\u3099", 0x30C0: "\u30bf\u3099", 0x30C2: "\u30c1\u3099",
+	0x30C5: "\u30c4\u3099", 0x30C7: "\u30c6\u3099", 0x30C9: "\u30c8\u3099", 0x30D0: "\u30cf\u3099",
+	0x30D1: "\u30cf\u309a", 0x30D3: "\u30d2\u3099", 0x30D4: "\u30d2\u309a", 0x30D6: "\u30d5\u3099",
+	0x30D7: "\u30d5\u309a", 0x30D9: "\u30d8\u3099", 0x30DA: "\u30d8\u309a", 0x30DC: "\u30db\u3099",
+	0x30DD: "\u30db\u309a", 0x30F4: "\u30a6\u3099", 0x30F7: "\u30ef\u3099", 0x30F8: "\u30f0\u3099",
+	0x30F9: "\u30f1\u3099", 0x30FA: "\u30f2\u3099", 0x30FE: "\u30fd\u3099", 0xF900: "\u8c48",
+	0xF901: "\u66f4", 0xF902: "\u8eca", 0xF903: "\u8cc8", 0xF904: "\u6ed1",
+	0xF905: "\u4e32", 0xF906: "\u53e5", 0xF907: "\u9f9c", 0xF908: "\u9f9c",
+	0xF909: "\u5951", 0xF90A: "\u91d1", 0xF90B: "\u5587", 0xF90C: "\u5948",
+	0xF90D: "\u61f6", 0xF90E: "\u7669", 0xF90F: "\u7f85", 0xF910: "\u863f",
+	0xF911: "\u87ba", 0xF912: "\u88f8", 0xF913: "\u908f", 0xF914: "\u6a02",
+	0xF915: "\u6d1b", 0xF916: "\u70d9", 0xF917: "\u73de", 0xF918: "\u843d",
+	0xF919: "\u916a", 0xF91A: "\u99f1", 0xF91B: "\u4e82", 0xF91C: "\u5375",
+	0xF91D: "\u6b04", 0xF91E: "\u721b", 0xF91F: "\u862d", 0xF920: "\u9e1e",
+	0xF921: "\u5d50", 0xF922: "\u6feb", 0xF923: "\u85cd", 0xF924: "\u8964",
+	0xF925: "\u62c9", 0xF926: "\u81d8", 0xF927: "\u881f", 0xF928: "\u5eca",
+	0xF929: "\u6717", 0xF92A: "\u6d6a", 0xF92B: "\u72fc", 0xF92C: "\u90ce",
+	0xF92D: "\u4f86", 0xF92E: "\u51b7", 0xF92F: "\u52de", 0xF930: "\u64c4",
+	0xF931: "\u6ad3", 0xF932: "\u7210", 0xF933: "\u76e7", 0xF934: "\u8001",
+	0xF935: "\u8606", 0xF936: "\u865c", 0xF937: "\u8def", 0xF938: "\u9732",
+	0xF939: "\u9b6f", 0xF93A: "\u9dfa", 0xF93B: "\u788c", 0xF93C: "\u797f",
+	0xF93D: "\u7da0", 0xF93E: "\u83c9", 0xF93F: "\u9304", 0xF940: "\u9e7f",
+	0xF941: "\u8ad6", 0xF942: "\u58df", 0xF943: "\u5f04", 0xF944: "\u7c60",
+	0xF945: "\u807e", 0xF946: "\u7262", 0xF947: "\u78ca", 0xF948: "\u8cc2",
+	0xF949: "\u96f7", 0xF94A: "\u58d8", 0xF94B: "\u5c62", 0xF94C: "\u6a13",
+	0xF94D: "\u6dda", 0xF94E: "\u6f0f", 0xF94F: "\u7d2f", 0xF950: "\u7e37",
+	0xF951: "\u964b", 0xF952: "\u52d2", 0xF953: "\u808b", 0xF954: "\u51dc",
+	0xF955: "\u51cc", 0xF956: "\u7a1c", 0xF957: "\u7dbe", 0xF958: "\u83f1",
+	0xF959: "\u9675", 0xF95A: "\u8b80", 0xF95B: "\u62cf", 0xF95C: "\u6a02",
+	0xF95D: "\u8afe", 0xF95E: "\u4e39", 0xF95F: "\u5be7", 0xF960: "\u6012",
+	0xF961: "\u7387", 0xF962: "\u7570", 0xF963: "\u5317", 0xF964: "\u78fb",
+	0xF965: "\u4fbf", 0xF966: "\u5fa9", 0xF967: "\u4e0d", 0xF968: "\u6ccc",
+	0xF969: "\u6578", 0xF96A: "\u7d22", 0xF96B: "\u53c3", 0xF96C: "\u585e",
+	0xF96D: "\u7701", 0xF96E: "\u8449", 0xF96F: "\u8aaa", 0xF970: "\u6bba",
+	0xF971: "\u8fb0", 0xF972: "\u6c88", 0xF973: "\u62fe", 0xF974: "\u82e5",
+	0xF975: "\u63a0", 0xF976: "\u7565", 0xF977: "\u4eae", 0xF978: "\u5169",
+	0xF979: "\u51c9", 0xF97A: "\u6881", 0xF97B: "\u7ce7", 0xF97C: "\u826f",
+	0xF97D: "\u8ad2", 0xF97E: "\u91cf", 0xF97F: "\u52f5", 0xF980: "\u5442",
+	0xF981: "\u5973", 0xF982: "\u5eec", 0xF983: "\u65c5", 0xF984: "\u6ffe",
+	0xF985: "\u792a", 0xF986: "\u95ad", 0xF987: "\u9a6a", 0xF988: "\u9e97",
+	0xF989: "\u9ece", 0xF98A: "\u529b", 0xF98B: "\u66c6", 0xF98C: "\u6b77",
+	0xF98D: "\u8f62", 0xF98E: "\u5e74", 0xF98F: "\u6190", 0xF990: "\u6200",
+	0xF991: "\u649a", 0xF992: "\u6f23", 0xF993: "\u7149", 0xF994: "\u7489",
+	0xF995: "\u79ca", 0xF996: "\u7df4", 0xF997: "\u806f", 0xF998: "\u8f26",
+	0xF999: "\u84ee", 0xF99A: "\u9023", 0xF99B: "\u934a", 0xF99C: "\u5217",
+	0xF99D: "\u52a3", 0xF99E: "\u54bd", 0xF99F: "\u70c8", 0xF9A0: "\u88c2",
+	0xF9A1: "\u8aaa", 0xF9A2: "\u5ec9", 0xF9A3: "\u5ff5", 0xF9A4: "\u637b",
+	0xF9A5: "\u6bae", 0xF9A6: "\u7c3e", 0xF9A7: "\u7375", 0xF9A8: "\u4ee4",
+	0xF9A9: "\u56f9", 0xF9AA: "\u5be7", 0xF9AB: "\u5dba", 0xF9AC: "\u601c",
+	0xF9AD: "\u73b2", 0xF9AE: "\u7469", 0xF9AF: "\u7f9a", 0xF9B0: "\u8046",
+	0xF9B1: "\u9234", 0xF9B2: "\u96f6", 0xF9B3: "\u9748", 0xF9B4: "\u9818",
+	0xF9B5: "\u4f8b", 0xF9B6: "\u79ae", 0xF9B7: "\u91b4", 0xF9B8: "\u96b8",
+	0xF9B9: "\u60e1", 0xF9BA: "\u4e86", 0xF9BB: "\u50da", 0xF9BC: "\u5bee",
+	0xF9BD: "\u5c3f", 0xF9BE: "\u6599", 0xF9BF: "\u6a02", 0xF9C0: "\u71ce",
+	0xF9C1: "\u7642", 0xF9C2: "\u84fc", 0xF9C3: "\u907c", 0xF9C4: "\u9f8d",
+	0xF9C5: "\u6688", 0xF9C6: "\u962e", 0xF9C7: "\u5289", 0xF9C8: "\u677b",
+	0xF9C9: "\u67f3", 0xF9CA: "\u6d41", 0xF9CB: "\u6e9c", 0xF9CC: "\u7409",
+	0xF9CD: "\u7559", 0xF9CE: "\u786b", 0xF9CF: "\u7d10", 0xF9D0: "\u985e",
+	0xF9D1: "\u516d", 0xF9D2: "\u622e", 0xF9D3: "\u9678", 0xF9D4: "\u502b",
+	0xF9D5: "\u5d19", 0xF9D6: "\u6dea", 0xF9D7: "\u8f2a", 0xF9D8: "\u5f8b",
+	0xF9D9: "\u6144", 0xF9DA: "\u6817", 0xF9DB: "\u7387", 0xF9DC: "\u9686",
+	0xF9DD: "\u5229", 0xF9DE: "\u540f", 0xF9DF: "\u5c65", 0xF9E0: "\u6613",
+	0xF9E1: "\u674e", 0xF9E2: "\u68a8", 0xF9E3: "\u6ce5", 0xF9E4: "\u7406",
+	0xF9E5: "\u75e2", 0xF9E6: "\u7f79", 0xF9E7: "\u88cf", 0xF9E8: "\u88e1",
+	0xF9E9: "\u91cc", 0xF9EA: "\u96e2", 0xF9EB: "\u533f", 0xF9EC: "\u6eba",
+	0xF9ED: "\u541d", 0xF9EE: "\u71d0", 0xF9EF: "\u7498", 0xF9F0: "\u85fa",
+	0xF9F1: "\u96a3", 0xF9F2: "\u9c57", 0xF9F3: "\u9e9f", 0xF9F4: "\u6797",
+	0xF9F5: "\u6dcb", 0xF9F6: "\u81e8", 0xF9F7: "\u7acb", 0xF9F8: "\u7b20",
+	0xF9F9: "\u7c92", 0xF9FA: "\u72c0", 0xF9FB: "\u7099", 0xF9FC: "\u8b58",
+	0xF9FD: "\u4ec0", 0xF9FE: "\u8336", 0xF9FF: "\u523a", 0xFA00: "\u5207",
+	0xFA01: "\u5ea6", 0xFA02: "\u62d3", 0xFA03: "\u7cd6", 0xFA04: "\u5b85",
+	0xFA05: "\u6d1e", 0xFA06: "\u66b4", 0xFA07: "\u8f3b", 0xFA08: "\u884c",
+	0xFA09: "\u964d", 0xFA0A: "\u898b", 0xFA0B: "\u5ed3", 0xFA0C: "\u5140",
+	0xFA0D: "\u55c0", 0xFA10: "\u585a", 0xFA12: "\u6674", 0xFA15: "\u51de",
+	0xFA16: "\u732a", 0xFA17: "\u76ca", 0xFA18: "\u793c", 0xFA19: "\u795e",
+	0xFA1A: "\u7965", 0xFA1B: "\u798f", 0xFA1C: "\u9756", 0xFA1D: "\u7cbe",
+	0xFA1E: "\u7fbd", 0xFA20: "\u8612", 0xFA22: "\u8af8", 0xFA25: "\u9038",
+	0xFA26: "\u90fd", 0xFA2A: "\u98ef", 0xFA2B: "\u98fc", 0xFA2C: "\u9928",
+	0xFA2D: "\u9db4", 0xFA2E: "\u90de", 0xFA2F: "\u96b7", 0xFA30: "\u4fae",
+	0xFA31: "\u50e7", 0xFA32: "\u514d", 0xFA33: "\u52c9", 0xFA34: "\u52e4",
+	0xFA35: "\u5351", 0xFA36: "\u559d", 0xFA37: "\u5606", 0xFA38: "\u5668",
+	0xFA39: "\u5840", 0xFA3A: "\u58a8", 0xFA3B: "\u5c64", 0xFA3C: "\u5c6e",
+	0xFA3D: "\u6094", 0xFA3E: "\u6168", 0xFA3F: "\u618e", 0xFA40: "\u61f2",
+	0xFA41: "\u654f", 0xFA42: "\u65e2", 0xFA43: "\u6691", 0xFA44: "\u6885",
+	0xFA45: "\u6d77", 0xFA46: "\u6e1a", 0xFA47: "\u6f22", 0xFA48: "\u716e",
+	0xFA49: "\u722b", 0xFA4A: "\u7422", 0xFA4B: "\u7891", 0xFA4C: "\u793e",
+	0xFA4D: "\u7949", 0xFA4E: "\u7948", 0xFA4F: "\u7950", 0xFA50: "\u7956",
+	0xFA51: "\u795d", 0xFA52: "\u798d", 0xFA53: "\u798e", 0xFA54: "\u7a40",
+	0xFA55: "\u7a81", 0xFA56: "\u7bc0", 0xFA57: "\u7df4", 0xFA58: "\u7e09",
+	0xFA59: "\u7e41", 0xFA5A: "\u7f72", 0xFA5B: "\u8005", 0xFA5C: "\u81ed",
+	0xFA5D: "\u8279", 0xFA5E: "\u8279", 0xFA5F: "\u8457", 0xFA60: "\u8910",
+	0xFA61: "\u8996", 0xFA62: "\u8b01", 0xFA63: "\u8b39", 0xFA64: "\u8cd3",
+	0xFA65: "\u8d08", 0xFA66: "\u8fb6", 0xFA67: "\u9038", 0xFA68: "\u96e3",
+	0xFA69: "\u97ff", 0xFA6A: "\u983b", 0xFA6B: "\u6075", 0xFA6C: "\U000242ee",
+	0xFA6D: "\u8218", 0xFA70: "\u4e26", 0xFA71: "\u51b5", 0xFA72: "\u5168",
+	0xFA73: "\u4f80", 0xFA74: "\u5145", 0xFA75: "\u5180", 0xFA76: "\u52c7",
+	0xFA77: "\u52fa", 0xFA78: "\u559d", 0xFA79: "\u5555", 0xFA7A: "\u5599",
+	0xFA7B: "\u55e2", 0xFA7C: "\u585a", 0xFA7D: "\u58b3", 0xFA7E: "\u5944",
+	0xFA7F: "\u5954", 0xFA80: "\u5a62", 0xFA81: "\u5b28", 0xFA82: "\u5ed2",
+	0xFA83: "\u5ed9", 0xFA84: "\u5f69", 0xFA85: "\u5fad", 0xFA86: "\u60d8",
+	0xFA87: "\u614e", 0xFA88: "\u6108", 0xFA89: "\u618e", 0xFA8A: "\u6160",
+	0xFA8B: "\u61f2", 0xFA8C: "\u6234", 0xFA8D: "\u63c4", 0xFA8E: "\u641c",
+	0xFA8F: "\u6452", 0xFA90: "\u6556", 0xFA91: "\u6674", 0xFA92: "\u6717",
+	0xFA93: "\u671b", 0xFA94: "\u6756", 0xFA95: "\u6b79", 0xFA96: "\u6bba",
+	0xFA97: "\u6d41", 0xFA98: "\u6edb", 0xFA99: "\u6ecb", 0xFA9A: "\u6f22",
+	0xFA9B: "\u701e", 0xFA9C: "\u716e", 0xFA9D: "\u77a7", 0xFA9E: "\u7235",
+	0xFA9F: "\u72af", 0xFAA0: "\u732a", 0xFAA1: "\u7471", 0xFAA2: "\u7506",
+	0xFAA3: "\u753b", 0xFAA4: "\u761d", 0xFAA5: "\u761f", 0xFAA6: "\u76ca",
+	0xFAA7: "\u76db", 0xFAA8: "\u76f4", 0xFAA9: "\u774a", 0xFAAA: "\u7740",
+	0xFAAB: "\u78cc", 0xFAAC: "\u7ab1", 0xFAAD: "\u7bc0", 0xFAAE: "\u7c7b",
+	0xFAAF: "\u7d5b", 0xFAB0: "\u7df4", 0xFAB1: "\u7f3e", 0xFAB2: "\u8005",
+	0xFAB3: "\u8352", 0xFAB4: "\u83ef", 0xFAB5: "\u8779", 0xFAB6: "\u8941",
+	0xFAB7: "\u8986", 0xFAB8: "\u8996", 0xFAB9: "\u8abf", 0xFABA: "\u8af8",
+	0xFABB: "\u8acb", 0xFABC: "\u8b01", 0xFABD: "\u8afe", 0xFABE: "\u8aed",
+	0xFABF: "\u8b39", 0xFAC0: "\u8b8a", 0xFAC1: "\u8d08", 0xFAC2: "\u8f38",
+	0xFAC3: "\u9072", 0xFAC4: "\u9199", 0xFAC5: "\u9276", 0xFAC6: "\u967c",
+	0xFAC7: "\u96e3", 0xFAC8: "\u9756", 0xFAC9: "\u97db", 0xFACA: "\u97ff",
+	0xFACB: "\u980b", 0xFACC: "\u983b", 0xFACD: "\u9b12", 0xFACE: "\u9f9c",
+	0xFACF: "\U0002284a", 0xFAD0: "\U00022844", 0xFAD1: "\U000233d5", 0xFAD2: "\u3b9d",
+	0xFAD3: "\u4018", 0xFAD4: "\u4039", 0xFAD5: "\U00025249", 0xFAD6: "\U00025cd0",
+	0xFAD7: "\U00027ed3", 0xFAD8: "\u9f43", 0xFAD9: "\u9f8e", 0xFB1D: "\u05d9\u05b4",
+	0xFB1F: "\u05f2\u05b7", 0xFB2A: "\u05e9\u05c1", 0xFB2B: "\u05e9\u05c2", 0xFB2C: "\ufb49\u05c1",
+	0xFB2D: "\ufb49\u05c2", 0xFB2E: "\u05d0\u05b7", 0xFB2F: "\u05d0\u05b8", 0xFB30: "\u05d0\u05bc",
+	0xFB31: "\u05d1\u05bc", 0xFB32: "\u05d2\u05bc", 0xFB33: "\u05d3\u05bc", 0xFB34: "\u05d4\u05bc",
+	0xFB35: "\u05d5\u05bc", 0xFB36: "\u05d6\u05bc", 0xFB38: "\u05d8\u05bc", 0xFB39: "\u05d9\u05bc",
+	0xFB3A: "\u05da\u05bc", 0xFB3B: "\u05db\u05bc", 0xFB3C: "\u05dc\u05bc", 0xFB3E: "\u05de\u05bc",
+	0xFB40: "\u05e0\u05bc", 0xFB41: "\u05e1\u05bc", 0xFB43: "\u05e3\u05bc", 0xFB44: "\u05e4\u05bc",
+	0xFB46: "\u05e6\u05bc", 0xFB47: "\u05e7\u05bc", 0xFB48: "\u05e8\u05bc", 0xFB49: "\u05e9\u05bc",
+	0xFB4A: "\u05ea\u05bc", 0xFB4B: "\u05d5\u05b9", 0xFB4C: "\u05d1\u05bf", 0xFB4D: "\u05db\u05bf",
+	0xFB4E: "\u05e4\u05bf", 0x1109A: "\U00011099\U000110ba", 0x1109C: "\U0001109b\U000110ba", 0x110AB: "\U000110a5\U000110ba",
+	0x1112E: "\U00011131\U00011127", 0x1112F: "\U00011132\U00011127", 0x1134B: "\U00011347\U0001133e", 0x1134C: "\U00011347\U00011357",
+	0x114BB: "\U000114b9\U000114ba", 0x114BC: "\U000114b9\U000114b0", 0x114BE: "\U000114b9\U000114bd", 0x115BA: "\U000115b8\U000115af",
+	0x115BB: "\U000115b9\U000115af", 0x11938: "\U00011935\U00011930", 0x1D15E: "\U0001d157\U0001d165", 0x1D15F: "\U0001d158\U0001d165",
+	0x1D160: "\U0001d15f\U0001d16e", 0x1D161: "\U0001d15f\U0001d16f", 0x1D162: "\U0001d15f\U0001d170", 0x1D163: "\U0001d15f\U0001d171",
+	0x1D164: "\U0001d15f\U0001d172", 0x1D1BB: "\U0001d1b9\U0001d165", 0x1D1BC: "\U0001d1ba\U0001d165", 0x1D1BD: "\U0001d1bb\U0001d16e",
+	0x1D1BE: "\U0001d1bc\U0001d16e", 0x1D1BF: "\U0001d1bb\U0001d16f", 0x1D1C0: "\U0001d1bc\U0001d16f", 0x2F800: "\u4e3d",
+	0x2F801: "\u4e38", 0x2F802: "\u4e41", 0x2F803: "\U00020122", 0x2F804: "\u4f60",
+	0x2F805: "\u4fae", 0x2F806: "\u4fbb", 0x2F807: "\u5002", 0x2F808: "\u507a",
+	0x2F809: "\u5099", 0x2F80A: "\u50e7", 0x2F80B: "\u50cf", 0x2F80C: "\u349e",
+	0x2F80D: "\U0002063a", 0x2F80E: "\u514d", 0x2F80F: "\u5154", 0x2F810: "\u5164",
+	0x2F811: "\u5177", 0x2F812: "\U0002051c", 0x2F813: "\u34b9", 0x2F814: "\u5167",
+	0x2F815: "\u518d", 0x2F816: "\U0002054b", 0x2F817: "\u5197", 0x2F818: "\u51a4",
+	0x2F819: "\u4ecc", 0x2F81A: "\u51ac", 0x2F81B: "\u51b5", 0x2F81C: "\U000291df",
+	0x2F81D: "\u51f5", 0x2F81E: "\u5203", 0x2F81F: "\u34df", 0x2F820: "\u523b",
+	0x2F821: "\u5246", 0x2F822: "\u5272", 0x2F823: "\u5277", 0x2F824: "\u3515",
+	0x2F825: "\u52c7", 0x2F826: "\u52c9", 0x2F827: "\u52e4", 0x2F828: "\u52fa",
+	0x2F829: "\u5305", 0x2F82A: "\u5306", 0x2F82B: "\u5317", 0x2F82C: "\u5349",
+	0x2F82D: "\u5351", 0x2F82E: "\u535a", 0x2F82F: "\u5373", 0x2F830: "\u537d",
+	0x2F831: "\u537f", 0x2F832: "\u537f", 0x2F833: "\u537f", 0x2F834: "\U00020a2c",
+	0x2F835: "\u7070", 0x2F836: "\u53ca", 0x2F837: "\u53df", 0x2F838: "\U00020b63",
+	0x2F839: "\u53eb", 0x2F83A: "\u53f1", 0x2F83B: "\u5406", 0x2F83C: "\u549e",
+	0x2F83D: "\u5438", 0x2F83E: "\u5448", 0x2F83F: "\u5468", 0x2F840: "\u54a2",
+	0x2F841: "\u54f6", 0x2F842: "\u5510", 0x2F843: "\u5553", 0x2F844: "\u5563",
+	0x2F845: "\u5584", 0x2F846: "\u5584", 0x2F847: "\u5599", 0x2F848: "\u55ab",
+	0x2F849: "\u55b3", 0x2F84A: "\u55c2", 0x2F84B: "\u5716", 0x2F84C: "\u5606",
+	0x2F84D: "\u5717", 0x2F84E: "\u5651", 0x2F84F: "\u5674", 0x2F850: "\u5207",
+	0x2F851: "\u58ee", 0x2F852: "\u57ce", 0x2F853: "\u57f4", 0x2F854: "\u580d",
+	0x2F855: "\u578b", 0x2F856: "\u5832", 0x2F857: "\u5831", 0x2F858: "\u58ac",
+	0x2F859: "\U000214e4", 0x2F85A: "\u58f2", 0x2F85B: "\u58f7", 0x2F85C: "\u5906",
+	0x2F85D: "\u591a", 0x2F85E: "\u5922", 0x2F85F: "\u5962", 0x2F860: "\U000216a8",
+	0x2F861: "\U000216ea", 0x2F862: "\u59ec", 0x2F863: "\u5a1b", 0x2F864: "\u5a27",
+	0x2F865: "\u59d8", 0x2F866: "\u5a66", 0x2F867: "\u36ee", 0x2F868: "\u36fc",
+	0x2F869: "\u5b08", 0x2F86A: "\u5b3e", 0x2F86B: "\u5b3e", 0x2F86C: "\U000219c8",
+	0x2F86D: "\u5bc3", 0x2F86E: "\u5bd8", 0x2F86F: "\u5be7", 0x2F870: "\u5bf3",
+	0x2F871: "\U00021b18", 0x2F872: "\u5bff", 0x2F873: "\u5c06", 0x2F874: "\u5f53",
+	0x2F875: "\u5c22", 0x2F876: "\u3781", 0x2F877: "\u5c60", 0x2F878: "\u5c6e",
+	0x2F879: "\u5cc0", 0x2F87A: "\u5c8d", 0x2F87B: "\U00021de4", 0x2F87C: "\u5d43",
+	0x2F87D: "\U00021de6", 0x2F87E: "\u5d6e", 0x2F87F: "\u5d6b", 0x2F880: "\u5d7c",
+	0x2F881: "\u5de1", 0x2F882: "\u5de2", 0x2F883: "\u382f", 0x2F884: "\u5dfd",
+	0x2F885: "\u5e28", 0x2F886: "\u5e3d", 0x2F887: "\u5e69", 0x2F888: "\u3862",
+	0x2F889: "\U00022183", 0x2F88A: "\u387c", 0x2F88B: "\u5eb0", 0x2F88C: "\u5eb3",
+	0x2F88D: "\u5eb6", 0x2F88E: "\u5eca", 0x2F88F: "\U0002a392", 0x2F890: "\u5efe",
+	0x2F891: "\U00022331", 0x2F892: "\U00022331", 0x2F893: "\u8201", 0x2F894: "\u5f22",
+	0x2F895: "\u5f22", 0x2F896: "\u38c7", 0x2F897: "\U000232b8", 0x2F898: "\U000261da",
+	0x2F899: "\u5f62", 0x2F89A: "\u5f6b", 0x2F89B: "\u38e3", 0x2F89C: "\u5f9a",
+	0x2F89D: "\u5fcd", 0x2F89E: "\u5fd7", 0x2F89F: "\u5ff9", 0x2F8A0: "\u6081",
+	0x2F8A1: "\u393a", 0x2F8A2: "\u391c", 0x2F8A3: "\u6094", 0x2F8A4: "\U000226d4",
+	0x2F8A5: "\u60c7", 0x2F8A6: "\u6148", 0x2F8A7: "\u614c", 0x2F8A8: "\u614e",
+	0x2F8A9: "\u614c", 0x2F8AA: "\u617a", 0x2F8AB: "\u618e", 0x2F8AC: "\u61b2",
+	0x2F8AD: "\u61a4", 0x2F8AE: "\u61af", 0x2F8AF: "\u61de", 0x2F8B0: "\u61f2",
+	0x2F8B1: "\u61f6", 0x2F8B2: "\u6210", 0x2F8B3: "\u621b", 0x2F8B4: "\u625d",
+	0x2F8B5: "\u62b1", 0x2F8B6: "\u62d4", 0x2F8B7: "\u6350", 0x2F8B8: "\U00022b0c",
+	0x2F8B9: "\u633d", 0x2F8BA: "\u62fc", 0x2F8BB: "\u6368", 0x2F8BC: "\u6383",
+	0x2F8BD: "\u63e4", 0x2F8BE: "\U00022bf1", 0x2F8BF: "\u6422", 0x2F8C0: "\u63c5",
+	0x2F8C1: "\u63a9", 0x2F8C2: "\u3a2e", 0x2F8C3: "\u6469", 0x2F8C4: "\u647e",
+	0x2F8C5: "\u649d", 0x2F8C6: "\u6477", 0x2F8C7: "\u3a6c", 0x2F8C8: "\u654f",
+	0x2F8C9: "\u656c", 0x2F8CA: "\U0002300a", 0x2F8CB: "\u65e3", 0x2F8CC: "\u66f8",
+	0x2F8CD: "\u6649", 0x2F8CE: "\u3b19", 0x2F8CF: "\u6691", 0x2F8D0: "\u3b08",
+	0x2F8D1: "\u3ae4", 0x2F8D2: "\u5192", 0x2F8D3: "\u5195", 0x2F8D4: "\u6700",
+	0x2F8D5: "\u669c", 0x2F8D6: "\u80ad", 0x2F8D7: "\u43d9", 0x2F8D8: "\u6717",
+	0x2F8D9: "\u671b", 0x2F8DA: "\u6721", 0x2F8DB: "\u675e", 0x2F8DC: "\u6753",
+	0x2F8DD: "\U000233c3", 0x2F8DE: "\u3b49", 0x2F8DF: "\u67fa", 0x2F8E0: "\u6785",
+	0x2F8E1: "\u6852", 0x2F8E2: "\u6885", 0x2F8E3: "\U0002346d", 0x2F8E4: "\u688e",
+	0x2F8E5: "\u681f", 0x2F8E6: "\u6914", 0x2F8E7: "\u3b9d", 0x2F8E8: "\u6942",
+	0x2F8E9: "\u69a3", 0x2F8EA: "\u69ea", 0x2F8EB: "\u6aa8", 0x2F8EC: "\U000236a3",
+	0x2F8ED: "\u6adb", 0x2F8EE: "\u3c18", 0x2F8EF: "\u6b21", 0x2F8F0: "\U000238a7",
+	0x2F8F1: "\u6b54", 0x2F8F2: "\u3c4e", 0x2F8F3: "\u6b72", 0x2F8F4: "\u6b9f",
+	0x2F8F5: "\u6bba", 0x2F8F6: "\u6bbb", 0x2F8F7: "\U00023a8d", 0x2F8F8: "\U00021d0b",
+	0x2F8F9: "\U00023afa", 0x2F8FA: "\u6c4e", 0x2F8FB: "\U00023cbc", 0x2F8FC: "\u6cbf",
+	0x2F8FD: "\u6ccd", 0x2F8FE: "\u6c67", 0x2F8FF: "\u6d16", 0x2F900: "\u6d3e",
+	0x2F901: "\u6d77", 0x2F902: "\u6d41", 0x2F903: "\u6d69", 0x2F904: "\u6d78",
+	0x2F905: "\u6d85", 0x2F906: "\U00023d1e", 0x2F907: "\u6d34", 0x2F908: "\u6e2f",
+	0x2F909: "\u6e6e", 0x2F90A: "\u3d33", 0x2F90B: "\u6ecb", 0x2F90C: "\u6ec7",
+	0x2F90D: "\U00023ed1", 0x2F90E: "\u6df9", 0x2F90F: "\u6f6e", 0x2F910: "\U00023f5e",
+	0x2F911: "\U00023f8e", 0x2F912: "\u6fc6", 0x2F913: "\u7039", 0x2F914: "\u701e",
+	0x2F915: "\u701b", 0x2F916: "\u3d96", 0x2F917: "\u704a", 0x2F918: "\u707d",
+	0x2F919: "\u7077", 0x2F91A: "\u70ad", 0x2F91B: "\U00020525", 0x2F91C: "\u7145",
+	0x2F91D: "\U00024263", 0x2F91E: "\u719c", 0x2F91F: "\U000243ab", 0x2F920: "\u7228",
+	0x2F921: "\u7235", 0x2F922: "\u7250", 0x2F923: "\U00024608", 0x2F924: "\u7280",
+	0x2F925: "\u7295", 0x2F926: "\U00024735", 0x2F927: "\U00024814", 0x2F928: "\u737a",
+	0x2F929: "\u738b", 0x2F92A: "\u3eac", 0x2F92B: "\u73a5", 0x2F92C: "\u3eb8",
+	0x2F92D: "\u3eb8", 0x2F92E: "\u7447", 0x2F92F: "\u745c", 0x2F930: "\u7471",
+	0x2F931: "\u7485", 0x2F932: "\u74ca", 0x2F933: "\u3f1b", 0x2F934: "\u7524",
+	0x2F935: "\U00024c36", 0x2F936: "\u753e", 0x2F937: "\U00024c92", 0x2F938: "\u7570",
+	0x2F939: "\U0002219f", 0x2F93A: "\u7610", 0x2F93B: "\U00024fa1", 0x2F93C: "\U00024fb8",
+	0x2F93D: "\U00025044", 0x2F93E: "\u3ffc", 0x2F93F: "\u4008", 0x2F940: "\u76f4",
+	0x2F941: "\U000250f3", 0x2F942: "\U000250f2", 0x2F943: "\U00025119", 0x2F944: "\U00025133",
+	0x2F945: "\u771e", 0x2F946: "\u771f", 0x2F947: "\u771f", 0x2F948: "\u774a",
+	0x2F949: "\u4039", 0x2F94A: "\u778b", 0x2F94B: "\u4046", 0x2F94C: "\u4096",
+	0x2F94D: "\U0002541d", 0x2F94E: "\u784e", 0x2F94F: "\u788c", 0x2F950: "\u78cc",
+	0x2F951: "\u40e3", 0x2F952: "\U00025626", 0x2F953: "\u7956", 0x2F954: "\U0002569a",
+	0x2F955: "\U000256c5", 0x2F956: "\u798f", 0x2F957: "\u79eb", 0x2F958: "\u412f",
+	0x2F959: "\u7a40", 0x2F95A: "\u7a4a", 0x2F95B: "\u7a4f", 0x2F95C: "\U0002597c",
+	0x2F95D: "\U00025aa7", 0x2F95E: "\U00025aa7", 0x2F95F: "\u7aee", 0x2F960: "\u4202",
+	0x2F961: "\U00025bab", 0x2F962: "\u7bc6", 0x2F963: "\u7bc9", 0x2F964: "\u4227",
+	0x2F965: "\U00025c80", 0x2F966: "\u7cd2", 0x2F967: "\u42a0", 0x2F968: "\u7ce8",
+	0x2F969: "\u7ce3", 0x2F96A: "\u7d00", 0x2F96B: "\U00025f86", 0x2F96C: "\u7d63",
+	0x2F96D: "\u4301", 0x2F96E: "\u7dc7", 0x2F96F: "\u7e02", 0x2F970: "\u7e45",
+	0x2F971: "\u4334", 0x2F972: "\U00026228", 0x2F973: "\U00026247", 0x2F974: "\u4359",
+	0x2F975: "\U000262d9", 0x2F976: "\u7f7a", 0x2F977: "\U0002633e", 0x2F978: "\u7f95",
+	0x2F979: "\u7ffa", 0x2F97A: "\u8005", 0x2F97B: "\U000264da", 0x2F97C: "\U00026523",
+	0x2F97D: "\u8060", 0x2F97E: "\U000265a8", 0x2F97F: "\u8070", 0x2F980: "\U0002335f",
+	0x2F981: "\u43d5", 0x2F982: "\u80b2", 0x2F983: "\u8103", 0x2F984: "\u440b",
+	0x2F985: "\u813e", 0x2F986: "\u5ab5", 0x2F987: "\U000267a7", 0x2F988: "\U000267b5",
+	0x2F989: "\U00023393", 0x2F98A: "\U0002339c", 0x2F98B: "\u8201", 0x2F98C: "\u8204",
+	0x2F98D: "\u8f9e", 0x2F98E: "\u446b", 0x2F98F: "\u8291", 0x2F990: "\u828b",
+	0x2F991: "\u829d", 0x2F992: "\u52b3", 0x2F993: "\u82b1", 0x2F994: "\u82b3",
+	0x2F995: "\u82bd", 0x2F996: "\u82e6", 0x2F997: "\U00026b3c", 0x2F998: "\u82e5",
+	0x2F999: "\u831d", 0x2F99A: "\u8363", 0x2F99B: "\u83ad", 0x2F99C: "\u8323",
+	0x2F99D: "\u83bd", 0x2F99E: "\u83e7", 0x2F99F: "\u8457", 0x2F9A0: "\u8353",
+	0x2F9A1: "\u83ca", 0x2F9A2: "\u83cc", 0x2F9A3: "\u83dc", 0x2F9A4: "\U00026c36",
+	0x2F9A5: "\U00026d6b", 0x2F9A6: "\U00026cd5", 0x2F9A7: "\u452b", 0x2F9A8: "\u84f1",
+	0x2F9A9: "\u84f3", 0x2F9AA: "\u8516", 0x2F9AB: "\U000273ca", 0x2F9AC: "\u8564",
+	0x2F9AD: "\U00026f2c", 0x2F9AE: "\u455d", 0x2F9AF: "\u4561", 0x2F9B0: "\U00026fb1",
+	0x2F9B1: "\U000270d2", 0x2F9B2: "\u456b", 0x2F9B3: "\u8650", 0x2F9B4: "\u865c",
+	0x2F9B5: "\u8667", 0x2F9B6: "\u8669", 0x2F9B7: "\u86a9", 0x2F9B8: "\u8688",
+	0x2F9B9: "\u870e", 0x2F9BA: "\u86e2", 0x2F9BB: "\u8779", 0x2F9BC: "\u8728",
+	0x2F9BD: "\u876b", 0x2F9BE: "\u8786", 0x2F9BF: "\u45d7", 0x2F9C0: "\u87e1",
+	0x2F9C1: "\u8801", 0x2F9C2: "\u45f9", 0x2F9C3: "\u8860", 0x2F9C4: "\u8863",
+	0x2F9C5: "\U00027667", 0x2F9C6: "\u88d7", 0x2F9C7: "\u88de", 0x2F9C8: "\u4635",
+	0x2F9C9: "\u88fa", 0x2F9CA: "\u34bb", 0x2F9CB: "\U000278ae", 0x2F9CC: "\U00027966",
+	0x2F9CD: "\u46be", 0x2F9CE: "\u46c7", 0x2F9CF: "\u8aa0", 0x2F9D0: "\u8aed",
+	0x2F9D1: "\u8b8a", 0x2F9D2: "\u8c55", 0x2F9D3: "\U00027ca8", 0x2F9D4: "\u8cab",
+	0x2F9D5: "\u8cc1", 0x2F9D6: "\u8d1b", 0x2F9D7: "\u8d77", 0x2F9D8: "\U00027f2f",
+	0x2F9D9: "\U00020804", 0x2F9DA: "\u8dcb", 0x2F9DB: "\u8dbc", 0x2F9DC: "\u8df0",
+	0x2F9DD: "\U000208de", 0x2F9DE: "\u8ed4", 0x2F9DF: "\u8f38", 0x2F9E0: "\U000285d2",
+	0x2F9E1: "\U000285ed", 0x2F9E2: "\u9094", 0x2F9E3: "\u90f1", 0x2F9E4: "\u9111",
+	0x2F9E5: "\U0002872e", 0x2F9E6: "\u911b", 0x2F9E7: "\u9238", 0x2F9E8: "\u92d7",
+	0x2F9E9: "\u92d8", 0x2F9EA: "\u927c", 0x2F9EB: "\u93f9", 0x2F9EC: "\u9415",
+	0x2F9ED: "\U00028bfa", 0x2F9EE: "\u958b", 0x2F9EF: "\u4995", 0x2F9F0: "\u95b7",
+	0x2F9F1: "\U00028d77", 0x2F9F2: "\u49e6", 0x2F9F3: "\u96c3", 0x2F9F4: "\u5db2",
+	0x2F9F5: "\u9723", 0x2F9F6: "\U00029145", 0x2F9F7: "\U0002921a", 0x2F9F8: "\u4a6e",
+	0x2F9F9: "\u4a76", 0x2F9FA: "\u97e0", 0x2F9FB: "\U0002940a", 0x2F9FC: "\u4ab2",
+	0x2F9FD: "\U00029496", 0x2F9FE: "\u980b", 0x2F9FF: "\u980b", 0x2FA00: "\u9829",
+	0x2FA01: "\U000295b6", 0x2FA02: "\u98e2", 0x2FA03: "\u4b33", 0x2FA04: "\u9929",
+	0x2FA05: "\u99a7", 0x2FA06: "\u99c2", 0x2FA07: "\u99fe", 0x2FA08: "\u4bce",
+	0x2FA09: "\U00029b30", 0x2FA0A: "\u9b12", 0x2FA0B: "\u9c40", 0x2FA0C: "\u9cfd",
+	0x2FA0D: "\u4cce", 0x2FA0E: "\u4ced", 0x2FA0F: "\u9d67", 0x2FA10: "\U0002a0ce",
+	0x2FA11: "\u4cf8", 0x2FA12: "\U0002a105", 0x2FA13: "\U0002a20e", 0x2FA14: "\U0002a291",
+	0x2FA15: "\u9ebb", 0x2FA16: "\u4d56", 0x2FA17: "\u9ef9", 0x2FA18: "\u9efe",
+	0x2FA19: "\u9f05", 0x2FA1A: "\u9f0f", 0x2FA1B: "\u9f16", 0x2FA1C: "\u9f3b",
+	0x2FA1D: "\U0002a600",
+}
+
+// combiningClasses holds every non-zero Canonical_Combining_Class.
+var combiningClasses = map[rune]uint8{
+	0x300: 230, 0x301: 230, 0x302: 230, 0x303: 230,
+	0x304: 230, 0x305: 230, 0x306: 230, 0x307: 230,
+	0x308: 230, 0x309: 230, 0x30A: 230, 0x30B: 230,
+	0x30C: 230, 0x30D: 230, 0x30E: 230, 0x30F: 230,
+	0x310: 230, 0x311: 230, 0x312: 230, 0x313: 230,
+	0x314: 230, 0x315: 232, 0x316: 220, 0x317: 220,
+	0x318: 220, 0x319: 220, 0x31A: 232, 0x31B: 216,
+	0x31C: 220, 0x31D: 220, 0x31E: 220, 0x31F: 220,
+	0x320: 220, 0x321: 202, 0x322: 202, 0x323: 220,
+	0x324: 220, 0x325: 220, 0x326: 220, 0x327: 202,
+	0x328: 202, 0x329: 220, 0x32A: 220, 0x32B: 220,
+	0x32C: 220, 0x32D: 220, 0x32E: 220, 0x32F: 220,
+	0x330: 220, 0x331: 220, 0x332: 220, 0x333: 220,
+	0x334: 1, 0x335: 1, 0x336: 1, 0x337: 1,
+	0x338: 1, 0x339: 220, 0x33A: 220, 0x33B: 220,
+	0x33C: 220, 0x33D: 230, 0x33E: 230, 0x33F: 230,
+	0x340: 230, 0x341: 230, 0x342: 230, 0x343: 230,
+	0x344: 230, 0x345: 240, 0x346: 230, 0x347: 220,
+	0x348: 220, 0x349: 220, 0x34A: 230, 0x34B: 230,
+	0x34C: 230, 0x34D: 220, 0x34E: 220, 0x350: 230,
+	0x351: 230, 0x352: 230, 0x353: 220, 0x354: 220,
+	0x355: 220, 0x356: 220, 0x357: 230, 0x358: 232,
+	0x359: 220, 0x35A: 220, 0x35B: 230, 0x35C: 233,
+	0x35D: 234, 0x35E: 234, 0x35F: 233, 0x360: 234,
+	0x361: 234, 0x362: 233, 0x363: 230, 0x364: 230,
+	0x365: 230, 0x366: 230, 0x367: 230, 0x368: 230,
+	0x369: 230, 0x36A: 230, 0x36B: 230, 0x36C: 230,
+	0x36D: 230, 0x36E: 230, 0x36F: 230, 0x483: 230,
+	0x484: 230, 0x485: 230, 0x486: 230, 0x487: 230,
+	0x591: 220, 0x592: 230, 0x593: 230, 0x594: 230,
+	0x595: 230, 0x596: 220, 0x597: 230, 0x598: 230,
+	0x599: 230, 0x59A: 222, 0x59B: 220, 0x59C: 230,
+	0x59D: 230, 0x59E: 230, 0x59F: 230, 0x5A0: 230,
+	0x5A1: 230, 0x5A2: 220, 0x5A3: 220, 0x5A4: 220,
+	0x5A5: 220, 0x5A6: 220, 0x5A7: 220, 0x5A8: 230,
+	0x5A9: 230, 0x5AA: 220, 0x5AB: 230, 0x5AC: 230,
+	0x5AD: 222, 0x5AE: 228, 0x5AF: 230, 0x5B0: 10,
+	0x5B1: 11, 0x5B2: 12, 0x5B3: 13, 0x5B4: 14,
+	0x5B5: 15, 0x5B6: 16, 0x5B7: 17, 0x5B8: 18,
+	0x5B9: 19, 0x5BA: 19, 0x5BB: 20, 0x5BC: 21,
+	0x5BD: 22, 0x5BF: 23, 0x5C1: 24, 0x5C2: 25,
+	0x5C4: 230, 0x5C5: 220, 0x5C7: 18, 0x610: 230,
+	0x611: 230, 0x612: 230, 0x613: 230, 0x614: 230,
+	0x615: 230, 0x616: 230, 0x617: 230, 0x618: 30,
+	0x619: 31, 0x61A: 32, 0x64B: 27, 0x64C: 28,
+	0x64D: 29, 0x64E: 30, 0x64F: 31, 0x650: 32,
+	0x651: 33, 0x652: 34, 0x653: 230, 0x654: 230,
+	0x655: 220, 0x656: 220, 0x657: 230, 0x658: 230,
+	0x659: 230, 0x65A: 230, 0x65B: 230, 0x65C: 220,
+	0x65D: 230, 0x65E: 230, 0x65F: 220, 0x670: 35,
+	0x6D6: 230, 0x6D7: 230, 0x6D8: 230, 0x6D9: 230,
+	0x6DA: 230, 0x6DB: 230, 0x6DC: 230, 0x6DF: 230,
+	0x6E0: 230, 0x6E1: 230, 0x6E2: 230, 0x6E3: 220,
+	0x6E4: 230, 0x6E7: 230, 0x6E8: 230, 0x6EA: 220,
+	0x6EB: 230, 0x6EC: 230, 0x6ED: 220, 0x711: 36,
+	0x730: 230, 0x731: 220, 0x732: 230, 0x733: 230,
+	0x734: 220, 0x735: 230, 0x736: 230, 0x737: 220,
+	0x738: 220, 0x739: 220, 0x73A: 230, 0x73B: 220,
+	0x73C: 220, 0x73D: 230, 0x73E: 220, 0x73F: 230,
+	0x740: 230, 0x741: 230, 0x742: 220, 0x743: 230,
+	0x744: 220, 0x745: 230, 0x746: 220, 0x747: 230,
+	0x748: 220, 0x749: 230, 0x74A: 230, 0x7EB: 230,
+	0x7EC: 230, 0x7ED: 230, 0x7EE: 230, 0x7EF: 230,
+	0x7F0: 230, 0x7F1: 230, 0x7F2: 220, 0x7F3: 230,
+	0x7FD: 220, 0x816: 230, 0x817: 230, 0x818: 230,
+	0x819: 230, 0x81B: 230, 0x81C: 230, 0x81D: 230,
+	0x81E: 230, 0x81F: 230, 0x820: 230, 0x821: 230,
+	0x822: 230, 0x823: 230, 0x825: 230, 0x826: 230,
+	0x827: 230, 0x829: 230, 0x82A: 230, 0x82B: 230,
+	0x82C: 230, 0x82D: 230, 0x859: 220, 0x85A: 220,
+	0x85B: 220, 0x898: 230, 0x899: 220, 0x89A: 220,
+	0x89B: 220, 0x89C: 230, 0x89D: 230, 0x89E: 230,
+	0x89F: 230, 0x8CA: 230, 0x8CB: 230, 0x8CC: 230,
+	0x8CD: 230, 0x8CE: 230, 0x8CF: 220, 0x8D0: 220,
+	0x8D1: 220, 0x8D2: 220, 0x8D3: 220, 0x8D4: 230,
+	0x8D5: 230, 0x8D6: 230, 0x8D7: 230, 0x8D8: 230,
+	0x8D9: 230, 0x8DA: 230, 0x8DB: 230, 0x8DC: 230,
+	0x8DD: 230, 0x8DE: 230, 0x8DF: 230, 0x8E0: 230,
+	0x8E1: 230, 0x8E3: 220, 0x8E4: 230, 0x8E5: 230,
+	0x8E6: 220, 0x8E7: 230, 0x8E8: 230, 0x8E9: 220,
+	0x8EA: 230, 0x8EB: 230, 0x8EC: 230, 0x8ED: 220,
+	0x8EE: 220, 0x8EF: 220, 0x8F0: 27, 0x8F1: 28,
+	0x8F2: 29, 0x8F3: 230, 0x8F4: 230, 0x8F5: 230,
+	0x8F6: 220, 0x8F7: 230, 0x8F8: 230, 0x8F9: 220,
+	0x8FA: 220, 0x8FB: 230, 0x8FC: 230, 0x8FD: 230,
+	0x8FE: 230, 0x8FF: 230, 0x93C: 7, 0x94D: 9,
+	0x951: 230, 0x952: 220, 0x953: 230, 0x954: 230,
+	0x9BC: 7, 0x9CD: 9, 0x9FE: 230, 0xA3C: 7,
+	0xA4D: 9, 0xABC: 7, 0xACD: 9, 0xB3C: 7,
+	0xB4D: 9, 0xBCD: 9, 0xC3C: 7, 0xC4D: 9,
+	0xC55: 84, 0xC56: 91, 0xCBC: 7, 0xCCD: 9,
+	0xD3B: 9, 0xD3C: 9, 0xD4D: 9, 0xDCA: 9,
+	0xE38: 103, 0xE39: 103, 0xE3A: 9, 0xE48: 107,
+	0xE49: 107, 0xE4A: 107, 0xE4B: 107, 0xEB8: 118,
+	0xEB9: 118, 0xEBA: 9, 0xEC8: 122, 0xEC9: 122,
+	0xECA: 122, 0xECB: 122, 0xF18: 220, 0xF19: 220,
+	0xF35: 220, 0xF37: 220, 0xF39: 216, 0xF71: 129,
+	0xF72: 130, 0xF74: 132, 0xF7A: 130, 0xF7B: 130,
+	0xF7C: 130, 0xF7D: 130, 0xF80: 130, 0xF82: 230,
+	0xF83: 230, 0xF84: 9, 0xF86: 230, 0xF87: 230,
+	0xFC6: 220, 0x1037: 7, 0x1039: 9, 0x103A: 9,
+	0x108D: 220, 0x135D: 230, 0x135E: 230, 0x135F: 230,
+	0x1714: 9, 0x1715: 9, 0x1734: 9, 0x17D2: 9,
+	0x17DD: 230, 0x18A9: 228, 0x1939: 222, 0x193A: 230,
+	0x193B: 220, 0x1A17: 230, 0x1A18: 220, 0x1A60: 9,
+	0x1A75: 230, 0x1A76: 230, 0x1A77: 230, 0x1A78: 230,
+	0x1A79: 230, 0x1A7A: 230, 0x1A7B: 230, 0x1A7C: 230,
+	0x1A7F: 220, 0x1AB0: 230, 0x1AB1: 230, 0x1AB2: 230,
+	0x1AB3: 230, 0x1AB4: 230, 0x1AB5: 220, 0x1AB6: 220,
+	0x1AB7: 220, 0x1AB8: 220, 0x1AB9: 220, 0x1ABA: 220,
+	0x1ABB: 230, 0x1ABC: 230, 0x1ABD: 220, 0x1ABF: 220,
+	0x1AC0: 220, 0x1AC1: 230, 0x1AC2: 230, 0x1AC3: 220,
+	0x1AC4: 220, 0x1AC5: 230, 0x1AC6: 230, 0x1AC7: 230,
+	0x1AC8: 230, 0x1AC9: 230, 0x1ACA: 220, 0x1ACB: 230,
+	0x1ACC: 230, 0x1ACD: 230, 0x1ACE: 230, 0x1B34: 7,
+	0x1B44: 9, 0x1B6B: 230, 0x1B6C: 220, 0x1B6D: 230,
+	0x1B6E: 230, 0x1B6F: 230, 0x1B70: 230, 0x1B71: 230,
+	0x1B72: 230, 0x1B73: 230, 0x1BAA: 9, 0x1BAB: 9,
+	0x1BE6: 7, 0x1BF2: 9, 0x1BF3: 9, 0x1C37: 7,
+	0x1CD0: 230, 0x1CD1: 230, 0x1CD2: 230, 0x1CD4: 1,
+	0x1CD5: 220, 0x1CD6: 220, 0x1CD7: 220, 0x1CD8: 220,
+	0x1CD9: 220, 0x1CDA: 230, 0x1CDB: 230, 0x1CDC: 220,
+	0x1CDD: 220, 0x1CDE: 220, 0x1CDF: 220, 0x1CE0: 230,
+	0x1CE2: 1, 0x1CE3: 1, 0x1CE4: 1, 0x1CE5: 1,
+	0x1CE6: 1, 0x1CE7: 1, 0x1CE8: 1, 0x1CED: 220,
+	0x1CF4: 230, 0x1CF8: 230, 0x1CF9: 230, 0x1DC0: 230,
+	0x1DC1: 230, 0x1DC2: 220, 0x1DC3: 230, 0x1DC4: 230,
+	0x1DC5: 230, 0x1DC6: 230, 0x1DC7: 230, 0x1DC8: 230,
+	0x1DC9: 230, 0x1DCA: 220, 0x1DCB: 230, 0x1DCC: 230,
+	0x1DCD: 234, 0x1DCE: 214, 0x1DCF: 220, 0x1DD0: 202,
+	0x1DD1: 230, 0x1DD2: 230, 0x1DD3: 230, 0x1DD4: 230,
+	0x1DD5: 230, 0x1DD6: 230, 0x1DD7: 230, 0x1DD8: 230,
+	0x1DD9: 230, 0x1DDA: 230, 0x1DDB: 230, 0x1DDC: 230,
+	0x1DDD: 230, 0x1DDE: 230, 0x1DDF: 230, 0x1DE0: 230,
+	0x1DE1: 230, 0x1DE2: 230, 0x1DE3: 230, 0x1DE4: 230,
+	0x1DE5: 230, 0x1DE6: 230, 0x1DE7: 230, 0x1DE8: 230,
+	0x1DE9: 230, 0x1DEA: 230, 0x1DEB: 230, 0x1DEC: 230,
+	0x1DED: 230, 0x1DEE: 230, 0x1DEF: 230, 0x1DF0: 230,
+	0x1DF1: 230, 0x1DF2: 230, 0x1DF3: 230, 0x1DF4: 230,
+	0x1DF5: 230, 0x1DF6: 232, 0x1DF7: 228, 0x1DF8: 228,
+	0x1DF9: 220, 0x1DFA: 218, 0x1DFB: 230, 0x1DFC: 233,
+	0x1DFD: 220, 0x1DFE: 230, 0x1DFF: 220, 0x20D0: 230,
+	0x20D1: 230, 0x20D2: 1, 0x20D3: 1, 0x20D4: 230,
+	0x20D5: 230, 0x20D6: 230, 0x20D7: 230, 0x20D8: 1,
+	0x20D9: 1, 0x20DA: 1, 0x20DB: 230, 0x20DC: 230,
+	0x20E1: 230, 0x20E5: 1, 0x20E6: 1, 0x20E7: 230,
+	0x20E8: 220, 0x20E9: 230, 0x20EA: 1, 0x20EB: 1,
+	0x20EC: 220, 0x20ED: 220, 0x20EE: 220, 0x20EF: 220,
+	0x20F0: 230, 0x2CEF: 230, 0x2CF0: 230, 0x2CF1: 230,
+	0x2D7F: 9, 0x2DE0: 230, 0x2DE1: 230, 0x2DE2: 230,
+	0x2DE3: 230, 0x2DE4: 230, 0x2DE5: 230, 0x2DE6: 230,
+	0x2DE7: 230, 0x2DE8: 230, 0x2DE9: 230, 0x2DEA: 230,
+	0x2DEB: 230, 0x2DEC: 230, 0x2DED: 230, 0x2DEE: 230,
+	0x2DEF: 230, 0x2DF0: 230, 0x2DF1: 230, 0x2DF2: 230,
+	0x2DF3: 230, 0x2DF4: 230, 0x2DF5: 230, 0x2DF6: 230,
+	0x2DF7: 230, 0x2DF8: 230, 0x2DF9: 230, 0x2DFA: 230,
+	0x2DFB: 230, 0x2DFC: 230, 0x2DFD: 230, 0x2DFE: 230,
+	0x2DFF: 230, 0x302A: 218, 0x302B: 228, 0x302C: 232,
+	0x302D: 222, 0x302E: 224, 0x302F: 224, 0x3099: 8,
+	0x309A: 8, 0xA66F: 230, 0xA674: 230, 0xA675: 230,
+	0xA676: 230, 0xA677: 230, 0xA678: 230, 0xA679: 230,
+	0xA67A: 230, 0xA67B: 230, 0xA67C: 230, 0xA67D: 230,
+	0xA69E: 230, 0xA69F: 230, 0xA6F0: 230, 0xA6F1: 230,
+	0xA806: 9, 0xA82C: 9, 0xA8C4: 9, 0xA8E0: 230,
+	0xA8E1: 230, 0xA8E2: 230, 0xA8E3: 230, 0xA8E4: 230,
+	0xA8E5: 230, 0xA8E6: 230, 0xA8E7: 230, 0xA8E8: 230,
+	0xA8E9: 230, 0xA8EA: 230, 0xA8EB: 230, 0xA8EC: 230,
+	0xA8ED: 230, 0xA8EE: 230, 0xA8EF: 230, 0xA8F0: 230,
+	0xA8F1: 230, 0xA92B: 220, 0xA92C: 220, 0xA92D: 220,
+	0xA953: 9, 0xA9B3: 7, 0xA9C0: 9, 0xAAB0: 230,
+	0xAAB2: 230, 0xAAB3: 230, 0xAAB4: 220, 0xAAB7: 230,
+	0xAAB8: 230, 0xAABE: 230, 0xAABF: 230, 0xAAC1: 230,
+	0xAAF6: 9, 0xABED: 9, 0xFB1E: 26, 0xFE20: 230,
+	0xFE21: 230, 0xFE22: 230, 0xFE23: 230, 0xFE24: 230,
+	0xFE25: 230, 0xFE26: 230, 0xFE27: 220, 0xFE28: 220,
+	0xFE29: 220, 0xFE2A: 220, 0xFE2B: 220, 0xFE2C: 220,
+	0xFE2D: 220, 0xFE2E: 230, 0xFE2F: 230, 0x101FD: 220,
+	0x102E0: 220, 0x10376: 230, 0x10377: 230, 0x10378: 230,
+	0x10379: 230, 0x1037A: 230, 0x10A0D: 220, 0x10A0F: 230,
+	0x10A38: 230, 0x10A39: 1, 0x10A3A: 220, 0x10A3F: 9,
+	0x10AE5: 230, 0x10AE6: 220, 0x10D24: 230, 0x10D25: 230,
+	0x10D26: 230, 0x10D27: 230, 0x10EAB: 230, 0x10EAC: 230,
+	0x10EFD: 220, 0x10EFE: 220, 0x10EFF: 220, 0x10F46: 220,
+	0x10F47: 220, 0x10F48: 230, 0x10F49: 230, 0x10F4A: 230,
+	0x10F4B: 220, 0x10F4C: 230, 0x10F4D: 220, 0x10F4E: 220,
+	0x10F4F: 220, 0x10F50: 220, 0x10F82: 230, 0x10F83: 220,
+	0x10F84: 230, 0x10F85: 220, 0x11046: 9, 0x11070: 9,
+	0x1107F: 9, 0x110B9: 9, 0x110BA: 7, 0x11100: 230,
+	0x11101: 230, 0x11102: 230, 0x11133: 9, 0x11134: 9,
+	0x11173: 7, 0x111C0: 9, 0x111CA: 7, 0x11235: 9,
+	0x11236: 7, 0x112E9: 7, 0x112EA: 9, 0x1133B: 7,
+	0x1133C: 7, 0x1134D: 9, 0x11366: 230, 0x11367: 230,
+	0x11368: 230, 0x11369: 230, 0x1136A: 230, 0x1136B: 230,
+	0x1136C: 230, 0x11370: 230, 0x11371: 230, 0x11372: 230,
+	0x11373: 230, 0x11374: 230, 0x11442: 9, 0x11446: 7,
+	0x1145E: 230, 0x114C2: 9, 0x114C3: 7, 0x115BF: 9,
+	0x115C0: 7, 0x1163F: 9, 0x116B6: 9, 0x116B7: 7,
+	0x1172B: 9, 0x11839: 9, 0x1183A: 7, 0x1193D: 9,
+	0x1193E: 9, 0x11943: 7, 0x119E0: 9, 0x11A34: 9,
+	0x11A47: 9, 0x11A99: 9, 0x11C3F: 9, 0x11D42: 7,
+	0x11D44: 9, 0x11D45: 9, 0x11D97: 9, 0x11F41: 9,
+	0x11F42: 9, 0x16AF0: 1, 0x16AF1: 1, 0x16AF2: 1,
+	0x16AF3: 1, 0x16AF4: 1, 0x16B30: 230, 0x16B31: 230,
+	0x16B32: 230, 0x16B33: 230, 0x16B34: 230, 0x16B35: 230,
+	0x16B36: 230, 0x16FF0: 6, 0x16FF1: 6, 0x1BC9E: 1,
+	0x1D165: 216, 0x1D166: 216, 0x1D167: 1, 0x1D168: 1,
+	0x1D169: 1, 0x1D16D: 226, 0x1D16E: 216, 0x1D16F: 216,
+	0x1D170: 216, 0x1D171: 216, 0x1D172: 216, 0x1D17B: 220,
+	0x1D17C: 220, 0x1D17D: 220, 0x1D17E: 220, 0x1D17F: 220,
+	0x1D180: 220, 0x1D181: 220, 0x1D182: 220, 0x1D185: 230,
+	0x1D186: 230, 0x1D187: 230, 0x1D188: 230, 0x1D189: 230,
+	0x1D18A: 220, 0x1D18B: 220, 0x1D1AA: 230, 0x1D1AB: 230,
+	0x1D1AC: 230, 0x1D1AD: 230, 0x1D242: 230, 0x1D243: 230,
+	0x1D244: 230, 0x1E000: 230, 0x1E001: 230, 0x1E002: 230,
+	0x1E003: 230, 0x1E004: 230, 0x1E005: 230, 0x1E006: 230,
+	0x1E008: 230, 0x1E009: 230, 0x1E00A: 230, 0x1E00B: 230,
+	0x1E00C: 230, 0x1E00D: 230, 0x1E00E: 230, 0x1E00F: 230,
+	0x1E010: 230, 0x1E011: 230, 0x1E012: 230, 0x1E013: 230,
+	0x1E014: 230, 0x1E015: 230, 0x1E016: 230, 0x1E017: 230,
+	0x1E018: 230, 0x1E01B: 230, 0x1E01C: 230, 0x1E01D: 230,
+	0x1E01E: 230, 0x1E01F: 230, 0x1E020: 230, 0x1E021: 230,
+	0x1E023: 230, 0x1E024: 230, 0x1E026: 230, 0x1E027: 230,
+	0x1E028: 230, 0x1E029: 230, 0x1E02A: 230, 0x1E08F: 230,
+	0x1E130: 230, 0x1E131: 230, 0x1E132: 230, 0x1E133: 230,
+	0x1E134: 230, 0x1E135: 230, 0x1E136: 230, 0x1E2AE: 230,
+	0x1E2EC: 230, 0x1E2ED: 230, 0x1E2EE: 230, 0x1E2EF: 230,
+	0x1E4EC: 232, 0x1E4ED: 232, 0x1E4EE: 220, 0x1E4EF: 230,
+	0x1E8D0: 220, 0x1E8D1: 220, 0x1E8D2: 220, 0x1E8D3: 220,
+	0x1E8D4: 220, 0x1E8D5: 220, 0x1E8D6: 220, 0x1E944: 230,
+	0x1E945: 230, 0x1E946: 230, 0x1E947: 230, 0x1E948: 230,
+	0x1E949: 230, 0x1E94A: 7,
+}
+
+// compositions maps pairs of code points to their primary composite.
+var compositions = map[[2]rune]rune{
+	{0x3C, 0x338}: 0x226E, {0x3D, 0x338}: 0x2260, {0x3E, 0x338}: 0x226F, {0x41, 0x300}: 0xC0,
+	{0x41, 0x301}: 0xC1, {0x41, 0x302}: 0xC2, {0x41, 0x303}: 0xC3, {0x41, 0x304}: 0x100,
+	{0x41, 0x306}: 0x102, {0x41, 0x307}: 0x226, {0x41, 0x308}: 0xC4, {0x41, 0x309}: 0x1EA2,
+	{0x41, 0x30A}: 0xC5, {0x41, 0x30C}: 0x1CD, {0x41, 0x30F}: 0x200, {0x41, 0x311}: 0x202,
+	{0x41, 0x323}: 0x1EA0, {0x41, 0x325}: 0x1E00, {0x41, 0x328}: 0x104, {0x42, 0x307}: 0x1E02,
+	{0x42, 0x323}: 0x1E04, {0x42, 0x331}: 0x1E06, {0x43, 0x301}: 0x106, {0x43, 0x302}: 0x108,
+	{0x43, 0x307}: 0x10A, {0x43, 0x30C}: 0x10C, {0x43, 0x327}: 0xC7, {0x44, 0x307}: 0x1E0A,
+	{0x44, 0x30C}: 0x10E, {0x44, 0x323}: 0x1E0C, {0x44, 0x327}: 0x1E10, {0x44, 0x32D}: 0x1E12,
+	{0x44, 0x331}: 0x1E0E, {0x45, 0x300}: 0xC8, {0x45, 0x301}: 0xC9, {0x45, 0x302}: 0xCA,
+	{0x45, 0x303}: 0x1EBC, {0x45, 0x304}: 0x112, {0x45, 0x306}: 0x114, {0x45, 0x307}: 0x116,
+	{0x45, 0x308}: 0xCB, {0x45, 0x309}: 0x1EBA, {0x45, 0x30C}: 0x11A, {0x45, 0x30F}: 0x204,
+	{0x45, 0x311}: 0x206, {0x45, 0x323}: 0x1EB8, {0x45, 0x327}: 0x228, {0x45, 0x328}: 0x118,
+	{0x45, 0x32D}: 0x1E18, {0x45, 0x330}: 0x1E1A, {0x46, 0x307}: 0x1E1E, {0x47, 0x301}: 0x1F4,
+	{0x47, 0x302}: 0x11C, {0x47, 0x304}: 0x1E20, {0x47, 0x306}: 0x11E, {0x47, 0x307}: 0x120,
+	{0x47, 0x30C}: 0x1E6, {0x47, 0x327}: 0x122, {0x48, 0x302}: 0x124, {0x48, 0x307}: 0x1E22,
+	{0x48, 0x308}: 0x1E26, {0x48, 0x30C}: 0x21E, {0x48, 0x323}: 0x1E24, {0x48, 0x327}: 0x1E28,
+	{0x48, 0x32E}: 0x1E2A, {0x49, 0x300}: 0xCC, {0x49, 0x301}: 0xCD, {0x49, 0x302}: 0xCE,
+	{0x49, 0x303}: 0x128, {0x49, 0x304}: 0x12A, {0x49, 0x306}: 0x12C, {0x49, 0x307}: 0x130,
+	{0x49, 0x308}: 0xCF, {0x49, 0x309}: 0x1EC8, {0x49, 0x30C}: 0x1CF, {0x49, 0x30F}: 0x208,
+	{0x49, 0x311}: 0x20A, {0x49, 0x323}: 0x1ECA, {0x49, 0x328}: 0x12E, {0x49, 0x330}: 0x1E2C,
+	{0x4A, 0x302}: 0x134, {0x4B, 0x301}: 0x1E30, {0x4B, 0x30C}: 0x1E8, {0x4B, 0x323}: 0x1E32,
+	{0x4B, 0x327}: 0x136, {0x4B, 0x331}: 0x1E34, {0x4C, 0x301}: 0x139, {0x4C, 0x30C}: 0x13D,
+	{0x4C, 0x323}: 0x1E36, {0x4C, 0x327}: 0x13B, {0x4C, 0x32D}: 0x1E3C, {0x4C, 0x331}: 0x1E3A,
+	{0x4D, 0x301}: 0x1E3E, {0x4D, 0x307}: 0x1E40, {0x4D, 0x323}: 0x1E42, {0x4E, 0x300}: 0x1F8,
+	{0x4E, 0x301}: 0x143, {0x4E, 0x303}: 0xD1, {0x4E, 0x307}: 0x1E44, {0x4E, 0x30C}: 0x147,
+	{0x4E, 0x323}: 0x1E46, {0x4E, 0x327}: 0x145, {0x4E, 0x32D}: 0x1E4A, {0x4E, 0x331}: 0x1E48,
+	{0x4F, 0x300}: 0xD2, {0x4F, 0x301}: 0xD3, {0x4F, 0x302}: 0xD4, {0x4F, 0x303}: 0xD5,
+	{0x4F, 0x304}: 0x14C, {0x4F, 0x306}: 0x14E, {0x4F, 0x307}: 0x22E, {0x4F, 0x308}: 0xD6,
+	{0x4F, 0x309}: 0x1ECE, {0x4F, 0x30B}: 0x150, {0x4F, 0x30C}: 0x1D1, {0x4F, 0x30F}: 0x20C,
+	{0x4F, 0x311}: 0x20E, {0x4F, 0x31B}: 0x1A0, {0x4F, 0x323}: 0x1ECC, {0x4F, 0x328}: 0x1EA,
+	{0x50, 0x301}: 0x1E54, {0x50, 0x307}: 0x1E56, {0x52, 0x301}: 0x154, {0x52, 0x307}: 0x1E58,
+	{0x52, 0x30C}: 0x158, {0x52, 0x30F}: 0x210, {0x52, 0x311}: 0x212, {0x52, 0x323}: 0x1E5A,
+	{0x52, 0x327}: 0x156, {0x52, 0x331}: 0x1E5E, {0x53, 0x301}: 0x15A, {0x53, 0x302}: 0x15C,
+	{0x53, 0x307}: 0x1E60, {0x53, 0x30C}: 0x160, {0x53, 0x323}: 0x1E62, {0x53, 0x326}: 0x218,
+	{0x53, 0x327}: 0x15E, {0x54, 0x307}: 0x1E6A, {0x54, 0x30C}: 0x164, {0x54, 0x323}: 0x1E6C,
+	{0x54, 0x326}: 0x21A, {0x54, 0x327}: 0x162, {0x54, 0x32D}: 0x1E70, {0x54, 0x331}: 0x1E6E,
+	{0x55, 0x300}: 0xD9, {0x55, 0x301}: 0xDA, {0x55, 0x302}: 0xDB, {0x55, 0x303}: 0x168,
+	{0x55, 0x304}: 0x16A, {0x55, 0x306}: 0x16C, {0x55, 0x308}: 0xDC, {0x55, 0x309}: 0x1EE6,
+	{0x55, 0x30A}: 0x16E, {0x55, 0x30B}: 0x170, {0x55, 0x30C}: 0x1D3, {0x55, 0x30F}: 0x214,
+	{0x55, 0x311}: 0x216, {0x55, 0x31B}: 0x1AF, {0x55, 0x323}: 0x1EE4, {0x55, 0x324}: 0x1E72,
+	{0x55, 0x328}: 0x172, {0x55, 0x32D}: 0x1E76, {0x55, 0x330}: 0x1E74, {0x56, 0x303}: 0x1E7C,
+	{0x56, 0x323}: 0x1E7E, {0x57, 0x300}: 0x1E80, {0x57, 0x301}: 0x1E82, {0x57, 0x302}: 0x174,
+	{0x57, 0x307}: 0x1E86, {0x57, 0x308}: 0x1E84, {0x57, 0x323}: 0x1E88, {0x58, 0x307}: 0x1E8A,
+	{0x58, 0x308}: 0x1E8C, {0x59, 0x300}: 0x1EF2, {0x59, 0x301}: 0xDD, {0x59, 0x302}: 0x176,
+	{0x59, 0x303}: 0x1EF8, {0x59, 0x304}: 0x232, {0x59, 0x307}: 0x1E8E, {0x59, 0x308}: 0x178,
+	{0x59, 0x309}: 0x1EF6, {0x59, 0x323}: 0x1EF4, {0x5A, 0x301}: 0x179, {0x5A, 0x302}: 0x1E90,
+	{0x5A, 0x307}: 0x17B, {0x5A, 0x30C}: 0x17D, {0x5A, 0x323}: 0x1E92, {0x5A, 0x331}: 0x1E94,
+	{0x61, 0x300}: 0xE0, {0x61, 0x301}: 0xE1, {0x61, 0x302}: 0xE2, {0x61, 0x303}: 0xE3,
+	{0x61, 0x304}: 0x101, {0x61, 0x306}: 0x103, {0x61, 0x307}: 0x227, {0x61, 0x308}: 0xE4,
+	{0x61, 0x309}: 0x1EA3, {0x61, 0x30A}: 0xE5, {0x61, 0x30C}: 0x1CE, {0x61, 0x30F}: 0x201,
+	{0x61, 0x311}: 0x203, {0x61, 0x323}: 0x1EA1, {0x61, 0x325}: 0x1E01, {0x61, 0x328}: 0x105,
+	{0x62, 0x307}: 0x1E03, {0x62, 0x323}: 0x1E05, {0x62, 0x331}: 0x1E07, {0x63, 0x301}: 0x107,
+	{0x63, 0x302}: 0x109, {0x63, 0x307}: 0x10B, {0x63, 0x30C}: 0x10D, {0x63, 0x327}: 0xE7,
+	{0x64, 0x307}: 0x1E0B, {0x64, 0x30C}: 0x10F, {0x64, 0x323}: 0x1E0D, {0x64, 0x327}: 0x1E11,
+	{0x64, 0x32D}: 0x1E13, {0x64, 0x331}: 0x1E0F, {0x65, 0x300}: 0xE8, {0x65, 0x301}: 0xE9,
+	{0x65, 0x302}: 0xEA, {0x65, 0x303}: 0x1EBD, {0x65, 0x304}: 0x113, {0x65, 0x306}: 0x115,
+	{0x65, 0x307}: 0x117, {0x65, 0x308}: 0xEB, {0x65, 0x309}: 0x1EBB, {0x65, 0x30C}: 0x11B,
+	{0x65, 0x30F}: 0x205, {0x65, 0x311}: 0x207, {0x65, 0x323}: 0x1EB9, {0x65, 0x327}: 0x229,
+	{0x65, 0x328}: 0x119, {0x65, 0x32D}: 0x1E19, {0x65, 0x330}: 0x1E1B, {0x66, 0x307}: 0x1E1F,
+	{0x67, 0x301}: 0x1F5, {0x67, 0x302}: 0x11D, {0x67, 0x304}: 0x1E21, {0x67, 0x306}: 0x11F,
+	{0x67, 0x307}: 0x121, {0x67, 0x30C}: 0x1E7, {0x67, 0x327}: 0x123, {0x68, 0x302}: 0x125,
+	{0x68, 0x307}: 0x1E23, {0x68, 0x308}: 0x1E27, {0x68, 0x30C}: 0x21F, {0x68, 0x323}: 0x1E25,
+	{0x68, 0x327}: 0x1E29, {0x68, 0x32E}: 0x1E2B, {0x68, 0x331}: 0x1E96, {0x69, 0x300}: 0xEC,
+	{0x69, 0x301}: 0xED, {0x69, 0x302}: 0xEE, {0x69, 0x303}: 0x129, {0x69, 0x304}: 0x12B,
+	{0x69, 0x306}: 0x12D, {0x69, 0x308}: 0xEF, {0x69, 0x309}: 0x1EC9, {0x69, 0x30C}: 0x1D0,
+	{0x69, 0x30F}: 0x209, {0x69, 0x311}: 0x20B, {0x69, 0x323}: 0x1ECB, {0x69, 0x328}: 0x12F,
+	{0x69, 0x330}: 0x1E2D, {0x6A, 0x302}: 0x135, {0x6A, 0x30C}: 0x1F0, {0x6B, 0x301}: 0x1E31,
+	{0x6B, 0x30C}: 0x1E9, {0x6B, 0x323}: 0x1E33, {0x6B, 0x327}: 0x137, {0x6B, 0x331}: 0x1E35,
+	{0x6C, 0x301}: 0x13A, {0x6C, 0x30C}: 0x13E, {0x6C, 0x323}: 0x1E37, {0x6C, 0x327}: 0x13C,
+	{0x6C, 0x32D}: 0x1E3D, {0x6C, 0x331}: 0x1E3B, {0x6D, 0x301}: 0x1E3F, {0x6D, 0x307}: 0x1E41,
+	{0x6D, 0x323}: 0x1E43, {0x6E, 0x300}: 0x1F9, {0x6E, 0x301}: 0x144, {0x6E, 0x303}: 0xF1,
+	{0x6E, 0x307}: 0x1E45, {0x6E, 0x30C}: 0x148, {0x6E, 0x323}: 0x1E47, {0x6E, 0x327}: 0x146,
+	{0x6E, 0x32D}: 0x1E4B, {0x6E, 0x331}: 0x1E49, {0x6F, 0x300}: 0xF2, {0x6F, 0x301}: 0xF3,
+	{0x6F, 0x302}: 0xF4, {0x6F, 0x303}: 0xF5, {0x6F, 0x304}: 0x14D, {0x6F, 0x306}: 0x14F,
+	{0x6F, 0x307}: 0x22F, {0x6F, 0x308}: 0xF6, {0x6F, 0x309}: 0x1ECF, {0x6F, 0x30B}: 0x151,
+	{0x6F, 0x30C}: 0x1D2, {0x6F, 0x30F}: 0x20D, {0x6F, 0x311}: 0x20F, {0x6F, 0x31B}: 0x1A1,
+	{0x6F, 0x323}: 0x1ECD, {0x6F, 0x328}: 0x1EB, {0x70, 0x301}: 0x1E55, {0x70, 0x307}: 0x1E57,
+	{0x72, 0x301}: 0x155, {0x72, 0x307}: 0x1E59, {0x72, 0x30C}: 0x159, {0x72, 0x30F}: 0x211,
+	{0x72, 0x311}: 0x213, {0x72, 0x323}: 0x1E5B, {0x72, 0x327}: 0x157, {0x72, 0x331}: 0x1E5F,
+	{0x73, 0x301}: 0x15B, {0x73, 0x302}: 0x15D, {0x73, 0x307}: 0x1E61, {0x73, 0x30C}: 0x161,
+	{0x73, 0x323}: 0x1E63, {0x73, 0x326}: 0x219, {0x73, 0x327}: 0x15F, {0x74, 0x307}: 0x1E6B,
+	{0x74, 0x308}: 0x1E97, {0x74, 0x30C}: 0x165, {0x74, 0x323}: 0x1E6D, {0x74, 0x326}: 0x21B,
+	{0x74, 0x327}: 0x163, {0x74, 0x32D}: 0x1E71, {0x74, 0x331}: 0x1E6F, {0x75, 0x300}: 0xF9,
+	{0x75, 0x301}: 0xFA, {0x75, 0x302}: 0xFB, {0x75, 0x303}: 0x169, {0x75, 0x304}: 0x16B,
+	{0x75, 0x306}: 0x16D, {0x75, 0x308}: 0xFC, {0x75, 0x309}: 0x1EE7, {0x75, 0x30A}: 0x16F,
+	{0x75, 0x30B}: 0x171, {0x75, 0x30C}: 0x1D4, {0x75, 0x30F}: 0x215, {0x75, 0x311}: 0x217,
+	{0x75, 0x31B}: 0x1B0, {0x75, 0x323}: 0x1EE5, {0x75, 0x324}: 0x1E73, {0x75, 0x328}: 0x173,
+	{0x75, 0x32D}: 0x1E77, {0x75, 0x330}: 0x1E75, {0x76, 0x303}: 0x1E7D, {0x76, 0x323}: 0x1E7F,
+	{0x77, 0x300}: 0x1E81, {0x77, 0x301}: 0x1E83, {0x77, 0x302}: 0x175, {0x77, 0x307}: 0x1E87,
+	{0x77, 0x308}: 0x1E85, {0x77, 0x30A}: 0x1E98, {0x77, 0x323}: 0x1E89, {0x78, 0x307}: 0x1E8B,
+	{0x78, 0x308}: 0x1E8D, {0x79, 0x300}: 0x1EF3, {0x79, 0x301}: 0xFD, {0x79, 0x302}: 0x177,
+	{0x79, 0x303}: 0x1EF9, {0x79, 0x304}: 0x233, {0x79, 0x307}: 0x1E8F, {0x79, 0x308}: 0xFF,
+	{0x79, 0x309}: 0x1EF7, {0x79, 0x30A}: 0x1E99, {0x79, 0x323}: 0x1EF5, {0x7A, 0x301}: 0x17A,
+	{0x7A, 0x302}: 0x1E91, {0x7A, 0x307}: 0x17C, {0x7A, 0x30C}: 0x17E, {0x7A, 0x323}: 0x1E93,
+	{0x7A, 0x331}: 0x1E95, {0xA8, 0x300}: 0x1FED, {0xA8, 0x301}: 0x385, {0xA8, 0x342}: 0x1FC1,
+	{0xC2, 0x300}: 0x1EA6, {0xC2, 0x301}: 0x1EA4, {0xC2, 0x303}: 0x1EAA, {0xC2, 0x309}: 0x1EA8,
+	{0xC4, 0x304}: 0x1DE, {0xC5, 0x301}: 0x1FA, {0xC6, 0x301}: 0x1FC, {0xC6, 0x304}: 0x1E2,
+	{0xC7, 0x301}: 0x1E08, {0xCA, 0x300}: 0x1EC0, {0xCA, 0x301}: 0x1EBE, {0xCA, 0x303}: 0x1EC4,
+	{0xCA, 0x309}: 0x1EC2, {0xCF, 0x301}: 0x1E2E, {0xD4, 0x300}: 0x1ED2, {0xD4, 0x301}: 0x1ED0,
+	{0xD4, 0x303}: 0x1ED6, {0xD4, 0x309}: 0x1ED4, {0xD5, 0x301}: 0x1E4C, {0xD5, 0x304}: 0x22C,
+	{0xD5, 0x308}: 0x1E4E, {0xD6, 0x304}: 0x22A, {0xD8, 0x301}: 0x1FE, {0xDC, 0x300}: 0x1DB,
+	{0xDC, 0x301}: 0x1D7, {0xDC, 0x304}: 0x1D5, {0xDC, 0x30C}: 0x1D9, {0xE2, 0x300}: 0x1EA7,
+	{0xE2, 0x301}: 0x1EA5, {0xE2, 0x303}: 0x1EAB, {0xE2, 0x309}: 0x1EA9, {0xE4, 0x304}: 0x1DF,
+	{0xE5, 0x301}: 0x1FB, {0xE6, 0x301}: 0x1FD, {0xE6, 0x304}: 0x1E3, {0xE7, 0x301}: 0x1E09,
+	{0xEA, 0x300}: 0x1EC1, {0xEA, 0x301}: 0x1EBF, {0xEA, 0x303}: 0x1EC5, {0xEA, 0x309}: 0x1EC3,
+	{0xEF, 0x301}: 0x1E2F, {0xF4, 0x300}: 0x1ED3, {0xF4, 0x301}: 0x1ED1, {0xF4, 0x303}: 0x1ED7,
+	{0xF4, 0x309}: 0x1ED5, {0xF5, 0x301}: 0x1E4D, {0xF5, 0x304}: 0x22D, {0xF5, 0x308}: 0x1E4F,
+	{0xF6, 0x304}: 0x22B, {0xF8, 0x301}: 0x1FF, {0xFC, 0x300}: 0x1DC, {0xFC, 0x301}: 0x1D8,
+	{0xFC, 0x304}: 0x1D6, {0xFC, 0x30C}: 0x1DA, {0x102, 0x300}: 0x1EB0, {0x102, 0x301}: 0x1EAE,
+	{0x102, 0x303}: 0x1EB4, {0x102, 0x309}: 0x1EB2, {0x103, 0x300}: 0x1EB1, {0x103, 0x301}: 0x1EAF,
+	{0x103, 0x303}: 0x1EB5, {0x103, 0x309}: 0x1EB3, {0x112, 0x300}: 0x1E14, {0x112, 0x301}: 0x1E16,
+	{0x113, 0x300}: 0x1E15, {0x113, 0x301}: 0x1E17, {0x14C, 0x300}: 0x1E50, {0x14C, 0x301}: 0x1E52,
+	{0x14D, 0x300}: 0x1E51, {0x14D, 0x301}: 0x1E53, {0x15A, 0x307}: 0x1E64, {0x15B, 0x307}: 0x1E65,
+	{0x160, 0x307}: 0x1E66, {0x161, 0x307}: 0x1E67, {0x168, 0x301}: 0x1E78, {0x169, 0x301}: 0x1E79,
+	{0x16A, 0x308}: 0x1E7A, {0x16B, 0x308}: 0x1E7B, {0x17F, 0x307}: 0x1E9B, {0x1A0, 0x300}: 0x1EDC,
+	{0x1A0, 0x301}: 0x1EDA, {0x1A0, 0x303}: 0x1EE0, {0x1A0, 0x309}: 0x1EDE, {0x1A0, 0x323}: 0x1EE2,
+	{0x1A1, 0x300}: 0x1EDD, {0x1A1, 0x301}: 0x1EDB, {0x1A1, 0x303}: 0x1EE1, {0x1A1, 0x309}: 0x1EDF,
+	{0x1A1, 0x323}: 0x1EE3, {0x1AF, 0x300}: 0x1EEA, {0x1AF, 0x301}: 0x1EE8, {0x1AF, 0x303}: 0x1EEE,
+	{0x1AF, 0x309}: 0x1EEC, {0x1AF, 0x323}: 0x1EF0, {0x1B0, 0x300}: 0x1EEB, {0x1B0, 0x301}: 0x1EE9,
+	{0x1B0, 0x303}: 0x1EEF, {0x1B0, 0x309}: 0x1EED, {0x1B0, 0x323}: 0x1EF1, {0x1B7, 0x30C}: 0x1EE,
+	{0x1EA, 0x304}: 0x1EC, {0x1EB, 0x304}: 0x1ED, {0x226, 0x304}: 0x1E0, {0x227, 0x304}: 0x1E1,
+	{0x228, 0x306}: 0x1E1C, {0x229, 0x306}: 0x1E1D, {0x22E, 0x304}: 0x230, {0x22F, 0x304}: 0x231,
+	{0x292, 0x30C}: 0x1EF, {0x391, 0x300}: 0x1FBA, {0x391, 0x301}: 0x386, {0x391, 0x304}: 0x1FB9,
+	{0x391, 0x306}: 0x1FB8, {0x391, 0x313}: 0x1F08, {0x391, 0x314}: 0x1F09, {0x391, 0x345}: 0x1FBC,
+	{0x395, 0x300}: 0x1FC8, {0x395, 0x301}: 0x388, {0x395, 0x313}: 0x1F18, {0x395, 0x314}: 0x1F19,
+	{0x397, 0x300}: 0x1FCA, {0x397, 0x301}: 0x389, {0x397, 0x313}: 0x1F28, {0x397, 0x314}: 0x1F29,
+	{0x397, 0x345}: 0x1FCC, {0x399, 0x300}: 0x1FDA, {0x399, 0x301}: 0x38A, {0x399, 0x304}: 0x1FD9,
+	{0x399, 0x306}: 0x1FD8, {0x399, 0x308}: 0x3AA, {0x399, 0x313}: 0x1F38, {0x399, 0x314}: 0x1F39,
+	{0x39F, 0x300}: 0x1FF8, {0x39F, 0x301}: 0x38C, {0x39F, 0x313}: 0x1F48, {0x39F, 0x314}: 0x1F49,
+	{0x3A1, 0x314}: 0x1FEC, {0x3A5, 0x300}: 0x1FEA, {0x3A5, 0x301}: 0x38E, {0x3A5, 0x304}: 0x1FE9,
+	{0x3A5, 0x306}: 0x1FE8, {0x3A5, 0x308}: 0x3AB, {0x3A5, 0x314}: 0x1F59, {0x3A9, 0x300}: 0x1FFA,
+	{0x3A9, 0x301}: 0x38F, {0x3A9, 0x313}: 0x1F68, {0x3A9, 0x314}: 0x1F69, {0x3A9, 0x345}: 0x1FFC,
+	{0x3AC, 0x345}: 0x1FB4, {0x3AE, 0x345}: 0x1FC4, {0x3B1, 0x300}: 0x1F70, {0x3B1, 0x301}: 0x3AC,
+	{0x3B1, 0x304}: 0x1FB1, {0x3B1, 0x306}: 0x1FB0, {0x3B1, 0x313}: 0x1F00, {0x3B1, 0x314}: 0x1F01,
+	{0x3B1, 0x342}: 0x1FB6, {0x3B1, 0x345}: 0x1FB3, {0x3B5, 0x300}: 0x1F72, {0x3B5, 0x301}: 0x3AD,
+	{0x3B5, 0x313}: 0x1F10, {0x3B5, 0x314}: 0x1F11, {0x3B7, 0x300}: 0x1F74, {0x3B7, 0x301}: 0x3AE,
+	{0x3B7, 0x313}: 0x1F20, {0x3B7, 0x314}: 0x1F21, {0x3B7, 0x342}: 0x1FC6, {0x3B7, 0x345}: 0x1FC3,
+	{0x3B9, 0x300}: 0x1F76, {0x3B9, 0x301}: 0x3AF, {0x3B9, 0x304}: 0x1FD1, {0x3B9, 0x306}: 0x1FD0,
+	{0x3B9, 0x308}: 0x3CA, {0x3B9, 0x313}: 0x1F30, {0x3B9, 0x314}: 0x1F31, {0x3B9, 0x342}: 0x1FD6,
+	{0x3BF, 0x300}: 0x1F78, {0x3BF, 0x301}: 0x3CC, {0x3BF, 0x313}: 0x1F40, {0x3BF, 0x314}: 0x1F41,
+	{0x3C1, 0x313}: 0x1FE4, {0x3C1, 0x314}: 0x1FE5, {0x3C5, 0x300}: 0x1F7A, {0x3C5, 0x301}: 0x3CD,
+	{0x3C5, 0x304}: 0x1FE1, {0x3C5, 0x306}: 0x1FE0, {0x3C5, 0x308}: 0x3CB, {0x3C5, 0x313}: 0x1F50,
+	{0x3C5, 0x314}: 0x1F51, {0x3C5, 0x342}: 0x1FE6, {0x3C9, 0x300}: 0x1F7C, {0x3C9, 0x301}: 0x3CE,
+	{0x3C9, 0x313}: 0x1F60, {0x3C9, 0x314}: 0x1F61, {0x3C9, 0x342}: 0x1FF6, {0x3C9, 0x345}: 0x1FF3,
+	{0x3CA, 0x300}: 0x1FD2, {0x3CA, 0x301}: 0x390, {0x3CA, 0x342}: 0x1FD7, {0x3CB, 0x300}: 0x1FE2,
+	{0x3CB, 0x301}: 0x3B0, {0x3CB, 0x342}: 0x1FE7, {0x3CE, 0x345}: 0x1FF4, {0x3D2, 0x301}: 0x3D3,
+	{0x3D2, 0x308}: 0x3D4, {0x406, 0x308}: 0x407, {0x410, 0x306}: 0x4D0, {0x410, 0x308}: 0x4D2,
+	{0x413, 0x301}: 0x403, {0x415, 0x300}: 0x400, {0x415, 0x306}: 0x4D6, {0x415, 0x308}: 0x401,
+	{0x416, 0x306}: 0x4C1, {0x416, 0x308}: 0x4DC, {0x417, 0x308}: 0x4DE, {0x418, 0x300}: 0x40D,
+	{0x418, 0x304}: 0x4E2, {0x418, 0x306}: 0x419, {0x418, 0x308}: 0x4E4, {0x41A, 0x301}: 0x40C,
+	{0x41E, 0x308}: 0x4E6, {0x423, 0x304}: 0x4EE, {0x423, 0x306}: 0x40E, {0x423, 0x308}: 0x4F0,
+	{0x423, 0x30B}: 0x4F2, {0x427, 0x308}: 0x4F4, {0x42B, 0x308}: 0x4F8, {0x42D, 0x308}: 0x4EC,
+	{0x430, 0x306}: 0x4D1, {0x430, 0x308}: 0x4D3, {0x433, 0x301}: 0x453, {0x435, 0x300}: 0x450,
+	{0x435, 0x306}: 0x4D7, {0x435, 0x308}: 0x451, {0x436, 0x306}: 0x4C2, {0x436, 0x308}: 0x4DD,
+	{0x437, 0x308}: 0x4DF, {0x438, 0x300}: 0x45D, {0x438, 0x304}: 0x4E3, {0x438, 0x306}: 0x439,
+	{0x438, 0x308}: 0x4E5, {0x43A, 0x301}: 0x45C, {0x43E, 0x308}: 0x4E7, {0x443, 0x304}: 0x4EF,
+	{0x443, 0x306}: 0x45E, {0x443, 0x308}: 0x4F1, {0x443, 0x30B}: 0x4F3, {0x447, 0x308}: 0x4F5,
+	{0x44B, 0x308}: 0x4F9, {0x44D, 0x308}: 0x4ED, {0x456, 0x308}: 0x457, {0x474, 0x30F}: 0x476,
+	{0x475, 0x30F}: 0x477, {0x4D8, 0x308}: 0x4DA, {0x4D9, 0x308}: 0x4DB, {0x4E8, 0x308}: 0x4EA,
+	{0x4E9, 0x308}: 0x4EB, {0x627, 0x653}: 0x622, {0x627, 0x654}: 0x623, {0x627, 0x655}: 0x625,
+	{0x648, 0x654}: 0x624, {0x64A, 0x654}: 0x626, {0x6C1, 0x654}: 0x6C2, {0x6D2, 0x654}: 0x6D3,
+	{0x6D5, 0x654}: 0x6C0, {0x928, 0x93C}: 0x929, {0x930, 0x93C}: 0x931, {0x933, 0x93C}: 0x934,
+	{0x9C7, 0x9BE}: 0x9CB, {0x9C7, 0x9D7}: 0x9CC, {0xB47, 0xB3E}: 0xB4B, {0xB47, 0xB56}: 0xB48,
+	{0xB47, 0xB57}: 0xB4C, {0xB92, 0xBD7}: 0xB94, {0xBC6, 0xBBE}: 0xBCA, {0xBC6, 0xBD7}: 0xBCC,
+	{0xBC7, 0xBBE}: 0xBCB, {0xC46, 0xC56}: 0xC48, {0xCBF, 0xCD5}: 0xCC0, {0xCC6, 0xCC2}: 0xCCA,
+	{0xCC6, 0xCD5}: 0xCC7, {0xCC6, 0xCD6}: 0xCC8, {0xCCA, 0xCD5}: 0xCCB, {0xD46, 0xD3E}: 0xD4A,
+	{0xD46, 0xD57}: 0xD4C, {0xD47, 0xD3E}: 0xD4B, {0xDD9, 0xDCA}: 0xDDA, {0xDD9, 0xDCF}: 0xDDC,
+	{0xDD9, 0xDDF}: 0xDDE, {0xDDC, 0xDCA}: 0xDDD, {0x1025, 0x102E}: 0x1026, {0x1B05, 0x1B35}: 0x1B06,
+	{0x1B07, 0x1B35}: 0x1B08, {0x1B09, 0x1B35}: 0x1B0A, {0x1B0B, 0x1B35}: 0x1B0C, {0x1B0D, 0x1B35}: 0x1B0E,
+	{0x1B11, 0x1B35}: 0x1B12, {0x1B3A, 0x1B35}: 0x1B3B, {0x1B3C, 0x1B35}: 0x1B3D, {0x1B3E, 0x1B35}: 0x1B40,
+	{0x1B3F, 0x1B35}: 0x1B41, {0x1B42, 0x1B35}: 0x1B43, {0x1E36, 0x304}: 0x1E38, {0x1E37, 0x304}: 0x1E39,
+	{0x1E5A, 0x304}: 0x1E5C, {0x1E5B, 0x304}: 0x1E5D, {0x1E62, 0x307}: 0x1E68, {0x1E63, 0x307}: 0x1E69,
+	{0x1EA0, 0x302}: 0x1EAC, {0x1EA0, 0x306}: 0x1EB6, {0x1EA1, 0x302}: 0x1EAD, {0x1EA1, 0x306}: 0x1EB7,
+	{0x1EB8, 0x302}: 0x1EC6, {0x1EB9, 0x302}: 0x1EC7, {0x1ECC, 0x302}: 0x1ED8, {0x1ECD, 0x302}: 0x1ED9,
+	{0x1F00, 0x300}: 0x1F02, {0x1F00, 0x301}: 0x1F04, {0x1F00, 0x342}: 0x1F06, {0x1F00, 0x345}: 0x1F80,
+	{0x1F01, 0x300}: 0x1F03, {0x1F01, 0x301}: 0x1F05, {0x1F01, 0x342}: 0x1F07, {0x1F01, 0x345}: 0x1F81,
+	{0x1F02, 0x345}: 0x1F82, {0x1F03, 0x345}: 0x1F83, {0x1F04, 0x345}: 0x1F84, {0x1F05, 0x345}: 0x1F85,
+	{0x1F06, 0x345}: 0x1F86, {0x1F07, 0x345}: 0x1F87, {0x1F08, 0x300}: 0x1F0A, {0x1F08, 0x301}: 0x1F0C,
+	{0x1F08, 0x342}: 0x1F0E, {0x1F08, 0x345}: 0x1F88, {0x1F09, 0x300}: 0x1F0B, {0x1F09, 0x301}: 0x1F0D,
+	{0x1F09, 0x342}: 0x1F0F, {0x1F09, 0x345}: 0x1F89, {0x1F0A, 0x345}: 0x1F8A, {0x1F0B, 0x345}: 0x1F8B,
+	{0x1F0C, 0x345}: 0x1F8C, {0x1F0D, 0x345}: 0x1F8D, {0x1F0E, 0x345}: 0x1F8E, {0x1F0F, 0x345}: 0x1F8F,
+	{0x1F10, 0x300}: 0x1F12, {0x1F10, 0x301}: 0x1F14, {0x1F11, 0x300}: 0x1F13, {0x1F11, 0x301}: 0x1F15,
+	{0x1F18, 0x300}: 0x1F1A, {0x1F18, 0x301}: 0x1F1C, {0x1F19, 0x300}: 0x1F1B, {0x1F19, 0x301}: 0x1F1D,
+	{0x1F20, 0x300}: 0x1F22, {0x1F20, 0x301}: 0x1F24, {0x1F20, 0x342}: 0x1F26, {0x1F20, 0x345}: 0x1F90,
+	{0x1F21, 0x300}: 0x1F23, {0x1F21, 0x301}: 0x1F25, {0x1F21, 0x342}: 0x1F27, {0x1F21, 0x345}: 0x1F91,
+	{0x1F22, 0x345}: 0x1F92, {0x1F23, 0x345}: 0x1F93, {0x1F24, 0x345}: 0x1F94, {0x1F25, 0x345}: 0x1F95,
+	{0x1F26, 0x345}: 0x1F96, {0x1F27, 0x345}: 0x1F97, {0x1F28, 0x300}: 0x1F2A, {0x1F28, 0x301}: 0x1F2C,
+	{0x1F28, 0x342}: 0x1F2E, {0x1F28, 0x345}: 0x1F98, {0x1F29, 0x300}: 0x1F2B, {0x1F29, 0x301}: 0x1F2D,
+	{0x1F29, 0x342}: 0x1F2F, {0x1F29, 0x345}: 0x1F99, {0x1F2A, 0x345}: 0x1F9A, {0x1F2B, 0x345}: 0x1F9B,
+	{0x1F2C, 0x345}: 0x1F9C, {0x1F2D, 0x345}: 0x1F9D, {0x1F2E, 0x345}: 0x1F9E, {0x1F2F, 0x345}: 0x1F9F,
+	{0x1F30, 0x300}: 0x1F32, {0x1F30, 0x301}: 0x1F34, {0x1F30, 0x342}: 0x1F36, {0x1F31, 0x300}: 0x1F33,
+	{0x1F31, 0x301}: 0x1F35, {0x1F31, 0x342}: 0x1F37, {0x1F38, 0x300}: 0x1F3A, {0x1F38, 0x301}: 0x1F3C,
+	{0x1F38, 0x342}: 0x1F3E, {0x1F39, 0x300}: 0x1F3B, {0x1F39, 0x301}: 0x1F3D, {0x1F39, 0x342}: 0x1F3F,
+	{0x1F40, 0x300}: 0x1F42, {0x1F40, 0x301}: 0x1F44, {0x1F41, 0x300}: 0x1F43, {0x1F41, 0x301}: 0x1F45,
+	{0x1F48, 0x300}: 0x1F4A, {0x1F48, 0x301}: 0x1F4C, {0x1F49, 0x300}: 0x1F4B, {0x1F49, 0x301}: 0x1F4D,
+	{0x1F50, 0x300}: 0x1F52, {0x1F50, 0x301}: 0x1F54, {0x1F50, 0x342}: 0x1F56, {0x1F51, 0x300}: 0x1F53,
+	{0x1F51, 0x301}: 0x1F55, {0x1F51, 0x342}: 0x1F57, {0x1F59, 0x300}: 0x1F5B, {0x1F59, 0x301}: 0x1F5D,
+	{0x1F59, 0x342}: 0x1F5F, {0x1F60, 0x300}: 0x1F62, {0x1F60, 0x301}: 0x1F64, {0x1F60, 0x342}: 0x1F66,
+	{0x1F60, 0x345}: 0x1FA0, {0x1F61, 0x300}: 0x1F63, {0x1F61, 0x301}: 0x1F65, {0x1F61, 0x342}: 0x1F67,
+	{0x1F61, 0x345}: 0x1FA1, {0x1F62, 0x345}: 0x1FA2, {0x1F63, 0x345}: 0x1FA3, {0x1F64, 0x345}: 0x1FA4,
+	{0x1F65, 0x345}: 0x1FA5, {0x1F66, 0x345}: 0x1FA6, {0x1F67, 0x345}: 0x1FA7, {0x1F68, 0x300}: 0x1F6A,
+	{0x1F68, 0x301}: 0x1F6C, {0x1F68, 0x342}: 0x1F6E, {0x1F68, 0x345}: 0x1FA8, {0x1F69, 0x300}: 0x1F6B,
+	{0x1F69, 0x301}: 0x1F6D, {0x1F69, 0x342}: 0x1F6F, {0x1F69, 0x345}: 0x1FA9, {0x1F6A, 0x345}: 0x1FAA,
+	{0x1F6B, 0x345}: 0x1FAB, {0x1F6C, 0x345}: 0x1FAC, {0x1F6D, 0x345}: 0x1FAD, {0x1F6E, 0x345}: 0x1FAE,
+	{0x1F6F, 0x345}: 0x1FAF, {0x1F70, 0x345}: 0x1FB2, {0x1F74, 0x345}: 0x1FC2, {0x1F7C, 0x345}: 0x1FF2,
+	{0x1FB6, 0x345}: 0x1FB7, {0x1FBF, 0x300}: 0x1FCD, {0x1FBF, 0x301}: 0x1FCE, {0x1FBF, 0x342}: 0x1FCF,
+	{0x1FC6, 0x345}: 0x1FC7, {0x1FF6, 0x345}: 0x1FF7, {0x1FFE, 0x300}: 0x1FDD, {0x1FFE, 0x301}: 0x1FDE,
+	{0x1FFE, 0x342}: 0x1FDF, {0x2190, 0x338}: 0x219A, {0x2192, 0x338}: 0x219B, {0x2194, 0x338}: 0x21AE,
+	{0x21D0, 0x338}: 0x21CD, {0x21D2, 0x338}: 0x21CF, {0x21D4, 0x338}: 0x21CE, {0x2203, 0x338}: 0x2204,
+	{0x2208, 0x338}: 0x2209, {0x220B, 0x338}: 0x220C, {0x2223, 0x338}: 0x2224, {0x2225, 0x338}: 0x2226,
+	{0x223C, 0x338}: 0x2241, {0x2243, 0x338}: 0x2244, {0x2245, 0x338}: 0x2247, {0x2248, 0x338}: 0x2249,
+	{0x224D, 0x338}: 0x226D, {0x2261, 0x338}: 0x2262, {0x2264, 0x338}: 0x2270, {0x2265, 0x338}: 0x2271,
+	{0x2272, 0x338}: 0x2274, {0x2273, 0x338}: 0x2275, {0x2276, 0x338}: 0x2278, {0x2277, 0x338}: 0x2279,
+	{0x227A, 0x338}: 0x2280, {0x227B, 0x338}: 0x2281, {0x227C, 0x338}: 0x22E0, {0x227D, 0x338}: 0x22E1,
+	{0x2282, 0x338}: 0x2284, {0x2283, 0x338}: 0x2285, {0x2286, 0x338}: 0x2288, {0x2287, 0x338}: 0x2289,
+	{0x2291, 0x338}: 0x22E2, {0x2292, 0x338}: 0x22E3, {0x22A2, 0x338}: 0x22AC, {0x22A8, 0x338}: 0x22AD,
+	{0x22A9, 0x338}: 0x22AE, {0x22AB, 0x338}: 0x22AF, {0x22B2, 0x338}: 0x22EA, {0x22B3, 0x338}: 0x22EB,
+	{0x22B4, 0x338}: 0x22EC, {0x22B5, 0x338}: 0x22ED, {0x3046, 0x3099}: 0x3094, {0x304B, 0x3099}: 0x304C,
+	{0x304D, 0x3099}: 0x304E, {0x304F, 0x3099}: 0x3050, {0x3051, 0x3099}: 0x3052, {0x3053, 0x3099}: 0x3054,
+	{0x3055, 0x3099}: 0x3056, {0x3057, 0x3099}: 0x3058, {0x3059, 0x3099}: 0x305A, {0x305B, 0x3099}: 0x305C,
+	{0x305D, 0x3099}: 0x305E, {0x305F, 0x3099}: 0x3060, {0x3061, 0x3099}: 0x3062, {0x3064, 0x3099}: 0x3065,
+	{0x3066, 0x3099}: 0x3067, {0x3068, 0x3099}: 0x3069, {0x306F, 0x3099}: 0x3070, {0x306F, 0x309A}: 0x3071,
+	{0x3072, 0x3099}: 0x3073, {0x3072, 0x309A}: 0x3074, {0x3075, 0x3099}: 0x3076, {0x3075, 0x309A}: 0x3077,
+	{0x3078, 0x3099}: 0x3079, {0x3078, 0x309A}: 0x307A, {0x307B, 0x3099}: 0x307C, {0x307B, 0x309A}: 0x307D,
+	{0x309D, 0x3099}: 0x309E, {0x30A6, 0x3099}: 0x30F4, {0x30AB, 0x3099}: 0x30AC, {0x30AD, 0x3099}: 0x30AE,
+	{0x30AF, 0x3099}: 0x30B0, {0x30B1, 0x3099}: 0x30B2, {0x30B3, 0x3099}: 0x30B4, {0x30B5, 0x3099}: 0x30B6,
+	{0x30B7, 0x3099}: 0x30B8, {0x30B9, 0x3099}: 0x30BA, {0x30BB, 0x3099}: 0x30BC, {0x30BD, 0x3099}: 0x30BE,
+	{0x30BF, 0x3099}: 0x30C0, {0x30C1, 0x3099}: 0x30C2, {0x30C4, 0x3099}: 0x30C5, {0x30C6, 0x3099}: 0x30C7,
+	{0x30C8, 0x3099}: 0x30C9, {0x30CF, 0x3099}: 0x30D0, {0x30CF, 0x309A}: 0x30D1, {0x30D2, 0x3099}: 0x30D3,
+	{0x30D2, 0x309A}: 0x30D4, {0x30D5, 0x3099}: 0x30D6, {0x30D5, 0x309A}: 0x30D7, {0x30D8, 0x3099}: 0x30D9,
+	{0x30D8, 0x309A}: 0x30DA, {0x30DB, 0x3099}: 0x30DC, {0x30DB, 0x309A}: 0x30DD, {0x30EF, 0x3099}: 0x30F7,
+	{0x30F0, 0x3099}: 0x30F8, {0x30F1, 0x3099}: 0x30F9, {0x30F2, 0x3099}: 0x30FA, {0x30FD, 0x3099}: 0x30FE,
+	{0x11099, 0x110BA}: 0x1109A, {0x1109B, 0x110BA}: 0x1109C, {0x110A5, 0x110BA}: 0x110AB, {0x11131, 0x11127}: 0x1112E,
+	{0x11132, 0x11127}: 0x1112F, {0x11347, 0x1133E}: 0x1134B, {0x11347, 0x11357}: 0x1134C, {0x114B9, 0x114B0}: 0x114BC,
+	{0x114B9, 0x114BA}: 0x114BB, {0x114B9, 0x114BD}: 0x114BE, {0x115B8, 0x115AF}: 0x115BA, {0x115B9, 0x115AF}: 0x115BB,
+	{0x11935, 0x11930}: 0x11938,
+}
+
+// derivedProperties holds the ranges of code points which are PVALID,
+// CONTEXTJ or CONTEXTO (RFC5892 Section 3), sorted and without overlaps.
+// The rest are DISALLOWED or UNASSIGNED.
+var derivedProperties = []classRange{
+	{0x2D, 0x2D, derivedPVALID},
+	{0x30, 0x39, derivedPVALID},
+	{0x61, 0x7A, derivedPVALID},
+	{0xB7, 0xB7, derivedCONTEXTO},
+	{0xDF, 0xF6, derivedPVALID},
+	{0xF8, 0xFF, derivedPVALID},
+	{0x101, 0x101, derivedPVALID},
+	{0x103, 0x103, derivedPVALID},
+	{0x105, 0x105, derivedPVALID},
+	{0x107, 0x107, derivedPVALID},
+	{0x109, 0x109, derivedPVALID},
+	{0x10B, 0x10B, derivedPVALID},
+	{0x10D, 0x10D, derivedPVALID},
+	{0x10F, 0x10F, derivedPVALID},
+	{0x111, 0x111, derivedPVALID},
+	{0x113, 0x113, derivedPVALID},
+	{0x115, 0x115, derivedPVALID},
+	{0x117, 0x117, derivedPVALID},
+	{0x119, 0x119, derivedPVALID},
+	{0x11B, 0x11B, derivedPVALID},
+	{0x11D, 0x11D, derivedPVALID},
+	{0x11F, 0x11F, derivedPVALID},
+	{0x121, 0x121, derivedPVALID},
+	{0x123, 0x123, derivedPVALID},
+	{0x125, 0x125, derivedPVALID},
+	{0x127, 0x127, derivedPVALID},
+	{0x129, 0x129, derivedPVALID},
+	{0x12B, 0x12B, derivedPVALID},
+	{0x12D, 0x12D, derivedPVALID},
+	{0x12F, 0x12F, derivedPVALID},
+	{0x131, 0x131, derivedPVALID},
+	{0x135, 0x135, derivedPVALID},
+	{0x137, 0x138, derivedPVALID},
+	{0x13A, 0x13A, derivedPVALID},
+	{0x13C, 0x13C, derivedPVALID},
+	{0x13E, 0x13E, derivedPVALID},
+	{0x142, 0x142, derivedPVALID},
+	{0x144, 0x144, derivedPVALID},
+	{0x146, 0x146, derivedPVALID},
+	{0x148, 0x148, derivedPVALID},
+	{0x14B, 0x14B, derivedPVALID},
+	{0x14D, 0x14D, derivedPVALID},
+	{0x14F, 0x14F, derivedPVALID},
+	{0x151, 0x151, derivedPVALID},
+	{0x153, 0x153, derivedPVALID},
+	{0x155, 0x155, derivedPVALID},
+	{0x157, 0x157, derivedPVALID},
+	{0x159, 0x159, derivedPVALID},
+	{0x15B, 0x15B, derivedPVALID},
+	{0x15D, 0x15D, derivedPVALID},
+	{0x15F, 0x15F, derivedPVALID},
+	{0x161, 0x161, derivedPVALID},
+	{0x163, 0x163, derivedPVALID},
+	{0x165, 0x165, derivedPVALID},
+	{0x167, 0x167, derivedPVALID},
+	{0x169, 0x169, derivedPVALID},
+	{0x16B, 0x16B, derivedPVALID},
+	{0x16D, 0x16D, derivedPVALID},
+	{0x16F, 0x16F, derivedPVALID},
+	{0x171, 0x171, derivedPVALID},
+	{0x173, 0x173, derivedPVALID},
+	{0x175, 0x175, derivedPVALID},
+	{0x177, 0x177, derivedPVALID},
+	{0x17A, 0x17A, derivedPVALID},
+	{0x17C, 0x17C, derivedPVALID},
+	{0x17E, 0x17E, derivedPVALID},
+	{0x180, 0x180, derivedPVALID},
+	{0x183, 0x183, derivedPVALID},
+	{0x185, 0x185, derivedPVALID},
+	{0x188, 0x188, derivedPVALID},
+	{0x18C, 0x18D, derivedPVALID},
+	{0x192, 0x192, derivedPVALID},
+	{0x195, 0x195, derivedPVALID},
+	{0x199, 0x19B, derivedPVALID},
+	{0x19E, 0x19E, derivedPVALID},
+	{0x1A1, 0x1A1, derivedPVALID},
+	{0x1A3, 0x1A3, derivedPVALID},
+	{0x1A5, 0x1A5, derivedPVALID},
+	{0x1A8, 0x1A8, derivedPVALID},
+	{0x1AA, 0x1AB, derivedPVALID},
+	{0x1AD, 0x1AD, derivedPVALID},
+	{0x1B0, 0x1B0, derivedPVALID},
+	{0x1B4, 0x1B4, derivedPVALID},
+	{0x1B6, 0x1B6, derivedPVALID},
+	{0x1B9, 0x1BB, derivedPVALID},
+	{0x1BD, 0x1C3, derivedPVALID},
+	{0x1CE, 0x1CE, derivedPVALID},
+	{0x1D0, 0x1D0, derivedPVALID},
+	{0x1D2, 0x1D2, derivedPVALID},
+	{0x1D4, 0x1D4, derivedPVALID},
+	{0x1D6, 0x1D6, derivedPVALID},
+	{0x1D8, 0x1D8, derivedPVALID},
+	{0x1DA, 0x1DA, derivedPVALID},
+	{0x1DC, 0x1DD, derivedPVALID},
+	{0x1DF, 0x1DF, derivedPVALID},
+	{0x1E1, 0x1E1, derivedPVALID},
+	{0x1E3, 0x1E3, derivedPVALID},
+	{0x1E5, 0x1E5, derivedPVALID},
+	{0x1E7, 0x1E7, derivedPVALID},
+	{0x1E9, 0x1E9, derivedPVALID},
+	{0x1EB, 0x1EB, derivedPVALID},
+	{0x1ED, 0x1ED, derivedPVALID},
+	{0x1EF, 0x1F0, derivedPVALID},
+	{0x1F5, 0x1F5, derivedPVALID},
+	{0x1F9, 0x1F9, derivedPVALID},
+	{0x1FB, 0x1FB, derivedPVALID},
+	{0x1FD, 0x1FD, derivedPVALID},
+	{0x1FF, 0x1FF, derivedPVALID},
+	{0x201, 0x201, derivedPVALID},
+	{0x203, 0x203, derivedPVALID},
+	{0x205, 0x205, derivedPVALID},
+	{0x207, 0x207, derivedPVALID},
+	{0x209, 0x209, derivedPVALID},
+	{0x20B, 0x20B, derivedPVALID},
+	{0x20D, 0x20D, derivedPVALID},
+	{0x20F, 0x20F, derivedPVALID},
+	{0x211, 0x211, derivedPVALID},
+	{0x213, 0x213, derivedPVALID},
+	{0x215, 0x215, derivedPVALID},
+	{0x217, 0x217, derivedPVALID},
+	{0x219, 0x219, derivedPVALID},
+	{0x21B, 0x21B, derivedPVALID},
+	{0x21D, 0x21D, derivedPVALID},
+	{0x21F, 0x21F, derivedPVALID},
+	{0x221, 0x221, derivedPVALID},
+	{0x223, 0x223, derivedPVALID},
+	{0x225, 0x225, derivedPVALID},
+	{0x227, 0x227, derivedPVALID},
+	{0x229, 0x229, derivedPVALID},
+	{0x22B, 0x22B, derivedPVALID},
+	{0x22D, 0x22D, derivedPVALID},
+	{0x22F, 0x22F, derivedPVALID},
+	{0x231, 0x231, derivedPVALID},
+	{0x233, 0x239, derivedPVALID},
+	{0x23C, 0x23C, derivedPVALID},
+	{0x23F, 0x240, derivedPVALID},
+	{0x242, 0x242, derivedPVALID},
+	{0x247, 0x247, derivedPVALID},
+	{0x249, 0x249, derivedPVALID},
+	{0x24B, 0x24B, derivedPVALID},
+	{0x24D, 0x24D, derivedPVALID},
+	{0x24F, 0x2AF, derivedPVALID},
+	{0x2B9, 0x2C1, derivedPVALID},
+	{0x2C6, 0x2D1, derivedPVALID},
+	{0x2EC, 0x2EC, derivedPVALID},
+	{0x2EE, 0x2EE, derivedPVALID},
+	{0x300, 0x33F, derivedPVALID},
+	{0x342, 0x342, derivedPVALID},
+	{0x346, 0x34E, derivedPVALID},
+	{0x350, 0x36F, derivedPVALID},
+	{0x371, 0x371, derivedPVALID},
+	{0x373, 0x373, derivedPVALID},
+	{0x375, 0x375, derivedCONTEXTO},
+	{0x377, 0x377, derivedPVALID},
+	{0x37B, 0x37D, derivedPVALID},
+	{0x390, 0x390, derivedPVALID},
+	{0x3AC, 0x3CE, derivedPVALID},
+	{0x3D7, 0x3D7, derivedPVALID},
+	{0x3D9, 0x3D9, derivedPVALID},
+	{0x3DB, 0x3DB, derivedPVALID},
+	{0x3DD, 0x3DD, derivedPVALID},
+	{0x3DF, 0x3DF, derivedPVALID},
+	{0x3E1, 0x3E1, derivedPVALID},
+	{0x3E3, 0x3E3, derivedPVALID},
+	{0x3E5, 0x3E5, derivedPVALID},
+	{0x3E7, 0x3E7, derivedPVALID},
+	{0x3E9, 0x3E9, derivedPVALID},
+	{0x3EB, 0x3EB, derivedPVALID},
+	{0x3ED, 0x3ED, derivedPVALID},
+	{0x3EF, 0x3EF, derivedPVALID},
+	{0x3F3, 0x3F3, derivedPVALID},
+	{0x3F8, 0x3F8, derivedPVALID},
+	{0x3FB, 0x3FC, derivedPVALID},
+	{0x430, 0x45F, derivedPVALID},
+	{0x461, 0x461, derivedPVALID},
+	{0x463, 0x463, derivedPVALID},
+	{0x465, 0x465, derivedPVALID},
+	{0x467, 0x467, derivedPVALID},
+	{0x469, 0x469, derivedPVALID},
+	{0x46B, 0x46B, derivedPVALID},
+	{0x46D, 0x46D, derivedPVALID},
+	{0x46F, 0x46F, derivedPVALID},
+	{0x471, 0x471, derivedPVALID},
+	{0x473, 0x473, derivedPVALID},
+	{0x475, 0x475, derivedPVALID},
+	{0x477, 0x477, derivedPVALID},
+	{0x479, 0x479, derivedPVALID},
+	{0x47B, 0x47B, derivedPVALID},
+	{0x47D, 0x47D, derivedPVALID},
+	{0x47F, 0x47F, derivedPVALID},
+	{0x481, 0x481, derivedPVALID},
+	{0x483, 0x487, derivedPVALID},
+	{0x48B, 0x48B, derivedPVALID},
+	{0x48D, 0x48D, derivedPVALID},
+	{0x48F, 0x48F, derivedPVALID},
+	{0x491, 0x491, derivedPVALID},
+	{0x493, 0x493, derivedPVALID},
+	{0x495, 0x495, derivedPVALID},
+	{0x497, 0x497, derivedPVALID},
+	{0x499, 0x499, derivedPVALID},
+	{0x49B, 0x49B, derivedPVALID},
+	{0x49D, 0x49D, derivedPVALID},
+	{0x49F, 0x49F, derivedPVALID},
+	{0x4A1, 0x4A1, derivedPVALID},
+	{0x4A3, 0x4A3, derivedPVALID},
+	{0x4A5, 0x4A5, derivedPVALID},
+	{0x4A7, 0x4A7, derivedPVALID},
+	{0x4A9, 0x4A9, derivedPVALID},
+	{0x4AB, 0x4AB, derivedPVALID},
+	{0x4AD, 0x4AD, derivedPVALID},
+	{0x4AF, 0x4AF, derivedPVALID},
+	{0x4B1, 0x4B1, derivedPVALID},
+	{0x4B3, 0x4B3, derivedPVALID},
+	{0x4B5, 0x4B5, derivedPVALID},
+	{0x4B7, 0x4B7, derivedPVALID},
+	{0x4B9, 0x4B9, derivedPVALID},
+	{0x4BB, 0x4BB, derivedPVALID},
+	{0x4BD, 0x4BD, derivedPVALID},
+	{0x4BF, 0x4BF, derivedPVALID},
+	{0x4C2, 0x4C2, derivedPVALID},
+	{0x4C4, 0x4C4, derivedPVALID},
+	{0x4C6, 0x4C6, derivedPVALID},
+	{0x4C8, 0x4C8, derivedPVALID},
+	{0x4CA, 0x4CA, derivedPVALID},
+	{0x4CC, 0x4CC, derivedPVALID},
+	{0x4CE, 0x4CF, derivedPVALID},
+	{0x4D1, 0x4D1, derivedPVALID},
+	{0x4D3, 0x4D3, derivedPVALID},
+	{0x4D5, 0x4D5, derivedPVALID},
+	{0x4D7, 0x4D7, derivedPVALID},
+	{0x4D9, 0x4D9, derivedPVALID},
+	{0x4DB, 0x4DB, derivedPVALID},
+	{0x4DD, 0x4DD, derivedPVALID},
+	{0x4DF, 0x4DF, derivedPVALID},
+	{0x4E1, 0x4E1, derivedPVALID},
+	{0x4E3, 0x4E3, derivedPVALID},
+	{0x4E5, 0x4E5, derivedPVALID},
+	{0x4E7, 0x4E7, derivedPVALID},
+	{0x4E9, 0x4E9, derivedPVALID},
+	{0x4EB, 0x4EB, derivedPVALID},
+	{0x4ED, 0x4ED, derivedPVALID},
+	{0x4EF, 0x4EF, derivedPVALID},
+	{0x4F1, 0x4F1, derivedPVALID},
+	{0x4F3, 0x4F3, derivedPVALID},
+	{0x4F5, 0x4F5, derivedPVALID},
+	{0x4F7, 0x4F7, derivedPVALID},
+	{0x4F9, 0x4F9, derivedPVALID},
+	{0x4FB, 0x4FB, derivedPVALID},
+	{0x4FD, 0x4FD, derivedPVALID},
+	{0x4FF, 0x4FF, derivedPVALID},
+	{0x501, 0x501, derivedPVALID},
+	{0x503, 0x503, derivedPVALID},
+	{0x505, 0x505, derivedPVALID},
+	{0x507, 0x507, derivedPVALID},
+	{0x509, 0x509, derivedPVALID},
+	{0x50B, 0x50B, derivedPVALID},
+	{0x50D, 0x50D, derivedPVALID},
+	{0x50F, 0x50F, derivedPVALID},
+	{0x511, 0x511, derivedPVALID},
+	{0x513, 0x513, derivedPVALID},
+	{0x515, 0x515, derivedPVALID},
+	{0x517, 0x517, derivedPVALID},
+	{0x519, 0x519, derivedPVALID},
+	{0x51B, 0x51B, derivedPVALID},
+	{0x51D, 0x51D, derivedPVALID},
+	{0x51F, 0x51F, derivedPVALID},
+	{0x521, 0x521, derivedPVALID},
+	{0x523, 0x523, derivedPVALID},
+	{0x525, 0x525, derivedPVALID},
+	{0x527, 0x527, derivedPVALID},
+	{0x529, 0x529, derivedPVALID},
+	{0x52B, 0x52B, derivedPVALID},
+	{0x52D, 0x52D, derivedPVALID},
+	{0x52F, 0x52F, derivedPVALID},
+	{0x559, 0x559, derivedPVALID},
+	{0x560, 0x586, derivedPVALID},
+	{0x588, 0x588, derivedPVALID},
+	{0x591, 0x5BD, derivedPVALID},
+	{0x5BF, 0x5BF, derivedPVALID},
+	{0x5C1, 0x5C2, derivedPVALID},
+	{0x5C4, 0x5C5, derivedPVALID},
+	{0x5C7, 0x5C7, derivedPVALID},
+	{0x5D0, 0x5EA, derivedPVALID},
+	{0x5EF, 0x5F2, derivedPVALID},
+	{0x5F3, 0x5F4, derivedCONTEXTO},
+	{0x610, 0x61A, derivedPVALID},
+	{0x620, 0x63F, derivedPVALID},
+	{0x641, 0x65F, derivedPVALID},
+	{0x660, 0x669, derivedCONTEXTO},
+	{0x66E, 0x674, derivedPVALID},
+	{0x679, 0x6D3, derivedPVALID},
+	{0x6D5, 0x6DC, derivedPVALID},
+	{0x6DF, 0x6E8, derivedPVALID},
+	{0x6EA, 0x6EF, derivedPVALID},
+	{0x6F0, 0x6F9, derivedCONTEXTO},
+	{0x6FA, 0x6FF, derivedPVALID},
+	{0x710, 0x74A, derivedPVALID},
+	{0x74D, 0x7B1, derivedPVALID},
+	{0x7C0, 0x7F5, derivedPVALID},
+	{0x7FD, 0x7FD, derivedPVALID},
+	{0x800, 0x82D, derivedPVALID},
+	{0x840, 0x85B, derivedPVALID},
+	{0x860, 0x86A, derivedPVALID},
+	{0x870, 0x887, derivedPVALID},
+	{0x889, 0x88E, derivedPVALID},
+	{0x898, 0x8E1, derivedPVALID},
+	{0x8E3, 0x957, derivedPVALID},
+	{0x960, 0x963, derivedPVALID},
+	{0x966, 0x96F, derivedPVALID},
+	{0x971, 0x983, derivedPVALID},
+	{0x985, 0x98C, derivedPVALID},
+	{0x98F, 0x990, derivedPVALID},
+	{0x993, 0x9A8, derivedPVALID},
+	{0x9AA, 0x9B0, derivedPVALID},
+	{0x9B2, 0x9B2, derivedPVALID},
+	{0x9B6, 0x9B9, derivedPVALID},
+	{0x9BC, 0x9C4, derivedPVALID},
+	{0x9C7, 0x9C8, derivedPVALID},
+	{0x9CB, 0x9CE, derivedPVALID},
+	{0x9D7, 0x9D7, derivedPVALID},
+	{0x9E0, 0x9E3, derivedPVALID},
+	{0x9E6, 0x9F1, derivedPVALID},
+	{0x9FC, 0x9FC, derivedPVALID},
+	{0x9FE, 0x9FE, derivedPVALID},
+	{0xA01, 0xA03, derivedPVALID},
+	{0xA05, 0xA0A, derivedPVALID},
+	{0xA0F, 0xA10, derivedPVALID},
+	{0xA13, 0xA28, derivedPVALID},
+	{0xA2A, 0xA30, derivedPVALID},
+	{0xA32, 0xA32, derivedPVALID},
+	{0xA35, 0xA35, derivedPVALID},
+	{0xA38, 0xA39, derivedPVALID},
+	{0xA3C, 0xA3C, derivedPVALID},
+	{0xA3E, 0xA42, derivedPVALID},
+	{0xA47, 0xA48, derivedPVALID},
+	{0xA4B, 0xA4D, derivedPVALID},
+	{0xA51, 0xA51, derivedPVALID},
+	{0xA5C, 0xA5C, derivedPVALID},
+	{0xA66, 0xA75, derivedPVALID},
+	{0xA81, 0xA83, derivedPVALID},
+	{0xA85, 0xA8D, derivedPVALID},
+	{0xA8F, 0xA91, derivedPVALID},
+	{0xA93, 0xAA8, derivedPVALID},
+	{0xAAA, 0xAB0, derivedPVALID},
+	{0xAB2, 0xAB3, derivedPVALID},
+	{0xAB5, 0xAB9, derivedPVALID},
+	{0xABC, 0xAC5, derivedPVALID},
+	{0xAC7, 0xAC9, derivedPVALID},
+	{0xACB, 0xACD, derivedPVALID},
+	{0xAD0, 0xAD0, derivedPVALID},
+	{0xAE0, 0xAE3, derivedPVALID},
+	{0xAE6, 0xAEF, derivedPVALID},
+	{0xAF9, 0xAFF, derivedPVALID},
+	{0xB01, 0xB03, derivedPVALID},
+	{0xB05, 0xB0C, derivedPVALID},
+	{0xB0F, 0xB10, derivedPVALID},
+	{0xB13, 0xB28, derivedPVALID},
+	{0xB2A, 0xB30, derivedPVALID},
+	{0xB32, 0xB33, derivedPVALID},
+	{0xB35, 0xB39, derivedPVALID},
+	{0xB3C, 0xB44, derivedPVALID},
+	{0xB47, 0xB48, derivedPVALID},
+	{0xB4B, 0xB4D, derivedPVALID},
+	{0xB55, 0xB57, derivedPVALID},
+	{0xB5F, 0xB63, derivedPVALID},
+	{0xB66, 0xB6F, derivedPVALID},
+	{0xB71, 0xB71, derivedPVALID},
+	{0xB82, 0xB83, derivedPVALID},
+	{0xB85, 0xB8A, derivedPVALID},
+	{0xB8E, 0xB90, derivedPVALID},
+	{0xB92, 0xB95, derivedPVALID},
+	{0xB99, 0xB9A, derivedPVALID},
+	{0xB9C, 0xB9C, derivedPVALID},
+	{0xB9E, 0xB9F, derivedPVALID},
+	{0xBA3, 0xBA4, derivedPVALID},
+	{0xBA8, 0xBAA, derivedPVALID},
+	{0xBAE, 0xBB9, derivedPVALID},
+	{0xBBE, 0xBC2, derivedPVALID},
+	{0xBC6, 0xBC8, derivedPVALID},
+	{0xBCA, 0xBCD, derivedPVALID},
+	{0xBD0, 0xBD0, derivedPVALID},
+	{0xBD7, 0xBD7, derivedPVALID},
+	{0xBE6, 0xBEF, derivedPVALID},
+	{0xC00, 0xC0C, derivedPVALID},
+	{0xC0E, 0xC10, derivedPVALID},
+	{0xC12, 0xC28, derivedPVALID},
+	{0xC2A, 0xC39, derivedPVALID},
+	{0xC3C, 0xC44, derivedPVALID},
+	{0xC46, 0xC48, derivedPVALID},
+	{0xC4A, 0xC4D, derivedPVALID},
+	{0xC55, 0xC56, derivedPVALID},
+	{0xC58, 0xC5A, derivedPVALID},
+	{0xC5D, 0xC5D, derivedPVALID},
+	{0xC60, 0xC63, derivedPVALID},
+	{0xC66, 0xC6F, derivedPVALID},
+	{0xC80, 0xC83, derivedPVALID},
+	{0xC85, 0xC8C, derivedPVALID},
+	{0xC8E, 0xC90, derivedPVALID},
+	{0xC92, 0xCA8, derivedPVALID},
+	{0xCAA, 0xCB3, derivedPVALID},
+	{0xCB5, 0xCB9, derivedPVALID},
+	{0xCBC, 0xCC4, derivedPVALID},
+	{0xCC6, 0xCC8, derivedPVALID},
+	{0xCCA, 0xCCD, derivedPVALID},
+	{0xCD5, 0xCD6, derivedPVALID},
+	{0xCDD, 0xCDE, derivedPVALID},
+	{0xCE0, 0xCE3, derivedPVALID},
+	{0xCE6, 0xCEF, derivedPVALID},
+	{0xCF1, 0xCF3, derivedPVALID},
+	{0xD00, 0xD0C, derivedPVALID},
+	{0xD0E, 0xD10, derivedPVALID},
+	{0xD12, 0xD44, derivedPVALID},
+	{0xD46, 0xD48, derivedPVALID},
+	{0xD4A, 0xD4E, derivedPVALID},
+	{0xD54, 0xD57, derivedPVALID},
+	{0xD5F, 0xD63, derivedPVALID},
+	{0xD66, 0xD6F, derivedPVALID},
+	{0xD7A, 0xD7F, derivedPVALID},
+	{0xD81, 0xD83, derivedPVALID},
+	{0xD85, 0xD96, derivedPVALID},
+	{0xD9A, 0xDB1, derivedPVALID},
+	{0xDB3, 0xDBB, derivedPVALID},
+	{0xDBD, 0xDBD, derivedPVALID},
+	{0xDC0, 0xDC6, derivedPVALID},
+	{0xDCA, 0xDCA, derivedPVALID},
+	{0xDCF, 0xDD4, derivedPVALID},
+	{0xDD6, 0xDD6, derivedPVALID},
+	{0xDD8, 0xDDF, derivedPVALID},
+	{0xDE6, 0xDEF, derivedPVALID},
+	{0xDF2, 0xDF3, derivedPVALID},
+	{0xE01, 0xE32, derivedPVALID},
+	{0xE34, 0xE3A, derivedPVALID},
+	{0xE40, 0xE4E, derivedPVALID},
+	{0xE50, 0xE59, derivedPVALID},
+	{0xE81, 0xE82, derivedPVALID},
+	{0xE84, 0xE84, derivedPVALID},
+	{0xE86, 0xE8A, derivedPVALID},
+	{0xE8C, 0xEA3, derivedPVALID},
+	{0xEA5, 0xEA5, derivedPVALID},
+	{0xEA7, 0xEB2, derivedPVALID},
+	{0xEB4, 0xEBD, derivedPVALID},
+	{0xEC0, 0xEC4, derivedPVALID},
+	{0xEC6, 0xEC6, derivedPVALID},
+	{0xEC8, 0xECE, derivedPVALID},
+	{0xED0, 0xED9, derivedPVALID},
+	{0xEDE, 0xEDF, derivedPVALID},
+	{0xF00, 0xF00, derivedPVALID},
+	{0xF0B, 0xF0B, derivedPVALID},
+	{0xF18, 0xF19, derivedPVALID},
+	{0xF20, 0xF29, derivedPVALID},
+	{0xF35, 0xF35, derivedPVALID},
+	{0xF37, 0xF37, derivedPVALID},
+	{0xF39, 0xF39, derivedPVALID},
+	{0xF3E, 0xF42, derivedPVALID},
+	{0xF44, 0xF47, derivedPVALID},
+	{0xF49, 0xF4C, derivedPVALID},
+	{0xF4E, 0xF51, derivedPVALID},
+	{0xF53, 0xF56, derivedPVALID},
+	{0xF58, 0xF5B, derivedPVALID},
+	{0xF5D, 0xF68, derivedPVALID},
+	{0xF6A, 0xF6C, derivedPVALID},
+	{0xF71, 0xF72, derivedPVALID},
+	{0xF74, 0xF74, derivedPVALID},
+	{0xF7A, 0xF80, derivedPVALID},
+	{0xF82, 0xF84, derivedPVALID},
+	{0xF86, 0xF92, derivedPVALID},
+	{0xF94, 0xF97, derivedPVALID},
+	{0xF99, 0xF9C, derivedPVALID},
+	{0xF9E, 0xFA1, derivedPVALID},
+	{0xFA3, 0xFA6, derivedPVALID},
+	{0xFA8, 0xFAB, derivedPVALID},
+	{0xFAD, 0xFB8, derivedPVALID},
+	{0xFBA, 0xFBC, derivedPVALID},
+	{0xFC6, 0xFC6, derivedPVALID},
+	{0x1000, 0x1049, derivedPVALID},
+	{0x1050, 0x109D, derivedPVALID},
+	{0x10D0, 0x10FA, derivedPVALID},
+	{0x10FD, 0x10FF, derivedPVALID},
+	{0x1200, 0x1248, derivedPVALID},
+	{0x124A, 0x124D, derivedPVALID},
+	{0x1250, 0x1256, derivedPVALID},
+	{0x1258, 0x1258, derivedPVALID},
+	{0x125A, 0x125D, derivedPVALID},
+	{0x1260, 0x1288, derivedPVALID},
+	{0x128A, 0x128D, derivedPVALID},
+	{0x1290, 0x12B0, derivedPVALID},
+	{0x12B2, 0x12B5, derivedPVALID},
+	{0x12B8, 0x12BE, derivedPVALID},
+	{0x12C0, 0x12C0, derivedPVALID},
+	{0x12C2, 0x12C5, derivedPVALID},
+	{0x12C8, 0x12D6, derivedPVALID},
+	{0x12D8, 0x1310, derivedPVALID},
+	{0x1312, 0x1315, derivedPVALID},
+	{0x1318, 0x135A, derivedPVALID},
+	{0x135D, 0x135F, derivedPVALID},
+	{0x1380, 0x138F, derivedPVALID},
+	{0x13A0, 0x13F5, derivedPVALID},
+	{0x1401, 0x166C, derivedPVALID},
+	{0x166F, 0x167F, derivedPVALID},
+	{0x1681, 0x169A, derivedPVALID},
+	{0x16A0, 0x16EA, derivedPVALID},
+	{0x16F1, 0x16F8, derivedPVALID},
+	{0x1700, 0x1715, derivedPVALID},
+	{0x171F, 0x1734, derivedPVALID},
+	{0x1740, 0x1753, derivedPVALID},
+	{0x1760, 0x176C, derivedPVALID},
+	{0x176E, 0x1770, derivedPVALID},
+	{0x1772, 0x1773, derivedPVALID},
+	{0x1780, 0x17B3, derivedPVALID},
+	{0x17B6, 0x17D3, derivedPVALID},
+	{0x17D7, 0x17D7, derivedPVALID},
+	{0x17DC, 0x17DD, derivedPVALID},
+	{0x17E0, 0x17E9, derivedPVALID},
+	{0x1810, 0x1819, derivedPVALID},
+	{0x1820, 0x1878, derivedPVALID},
+	{0x1880, 0x18AA, derivedPVALID},
+	{0x18B0, 0x18F5, derivedPVALID},
+	{0x1900, 0x191E, derivedPVALID},
+	{0x1920, 0x192B, derivedPVALID},
+	{0x1930, 0x193B, derivedPVALID},
+	{0x1946, 0x196D, derivedPVALID},
+	{0x1970, 0x1974, derivedPVALID},
+	{0x1980, 0x19AB, derivedPVALID},
+	{0x19B0, 0x19C9, derivedPVALID},
+	{0x19D0, 0x19D9, derivedPVALID},
+	{0x1A00, 0x1A1B, derivedPVALID},
+	{0x1A20, 0x1A5E, derivedPVALID},
+	{0x1A60, 0x1A7C, derivedPVALID},
+	{0x1A7F, 0x1A89, derivedPVALID},
+	{0x1A90, 0x1A99, derivedPVALID},
+	{0x1AA7, 0x1AA7, derivedPVALID},
+	{0x1AB0, 0x1ABD, derivedPVALID},
+	{0x1ABF, 0x1ACE, derivedPVALID},
+	{0x1B00, 0x1B4C, derivedPVALID},
+	{0x1B50, 0x1B59, derivedPVALID},
+	{0x1B6B, 0x1B73, derivedPVALID},
+	{0x1B80, 0x1BF3, derivedPVALID},
+	{0x1C00, 0x1C37, derivedPVALID},
+	{0x1C40, 0x1C49, derivedPVALID},
+	{0x1C4D, 0x1C7D, derivedPVALID},
+	{0x1CD0, 0x1CD2, derivedPVALID},
+	{0x1CD4, 0x1CFA, derivedPVALID},
+	{0x1D00, 0x1D2B, derivedPVALID},
+	{0x1D2F, 0x1D2F, derivedPVALID},
+	{0x1D3B, 0x1D3B, derivedPVALID},
+	{0x1D4E, 0x1D4E, derivedPVALID},
+	{0x1D6B, 0x1D77, derivedPVALID},
+	{0x1D79, 0x1D9A, derivedPVALID},
+	{0x1DC0, 0x1DFF, derivedPVALID},
+	{0x1E01, 0x1E01, derivedPVALID},
+	{0x1E03, 0x1E03, derivedPVALID},
+	{0x1E05, 0x1E05, derivedPVALID},
+	{0x1E07, 0x1E07, derivedPVALID},
+	{0x1E09, 0x1E09, derivedPVALID},
+	{0x1E0B, 0x1E0B, derivedPVALID},
+	{0x1E0D, 0x1E0D, derivedPVALID},
+	{0x1E0F, 0x1E0F, derivedPVALID},
+	{0x1E11, 0x1E11, derivedPVALID},
+	{0x1E13, 0x1E13, derivedPVALID},
+	{0x1E15, 0x1E15, derivedPVALID},
+	{0x1E17, 0x1E17, derivedPVALID},
+	{0x1E19, 0x1E19, derivedPVALID},
+	{0x1E1B, 0x1E1B, derivedPVALID},
+	{0x1E1D, 0x1E1D, derivedPVALID},
+	{0x1E1F, 0x1E1F, derivedPVALID},
+	{0x1E21, 0x1E21, derivedPVALID},
+	{0x1E23, 0x1E23, derivedPVALID},
+	{0x1E25, 0x1E25, derivedPVALID},
+	{0x1E27, 0x1E27, derivedPVALID},
+	{0x1E29, 0x1E29, derivedPVALID},
+	{0x1E2B, 0x1E2B, derivedPVALID},
+	{0x1E2D, 0x1E2D, derivedPVALID},
+	{0x1E2F, 0x1E2F, derivedPVALID},
+	{0x1E31, 0x1E31, derivedPVALID},
+	{0x1E33, 0x1E33, derivedPVALID},
+	{0x1E35, 0x1E35, derivedPVALID},
+	{0x1E37, 0x1E37, derivedPVALID},
+	{0x1E39, 0x1E39, derivedPVALID},
+	{0x1E3B, 0x1E3B, derivedPVALID},
+	{0x1E3D, 0x1E3D, derivedPVALID},
+	{0x1E3F, 0x1E3F, derivedPVALID},
+	{0x1E41, 0x1E41, derivedPVALID},
+	{0x1E43, 0x1E43, derivedPVALID},
+	{0x1E45, 0x1E45, derivedPVALID},
+	{0x1E47, 0x1E47, derivedPVALID},
+	{0x1E49, 0x1E49, derivedPVALID},
+	{0x1E4B, 0x1E4B, derivedPVALID},
+	{0x1E4D, 0x1E4D, derivedPVALID},
+	{0x1E4F, 0x1E4F, derivedPVALID},
+	{0x1E51, 0x1E51, derivedPVALID},
+	{0x1E53, 0x1E53, derivedPVALID},
+	{0x1E55, 0x1E55, derivedPVALID},
+	{0x1E57, 0x1E57, derivedPVALID},
+	{0x1E59, 0x1E59, derivedPVALID},
+	{0x1E5B, 0x1E5B, derivedPVALID},
+	{0x1E5D, 0x1E5D, derivedPVALID},
+	{0x1E5F, 0x1E5F, derivedPVALID},
+	{0x1E61, 0x1E61, derivedPVALID},
+	{0x1E63, 0x1E63, derivedPVALID},
+	{0x1E65, 0x1E65, derivedPVALID},
+	{0x1E67, 0x1E67, derivedPVALID},
+	{0x1E69, 0x1E69, derivedPVALID},
+	{0x1E6B, 0x1E6B, derivedPVALID},
+	{0x1E6D, 0x1E6D, derivedPVALID},
+	{0x1E6F, 0x1E6F, derivedPVALID},
+	{0x1E71, 0x1E71, derivedPVALID},
+	{0x1E73, 0x1E73, derivedPVALID},
+	{0x1E75, 0x1E75, derivedPVALID},
+	{0x1E77, 0x1E77, derivedPVALID},
+	{0x1E79, 0x1E79, derivedPVALID},
+	{0x1E7B, 0x1E7B, derivedPVALID},
+	{0x1E7D, 0x1E7D, derivedPVALID},
+	{0x1E7F, 0x1E7F, derivedPVALID},
+	{0x1E81, 0x1E81, derivedPVALID},
+	{0x1E83, 0x1E83, derivedPVALID},
+	{0x1E85, 0x1E85, derivedPVALID},
+	{0x1E87, 0x1E87, derivedPVALID},
+	{0x1E89, 0x1E89, derivedPVALID},
+	{0x1E8B, 0x1E8B, derivedPVALID},
+	{0x1E8D, 0x1E8D, derivedPVALID},
+	{0x1E8F, 0x1E8F, derivedPVALID},
+	{0x1E91, 0x1E91, derivedPVALID},
+	{0x1E93, 0x1E93, derivedPVALID},
+	{0x1E95, 0x1E99, derivedPVALID},
+	{0x1E9C, 0x1E9D, derivedPVALID},
+	{0x1E9F, 0x1E9F, derivedPVALID},
+	{0x1EA1, 0x1EA1, derivedPVALID},
+	{0x1EA3, 0x1EA3, derivedPVALID},
+	{0x1EA5, 0x1EA5, derivedPVALID},
+	{0x1EA7, 0x1EA7, derivedPVALID},
+	{0x1EA9, 0x1EA9, derivedPVALID},
+	{0x1EAB, 0x1EAB, derivedPVALID},
+	{0x1EAD, 0x1EAD, derivedPVALID},
+	{0x1EAF, 0x1EAF, derivedPVALID},
+	{0x1EB1, 0x1EB1, derivedPVALID},
+	{0x1EB3, 0x1EB3, derivedPVALID},
+	{0x1EB5, 0x1EB5, derivedPVALID},
+	{0x1EB7, 0x1EB7, derivedPVALID},
+	{0x1EB9, 0x1EB9, derivedPVALID},
+	{0x1EBB, 0x1EBB, derivedPVALID},
+	{0x1EBD, 0x1EBD, derivedPVALID},
+	{0x1EBF, 0x1EBF, derivedPVALID},
+	{0x1EC1, 0x1EC1, derivedPVALID},
+	{0x1EC3, 0x1EC3, derivedPVALID},
+	{0x1EC5, 0x1EC5, derivedPVALID},
+	{0x1EC7, 0x1EC7, derivedPVALID},
+	{0x1EC9, 0x1EC9, derivedPVALID},
+	{0x1ECB, 0x1ECB, derivedPVALID},
+	{0x1ECD, 0x1ECD, derivedPVALID},
+	{0x1ECF, 0x1ECF, derivedPVALID},
+	{0x1ED1, 0x1ED1, derivedPVALID},
+	{0x1ED3, 0x1ED3, derivedPVALID},
+	{0x1ED5, 0x1ED5, derivedPVALID},
+	{0x1ED7, 0x1ED7, derivedPVALID},
+	{0x1ED9, 0x1ED9, derivedPVALID},
+	{0x1EDB, 0x1EDB, derivedPVALID},
+	{0x1EDD, 0x1EDD, derivedPVALID},
+	{0x1EDF, 0x1EDF, derivedPVALID},
+	{0x1EE1, 0x1EE1, derivedPVALID},
+	{0x1EE3, 0x1EE3, derivedPVALID},
+	{0x1EE5, 0x1EE5, derivedPVALID},
+	{0x1EE7, 0x1EE7, derivedPVALID},
+	{0x1EE9, 0x1EE9, derivedPVALID},
+	{0x1EEB, 0x1EEB, derivedPVALID},
+	{0x1EED, 0x1EED, derivedPVALID},
+	{0x1EEF, 0x1EEF, derivedPVALID},
+	{0x1EF1, 0x1EF1, derivedPVALID},
+	{0x1EF3, 0x1EF3, derivedPVALID},
+	{0x1EF5, 0x1EF5, derivedPVALID},
+	{0x1EF7, 0x1EF7, derivedPVALID},
+	{0x1EF9, 0x1EF9, derivedPVALID},
+	{0x1EFB, 0x1EFB, derivedPVALID},
+	{0x1EFD, 0x1EFD, derivedPVALID},
+	{0x1EFF, 0x1F07, derivedPVALID},
+	{0x1F10, 0x1F15, derivedPVALID},
+	{0x1F20, 0x1F27, derivedPVALID},
+	{0x1F30, 0x1F37, derivedPVALID},
+	{0x1F40, 0x1F45, derivedPVALID},
+	{0x1F50, 0x1F57, derivedPVALID},
+	{0x1F60, 0x1F67, derivedPVALID},
+	{0x1F70, 0x1F70, derivedPVALID},
+	{0x1F72, 0x1F72, derivedPVALID},
+	{0x1F74, 0x1F74, derivedPVALID},
+	{0x1F76, 0x1F76, derivedPVALID},
+	{0x1F78, 0x1F78, derivedPVALID},
+	{0x1F7A, 0x1F7A, derivedPVALID},
+	{0x1F7C, 0x1F7C, derivedPVALID},
+	{0x1FB0, 0x1FB1, derivedPVALID},
+	{0x1FB6, 0x1FB6, derivedPVALID},
+	{0x1FC6, 0x1FC6, derivedPVALID},
+	{0x1FD0, 0x1FD2, derivedPVALID},
+	{0x1FD6, 0x1FD7, derivedPVALID},
+	{0x1FE0, 0x1FE2, derivedPVALID},
+	{0x1FE4, 0x1FE7, derivedPVALID},
+	{0x1FF6, 0x1FF6, derivedPVALID},
+	{0x200C, 0x200D, derivedCONTEXTJ},
+	{0x214E, 0x214E, derivedPVALID},
+	{0x2184, 0x2184, derivedPVALID},
+	{0x2C30, 0x2C5F, derivedPVALID},
+	{0x2C61, 0x2C61, derivedPVALID},
+	{0x2C65, 0x2C66, derivedPVALID},
+	{0x2C68, 0x2C68, derivedPVALID},
+	{0x2C6A, 0x2C6A, derivedPVALID},
+	{0x2C6C, 0x2C6C, derivedPVALID},
+	{0x2C71, 0x2C71, derivedPVALID},
+	{0x2C73, 0x2C74, derivedPVALID},
+	{0x2C76, 0x2C7B, derivedPVALID},
+	{0x2C81, 0x2C81, derivedPVALID},
+	{0x2C83, 0x2C83, derivedPVALID},
+	{0x2C85, 0x2C85, derivedPVALID},
+	{0x2C87, 0x2C87, derivedPVALID},
+	{0x2C89, 0x2C89, derivedPVALID},
+	{0x2C8B, 0x2C8B, derivedPVALID},
+	{0x2C8D, 0x2C8D, derivedPVALID},
+	{0x2C8F, 0x2C8F, derivedPVALID},
+	{0x2C91, 0x2C91, derivedPVALID},
+	{0x2C93, 0x2C93, derivedPVALID},
+	{0x2C95, 0x2C95, derivedPVALID},
+	{0x2C97, 0x2C97, derivedPVALID},
+	{0x2C99, 0x2C99, derivedPVALID},
+	{0x2C9B, 0x2C9B, derivedPVALID},
+	{0x2C9D, 0x2C9D, derivedPVALID},
+	{0x2C9F, 0x2C9F, derivedPVALID},
+	{0x2CA1, 0x2CA1, derivedPVALID},
+	{0x2CA3, 0x2CA3, derivedPVALID},
+	{0x2CA5, 0x2CA5, derivedPVALID},
+	{0x2CA7, 0x2CA7, derivedPVALID},
+	{0x2CA9, 0x2CA9, derivedPVALID},
+	{0x2CAB, 0x2CAB, derivedPVALID},
+	{0x2CAD, 0x2CAD, derivedPVALID},
+	{0x2CAF, 0x2CAF, derivedPVALID},
+	{0x2CB1, 0x2CB1, derivedPVALID},
+	{0x2CB3, 0x2CB3, derivedPVALID},
+	{0x2CB5, 0x2CB5, derivedPVALID},
+	{0x2CB7, 0x2CB7, derivedPVALID},
+	{0x2CB9, 0x2CB9, derivedPVALID},
+	{0x2CBB, 0x2CBB, derivedPVALID},
+	{0x2CBD, 0x2CBD, derivedPVALID},
+	{0x2CBF, 0x2CBF, derivedPVALID},
+	{0x2CC1, 0x2CC1, derivedPVALID},
+	{0x2CC3, 0x2CC3, derivedPVALID},
+	{0x2CC5, 0x2CC5, derivedPVALID},
+	{0x2CC7, 0x2CC7, derivedPVALID},
+	{0x2CC9, 0x2CC9, derivedPVALID},
+	{0x2CCB, 0x2CCB, derivedPVALID},
+	{0x2CCD, 0x2CCD, derivedPVALID},
+	{0x2CCF, 0x2CCF, derivedPVALID},
+	{0x2CD1, 0x2CD1, derivedPVALID},
+	{0x2CD3, 0x2CD3, derivedPVALID},
+	{0x2CD5, 0x2CD5, derivedPVALID},
+	{0x2CD7, 0x2CD7, derivedPVALID},
+	{0x2CD9, 0x2CD9, derivedPVALID},
+	{0x2CDB, 0x2CDB, derivedPVALID},
+	{0x2CDD, 0x2CDD, derivedPVALID},
+	{0x2CDF, 0x2CDF, derivedPVALID},
+	{0x2CE1, 0x2CE1, derivedPVALID},
+	{0x2CE3, 0x2CE4, derivedPVALID},
+	{0x2CEC, 0x2CEC, derivedPVALID},
+	{0x2CEE, 0x2CF1, derivedPVALID},
+	{0x2CF3, 0x2CF3, derivedPVALID},
+	{0x2D00, 0x2D25, derivedPVALID},
+	{0x2D27, 0x2D27, derivedPVALID},
+	{0x2D2D, 0x2D2D, derivedPVALID},
+	{0x2D30, 0x2D67, derivedPVALID},
+	{0x2D7F, 0x2D96, derivedPVALID},
+	{0x2DA0, 0x2DA6, derivedPVALID},
+	{0x2DA8, 0x2DAE, derivedPVALID},
+	{0x2DB0, 0x2DB6, derivedPVALID},
+	{0x2DB8, 0x2DBE, derivedPVALID},
+	{0x2DC0, 0x2DC6, derivedPVALID},
+	{0x2DC8, 0x2DCE, derivedPVALID},
+	{0x2DD0, 0x2DD6, derivedPVALID},
+	{0x2DD8, 0x2DDE, derivedPVALID},
+	{0x2DE0, 0x2DFF, derivedPVALID},
+	{0x2E2F, 0x2E2F, derivedPVALID},
+	{0x3005, 0x3007, derivedPVALID},
+	{0x302A, 0x302D, derivedPVALID},
+	{0x303C, 0x303C, derivedPVALID},
+	{0x3041, 0x3096, derivedPVALID},
+	{0x3099, 0x309A, derivedPVALID},
+	{0x309D, 0x309E, derivedPVALID},
+	{0x30A1, 0x30FA, derivedPVALID},
+	{0x30FB, 0x30FB, derivedCONTEXTO},
+	{0x30FC, 0x30FE, derivedPVALID},
+	{0x3105, 0x312F, derivedPVALID},
+	{0x31A0, 0x31BF, derivedPVALID},
+	{0x31F0, 0x31FF, derivedPVALID},
+	{0x3400, 0x4DBF, derivedPVALID},
+	{0x4E00, 0xA48C, derivedPVALID},
+	{0xA4D0, 0xA4FD, derivedPVALID},
+	{0xA500, 0xA60C, derivedPVALID},
+	{0xA610, 0xA62B, derivedPVALID},
+	{0xA641, 0xA641, derivedPVALID},
+	{0xA643, 0xA643, derivedPVALID},
+	{0xA645, 0xA645, derivedPVALID},
+	{0xA647, 0xA647, derivedPVALID},
+	{0xA649, 0xA649, derivedPVALID},
+	{0xA64B, 0xA64B, derivedPVALID},
+	{0xA64D, 0xA64D, derivedPVALID},
+	{0xA64F, 0xA64F, derivedPVALID},
+	{0xA651, 0xA651, derivedPVALID},
+	{0xA653, 0xA653, derivedPVALID},
+	{0xA655, 0xA655, derivedPVALID},
+	{0xA657, 0xA657, derivedPVALID},
+	{0xA659, 0xA659, derivedPVALID},
+	{0xA65B, 0xA65B, derivedPVALID},
+	{0xA65D, 0xA65D, derivedPVALID},
+	{0xA65F, 0xA65F, derivedPVALID},
+	{0xA661, 0xA661, derivedPVALID},
+	{0xA663, 0xA663, derivedPVALID},
+	{0xA665, 0xA665, derivedPVALID},
+	{0xA667, 0xA667, derivedPVALID},
+	{0xA669, 0xA669, derivedPVALID},
+	{0xA66B, 0xA66B, derivedPVALID},
+	{0xA66D, 0xA66F, derivedPVALID},
+	{0xA674, 0xA67D, derivedPVALID},
+	{0xA67F, 0xA67F, derivedPVALID},
+	{0xA681, 0xA681, derivedPVALID},
+	{0xA683, 0xA683, derivedPVALID},
+	{0xA685, 0xA685, derivedPVALID},
+	{0xA687, 0xA687, derivedPVALID},
+	{0xA689, 0xA689, derivedPVALID},
+	{0xA68B, 0xA68B, derivedPVALID},
+	{0xA68D, 0xA68D, derivedPVALID},
+	{0xA68F, 0xA68F, derivedPVALID},
+	{0xA691, 0xA691, derivedPVALID},
+	{0xA693, 0xA693, derivedPVALID},
+	{0xA695, 0xA695, derivedPVALID},
+	{0xA697, 0xA697, derivedPVALID},
+	{0xA699, 0xA699, derivedPVALID},
+	{0xA69B, 0xA69B, derivedPVALID},
+	{0xA69E, 0xA6E5, derivedPVALID},
+	{0xA6F0, 0xA6F1, derivedPVALID},
+	{0xA717, 0xA71F, derivedPVALID},
+	{0xA723, 0xA723, derivedPVALID},
+	{0xA725, 0xA725, derivedPVALID},
+	{0xA727, 0xA727, derivedPVALID},
+	{0xA729, 0xA729, derivedPVALID},
+	{0xA72B, 0xA72B, derivedPVALID},
+	{0xA72D, 0xA72D, derivedPVALID},
+	{0xA72F, 0xA731, derivedPVALID},
+	{0xA733, 0xA733, derivedPVALID},
+	{0xA735, 0xA735, derivedPVALID},
+	{0xA737, 0xA737, derivedPVALID},
+	{0xA739, 0xA739, derivedPVALID},
+	{0xA73B, 0xA73B, derivedPVALID},
+	{0xA73D, 0xA73D, derivedPVALID},
+	{0xA73F, 0xA73F, derivedPVALID},
+	{0xA741, 0xA741, derivedPVALID},
+	{0xA743, 0xA743, derivedPVALID},
+	{0xA745, 0xA745, derivedPVALID},
+	{0xA747, 0xA747, derivedPVALID},
+	{0xA749, 0xA749, derivedPVALID},
+	{0xA74B, 0xA74B, derivedPVALID},
+	{0xA74D, 0xA74D, derivedPVALID},
+	{0xA74F, 0xA74F, derivedPVALID},
+	{0xA751, 0xA751, derivedPVALID},
+	{0xA753, 0xA753, derivedPVALID},
+	{0xA755, 0xA755, derivedPVALID},
+	{0xA757, 0xA757, derivedPVALID},
+	{0xA759, 0xA759, derivedPVALID},
+	{0xA75B, 0xA75B, derivedPVALID},
+	{0xA75D, 0xA75D, derivedPVALID},
+	{0xA75F, 0xA75F, derivedPVALID},
+	{0xA761, 0xA761, derivedPVALID},
+	{0xA763, 0xA763, derivedPVALID},
+	{0xA765, 0xA765, derivedPVALID},
+	{0xA767, 0xA767, derivedPVALID},
+	{0xA769, 0xA769, derivedPVALID},
+	{0xA76B, 0xA76B, derivedPVALID},
+	{0xA76D, 0xA76D, derivedPVALID},
+	{0xA76F, 0xA76F, derivedPVALID},
+	{0xA771, 0xA778, derivedPVALID},
+	{0xA77A, 0xA77A, derivedPVALID},
+	{0xA77C, 0xA77C, derivedPVALID},
+	{0xA77F, 0xA77F, derivedPVALID},
+	{0xA781, 0xA781, derivedPVALID},
+	{0xA783, 0xA783, derivedPVALID},
+	{0xA785, 0xA785, derivedPVALID},
+	{0xA787, 0xA788, derivedPVALID},
+	{0xA78C, 0xA78C, derivedPVALID},
+	{0xA78E, 0xA78F, derivedPVALID},
+	{0xA791, 0xA791, derivedPVALID},
+	{0xA793, 0xA795, derivedPVALID},
+	{0xA797, 0xA797, derivedPVALID},
+	{0xA799, 0xA799, derivedPVALID},
+	{0xA79B, 0xA79B, derivedPVALID},
+	{0xA79D, 0xA79D, derivedPVALID},
+	{0xA79F, 0xA79F, derivedPVALID},
+	{0xA7A1, 0xA7A1, derivedPVALID},
+	{0xA7A3, 0xA7A3, derivedPVALID},
+	{0xA7A5, 0xA7A5, derivedPVALID},
+	{0xA7A7, 0xA7A7, derivedPVALID},
+	{0xA7A9, 0xA7A9, derivedPVALID},
+	{0xA7AF, 0xA7AF, derivedPVALID},
+	{0xA7B5, 0xA7B5, derivedPVALID},
+	{0xA7B7, 0xA7B7, derivedPVALID},
+	{0xA7B9, 0xA7B9, derivedPVALID},
+	{0xA7BB, 0xA7BB, derivedPVALID},
+	{0xA7BD, 0xA7BD, derivedPVALID},
+	{0xA7BF, 0xA7BF, derivedPVALID},
+	{0xA7C1, 0xA7C1, derivedPVALID},
+	{0xA7C3, 0xA7C3, derivedPVALID},
+	{0xA7C8, 0xA7C8, derivedPVALID},
+	{0xA7CA, 0xA7CA, derivedPVALID},
+	{0xA7D1, 0xA7D1, derivedPVALID},
+	{0xA7D3, 0xA7D3, derivedPVALID},
+	{0xA7D5, 0xA7D5, derivedPVALID},
+	{0xA7D7, 0xA7D7, derivedPVALID},
+	{0xA7D9, 0xA7D9, derivedPVALID},
+	{0xA7F6, 0xA7F7, derivedPVALID},
+	{0xA7FA, 0xA827, derivedPVALID},
+	{0xA82C, 0xA82C, derivedPVALID},
+	{0xA840, 0xA873, derivedPVALID},
+	{0xA880, 0xA8C5, derivedPVALID},
+	{0xA8D0, 0xA8D9, derivedPVALID},
+	{0xA8E0, 0xA8F7, derivedPVALID},
+	{0xA8FB, 0xA8FB, derivedPVALID},
+	{0xA8FD, 0xA92D, derivedPVALID},
+	{0xA930, 0xA953, derivedPVALID},
+	{0xA980, 0xA9C0, derivedPVALID},
+	{0xA9CF, 0xA9D9, derivedPVALID},
+	{0xA9E0, 0xA9FE, derivedPVALID},
+	{0xAA00, 0xAA36, derivedPVALID},
+	{0xAA40, 0xAA4D, derivedPVALID},
+	{0xAA50, 0xAA59, derivedPVALID},
+	{0xAA60, 0xAA76, derivedPVALID},
+	{0xAA7A, 0xAAC2, derivedPVALID},
+	{0xAADB, 0xAADD, derivedPVALID},
+	{0xAAE0, 0xAAEF, derivedPVALID},
+	{0xAAF2, 0xAAF6, derivedPVALID},
+	{0xAB01, 0xAB06, derivedPVALID},
+	{0xAB09, 0xAB0E, derivedPVALID},
+	{0xAB11, 0xAB16, derivedPVALID},
+	{0xAB20, 0xAB26, derivedPVALID},
+	{0xAB28, 0xAB2E, derivedPVALID},
+	{0xAB30, 0xAB5A, derivedPVALID},
+	{0xAB60, 0xAB68, derivedPVALID},
+	{0xABC0, 0xABEA, derivedPVALID},
+	{0xABEC, 0xABED, derivedPVALID},
+	{0xABF0, 0xABF9, derivedPVALID},
+	{0xAC00, 0xD7A3, derivedPVALID},
+	{0xFA0E, 0xFA0F, derivedPVALID},
+	{0xFA11, 0xFA11, derivedPVALID},
+	{0xFA13, 0xFA14, derivedPVALID},
+	{0xFA1F, 0xFA1F, derivedPVALID},
+	{0xFA21, 0xFA21, derivedPVALID},
+	{0xFA23, 0xFA24, derivedPVALID},
+	{0xFA27, 0xFA29, derivedPVALID},
+	{0xFB1E, 0xFB1E, derivedPVALID},
+	{0xFE20, 0xFE2F, derivedPVALID},
+	{0xFE73, 0xFE73, derivedPVALID},
+	{0x10000, 0x1000B, derivedPVALID},
+	{0x1000D, 0x10026, derivedPVALID},
+	{0x10028, 0x1003A, derivedPVALID},
+	{0x1003C, 0x1003D, derivedPVALID},
+	{0x1003F, 0x1004D, derivedPVALID},
+	{0x10050, 0x1005D, derivedPVALID},
+	{0x10080, 0x100FA, derivedPVALID},
+	{0x101FD, 0x101FD, derivedPVALID},
+	{0x10280, 0x1029C, derivedPVALID},
+	{0x102A0, 0x102D0, derivedPVALID},
+	{0x102E0, 0x102E0, derivedPVALID},
+	{0x10300, 0x1031F, derivedPVALID},
+	{0x1032D, 0x10340, derivedPVALID},
+	{0x10342, 0x10349, derivedPVALID},
+	{0x10350, 0x1037A, derivedPVALID},
+	{0x10380, 0x1039D, derivedPVALID},
+	{0x103A0, 0x103C3, derivedPVALID},
+	{0x103C8, 0x103CF, derivedPVALID},
+	{0x10428, 0x1049D, derivedPVALID},
+	{0x104A0, 0x104A9, derivedPVALID},
+	{0x104D8, 0x104FB, derivedPVALID},
+	{0x10500, 0x10527, derivedPVALID},
+	{0x10530, 0x10563, derivedPVALID},
+	{0x10597, 0x105A1, derivedPVALID},
+	{0x105A3, 0x105B1, derivedPVALID},
+	{0x105B3, 0x105B9, derivedPVALID},
+	{0x105BB, 0x105BC, derivedPVALID},
+	{0x10600, 0x10736, derivedPVALID},
+	{0x10740, 0x10755, derivedPVALID},
+	{0x10760, 0x10767, derivedPVALID},
+	{0x10780, 0x10780, derivedPVALID},
+	{0x10800, 0x10805, derivedPVALID},
+	{0x10808, 0x10808, derivedPVALID},
+	{0x1080A, 0x10835, derivedPVALID},
+	{0x10837, 0x10838, derivedPVALID},
+	{0x1083C, 0x1083C, derivedPVALID},
+	{0x1083F, 0x10855, derivedPVALID},
+	{0x10860, 0x10876, derivedPVALID},
+	{0x10880, 0x1089E, derivedPVALID},
+	{0x108E0, 0x108F2, derivedPVALID},
+	{0x108F4, 0x108F5, derivedPVALID},
+	{0x10900, 0x10915, derivedPVALID},
+	{0x10920, 0x10939, derivedPVALID},
+	{0x10980, 0x109B7, derivedPVALID},
+	{0x109BE, 0x109BF, derivedPVALID},
+	{0x10A00, 0x10A03, derivedPVALID},
+	{0x10A05, 0x10A06, derivedPVALID},
+	{0x10A0C, 0x10A13, derivedPVALID},
+	{0x10A15, 0x10A17, derivedPVALID},
+	{0x10A19, 0x10A35, derivedPVALID},
+	{0x10A38, 0x10A3A, derivedPVALID},
+	{0x10A3F, 0x10A3F, derivedPVALID},
+	{0x10A60, 0x10A7C, derivedPVALID},
+	{0x10A80, 0x10A9C, derivedPVALID},
+	{0x10AC0, 0x10AC7, derivedPVALID},
+	{0x10AC9, 0x10AE6, derivedPVALID},
+	{0x10B00, 0x10B35, derivedPVALID},
+	{0x10B40, 0x10B55, derivedPVALID},
+	{0x10B60, 0x10B72, derivedPVALID},
+	{0x10B80, 0x10B91, derivedPVALID},
+	{0x10C00, 0x10C48, derivedPVALID},
+	{0x10CC0, 0x10CF2, derivedPVALID},
+	{0x10D00, 0x10D27, derivedPVALID},
+	{0x10D30, 0x10D39, derivedPVALID},
+	{0x10E80, 0x10EA9, derivedPVALID},
+	{0x10EAB, 0x10EAC, derivedPVALID},
+	{0x10EB0, 0x10EB1, derivedPVALID},
+	{0x10EFD, 0x10F1C, derivedPVALID},
+	{0x10F27, 0x10F27, derivedPVALID},
+	{0x10F30, 0x10F50, derivedPVALID},
+	{0x10F70, 0x10F85, derivedPVALID},
+	{0x10FB0, 0x10FC4, derivedPVALID},
+	{0x10FE0, 0x10FF6, derivedPVALID},
+	{0x11000, 0x11046, derivedPVALID},
+	{0x11066, 0x11075, derivedPVALID},
+	{0x1107F, 0x110BA, derivedPVALID},
+	{0x110C2, 0x110C2, derivedPVALID},
+	{0x110D0, 0x110E8, derivedPVALID},
+	{0x110F0, 0x110F9, derivedPVALID},
+	{0x11100, 0x11134, derivedPVALID},
+	{0x11136, 0x1113F, derivedPVALID},
+	{0x11144, 0x11147, derivedPVALID},
+	{0x11150, 0x11173, derivedPVALID},
+	{0x11176, 0x11176, derivedPVALID},
+	{0x11180, 0x111C4, derivedPVALID},
+	{0x111C9, 0x111CC, derivedPVALID},
+	{0x111CE, 0x111DA, derivedPVALID},
+	{0x111DC, 0x111DC, derivedPVALID},
+	{0x11200, 0x11211, derivedPVALID},
+	{0x11213, 0x11237, derivedPVALID},
+	{0x1123E, 0x11241, derivedPVALID},
+	{0x11280, 0x11286, derivedPVALID},
+	{0x11288, 0x11288, derivedPVALID},
+	{0x1128A, 0x1128D, derivedPVALID},
+	{0x1128F, 0x1129D, derivedPVALID},
+	{0x1129F, 0x112A8, derivedPVALID},
+	{0x112B0, 0x112EA, derivedPVALID},
+	{0x112F0, 0x112F9, derivedPVALID},
+	{0x11300, 0x11303, derivedPVALID},
+	{0x11305, 0x1130C, derivedPVALID},
+	{0x1130F, 0x11310, derivedPVALID},
+	{0x11313, 0x11328, derivedPVALID},
+	{0x1132A, 0x11330, derivedPVALID},
+	{0x11332, 0x11333, derivedPVALID},
+	{0x11335, 0x11339, derivedPVALID},
+	{0x1133B, 0x11344, derivedPVALID},
+	{0x11347, 0x11348, derivedPVALID},
+	{0x1134B, 0x1134D, derivedPVALID},
+	{0x11350, 0x11350, derivedPVALID},
+	{0x11357, 0x11357, derivedPVALID},
+	{0x1135D, 0x11363, derivedPVALID},
+	{0x11366, 0x1136C, derivedPVALID},
+	{0x11370, 0x11374, derivedPVALID},
+	{0x11400, 0x1144A, derivedPVALID},
+	{0x11450, 0x11459, derivedPVALID},
+	{0x1145E, 0x11461, derivedPVALID},
+	{0x11480, 0x114C5, derivedPVALID},
+	{0x114C7, 0x114C7, derivedPVALID},
+	{0x114D0, 0x114D9, derivedPVALID},
+	{0x11580, 0x115B5, derivedPVALID},
+	{0x115B8, 0x115C0, derivedPVALID},
+	{0x115D8, 0x115DD, derivedPVALID},
+	{0x11600, 0x11640, derivedPVALID},
+	{0x11644, 0x11644, derivedPVALID},
+	{0x11650, 0x11659, derivedPVALID},
+	{0x11680, 0x116B8, derivedPVALID},
+	{0x116C0, 0x116C9, derivedPVALID},
+	{0x11700, 0x1171A, derivedPVALID},
+	{0x1171D, 0x1172B, derivedPVALID},
+	{0x11730, 0x11739, derivedPVALID},
+	{0x11740, 0x11746, derivedPVALID},
+	{0x11800, 0x1183A, derivedPVALID},
+	{0x118C0, 0x118E9, derivedPVALID},
+	{0x118FF, 0x11906, derivedPVALID},
+	{0x11909, 0x11909, derivedPVALID},
+	{0x1190C, 0x11913, derivedPVALID},
+	{0x11915, 0x11916, derivedPVALID},
+	{0x11918, 0x11935, derivedPVALID},
+	{0x11937, 0x11938, derivedPVALID},
+	{0x1193B, 0x11943, derivedPVALID},
+	{0x11950, 0x11959, derivedPVALID},
+	{0x119A0, 0x119A7, derivedPVALID},
+	{0x119AA, 0x119D7, derivedPVALID},
+	{0x119DA, 0x119E1, derivedPVALID},
+	{0x119E3, 0x119E4, derivedPVALID},
+	{0x11A00, 0x11A3E, derivedPVALID},
+	{0x11A47, 0x11A47, derivedPVALID},
+	{0x11A50, 0x11A99, derivedPVALID},
+	{0x11A9D, 0x11A9D, derivedPVALID},
+	{0x11AB0, 0x11AF8, derivedPVALID},
+	{0x11C00, 0x11C08, derivedPVALID},
+	{0x11C0A, 0x11C36, derivedPVALID},
+	{0x11C38, 0x11C40, derivedPVALID},
+	{0x11C50, 0x11C59, derivedPVALID},
+	{0x11C72, 0x11C8F, derivedPVALID},
+	{0x11C92, 0x11CA7, derivedPVALID},
+	{0x11CA9, 0x11CB6, derivedPVALID},
+	{0x11D00, 0x11D06, derivedPVALID},
+	{0x11D08, 0x11D09, derivedPVALID},
+	{0x11D0B, 0x11D36, derivedPVALID},
+	{0x11D3A, 0x11D3A, derivedPVALID},
+	{0x11D3C, 0x11D3D, derivedPVALID},
+	{0x11D3F, 0x11D47, derivedPVALID},
+	{0x11D50, 0x11D59, derivedPVALID},
+	{0x11D60, 0x11D65, derivedPVALID},
+	{0x11D67, 0x11D68, derivedPVALID},
+	{0x11D6A, 0x11D8E, derivedPVALID},
+	{0x11D90, 0x11D91, derivedPVALID},
+	{0x11D93, 0x11D98, derivedPVALID},
+	{0x11DA0, 0x11DA9, derivedPVALID},
+	{0x11EE0, 0x11EF6, derivedPVALID},
+	{0x11F00, 0x11F10, derivedPVALID},
+	{0x11F12, 0x11F3A, derivedPVALID},
+	{0x11F3E, 0x11F42, derivedPVALID},
+	{0x11F50, 0x11F59, derivedPVALID},
+	{0x11FB0, 0x11FB0, derivedPVALID},
+	{0x12000, 0x12399, derivedPVALID},
+	{0x12480, 0x12543, derivedPVALID},
+	{0x12F90, 0x12FF0, derivedPVALID},
+	{0x13000, 0x1342F, derivedPVALID},
+	{0x13440, 0x13455, derivedPVALID},
+	{0x14400, 0x14646, derivedPVALID},
+	{0x16800, 0x16A38, derivedPVALID},
+	{0x16A40, 0x16A5E, derivedPVALID},
+	{0x16A60, 0x16A69, derivedPVALID},
+	{0x16A70, 0x16ABE, derivedPVALID},
+	{0x16AC0, 0x16AC9, derivedPVALID},
+	{0x16AD0, 0x16AED, derivedPVALID},
+	{0x16AF0, 0x16AF4, derivedPVALID},
+	{0x16B00, 0x16B36, derivedPVALID},
+	{0x16B40, 0x16B43, derivedPVALID},
+	{0x16B50, 0x16B59, derivedPVALID},
+	{0x16B63, 0x16B77, derivedPVALID},
+	{0x16B7D, 0x16B8F, derivedPVALID},
+	{0x16E60, 0x16E7F, derivedPVALID},
+	{0x16F00, 0x16F4A, derivedPVALID},
+	{0x16F4F, 0x16F87, derivedPVALID},
+	{0x16F8F, 0x16F9F, derivedPVALID},
+	{0x16FE0, 0x16FE1, derivedPVALID},
+	{0x16FE3, 0x16FE4, derivedPVALID},
+	{0x16FF0, 0x16FF1, derivedPVALID},
+	{0x17000, 0x187F7, derivedPVALID},
+	{0x18800, 0x18CD5, derivedPVALID},
+	{0x18D00, 0x18D08, derivedPVALID},
+	{0x1AFF0, 0x1AFF3, derivedPVALID},
+	{0x1AFF5, 0x1AFFB, derivedPVALID},
+	{0x1AFFD, 0x1AFFE, derivedPVALID},
+	{0x1B000, 0x1B122, derivedPVALID},
+	{0x1B132, 0x1B132, derivedPVALID},
+	{0x1B150, 0x1B152, derivedPVALID},
+	{0x1B155, 0x1B155, derivedPVALID},
+	{0x1B164, 0x1B167, derivedPVALID},
+	{0x1B170, 0x1B2FB, derivedPVALID},
+	{0x1BC00, 0x1BC6A, derivedPVALID},
+	{0x1BC70, 0x1BC7C, derivedPVALID},
+	{0x1BC80, 0x1BC88, derivedPVALID},
+	{0x1BC90, 0x1BC99, derivedPVALID},
+	{0x1BC9D, 0x1BC9E, derivedPVALID},
+	{0x1CF00, 0x1CF2D, derivedPVALID},
+	{0x1CF30, 0x1CF46, derivedPVALID},
+	{0x1DA00, 0x1DA36, derivedPVALID},
+	{0x1DA3B, 0x1DA6C, derivedPVALID},
+	{0x1DA75, 0x1DA75, derivedPVALID},
+	{0x1DA84, 0x1DA84, derivedPVALID},
+	{0x1DA9B, 0x1DA9F, derivedPVALID},
+	{0x1DAA1, 0x1DAAF, derivedPVALID},
+	{0x1DF00, 0x1DF1E, derivedPVALID},
+	{0x1DF25, 0x1DF2A, derivedPVALID},
+	{0x1E000, 0x1E006, derivedPVALID},
+	{0x1E008, 0x1E018, derivedPVALID},
+	{0x1E01B, 0x1E021, derivedPVALID},
+	{0x1E023, 0x1E024, derivedPVALID},
+	{0x1E026, 0x1E02A, derivedPVALID},
+	{0x1E08F, 0x1E08F, derivedPVALID},
+	{0x1E100, 0x1E12C, derivedPVALID},
+	{0x1E130, 0x1E13D, derivedPVALID},
+	{0x1E140, 0x1E149, derivedPVALID},
+	{0x1E14E, 0x1E14E, derivedPVALID},
+	{0x1E290, 0x1E2AE, derivedPVALID},
+	{0x1E2C0, 0x1E2F9, derivedPVALID},
+	{0x1E4D0, 0x1E4F9, derivedPVALID},
+	{0x1E7E0, 0x1E7E6, derivedPVALID},
+	{0x1E7E8, 0x1E7EB, derivedPVALID},
+	{0x1E7ED, 0x1E7EE, derivedPVALID},
+	{0x1E7F0, 0x1E7FE, derivedPVALID},
+	{0x1E800, 0x1E8C4, derivedPVALID},
+	{0x1E8D0, 0x1E8D6, derivedPVALID},
+	{0x1E922, 0x1E94B, derivedPVALID},
+	{0x1E950, 0x1E959, derivedPVALID},
+	{0x20000, 0x2A6DF, derivedPVALID},
+	{0x2A700, 0x2B739, derivedPVALID},
+	{0x2B740, 0x2B81D, derivedPVALID},
+	{0x2B820, 0x2CEA1, derivedPVALID},
+	{0x2CEB0, 0x2EBE0, derivedPVALID},
+	{0x2EBF0, 0x2EE5D, derivedPVALID},
+	{0x30000, 0x3134A, derivedPVALID},
+	{0x31350, 0x323AF, derivedPVALID},
+}
+
+// marks holds the ranges of code points with each General_Category of
+// combining marks, sorted and without overlaps.
+var marks = []classRange{
+	{0x300, 0x36F, markMn},
+	{0x483, 0x487, markMn},
+	{0x488, 0x489, markMe},
+	{0x591, 0x5BD, markMn},
+	{0x5BF, 0x5BF, markMn},
+	{0x5C1, 0x5C2, markMn},
+	{0x5C4, 0x5C5, markMn},
+	{0x5C7, 0x5C7, markMn},
+	{0x610, 0x61A, markMn},
+	{0x64B, 0x65F, markMn},
+	{0x670, 0x670, markMn},
+	{0x6D6, 0x6DC, markMn},
+	{0x6DF, 0x6E4, markMn},
+	{0x6E7, 0x6E8, markMn},
+	{0x6EA, 0x6ED, markMn},
+	{0x711, 0x711, markMn},
+	{0x730, 0x74A, markMn},
+	{0x7A6, 0x7B0, markMn},
+	{0x7EB, 0x7F3, markMn},
+	{0x7FD, 0x7FD, markMn},
+	{0x816, 0x819, markMn},
+	{0x81B, 0x823, markMn},
+	{0x825, 0x827, markMn},
+	{0x829, 0x82D, markMn},
+	{0x859, 0x85B, markMn},
+	{0x898, 0x89F, markMn},
+	{0x8CA, 0x8E1, markMn},
+	{0x8E3, 0x902, markMn},
+	{0x903, 0x903, markMc},
+	{0x93A, 0x93A, markMn},
+	{0x93B, 0x93B, markMc},
+	{0x93C, 0x93C, markMn},
+	{0x93E, 0x940, markMc},
+	{0x941, 0x948, markMn},
+	{0x949, 0x94C, markMc},
+	{0x94D, 0x94D, markMn},
+	{0x94E, 0x94F, markMc},
+	{0x951, 0x957, markMn},
+	{0x962, 0x963, markMn},
+	{0x981, 0x981, markMn},
+	{0x982, 0x983, markMc},
+	{0x9BC, 0x9BC, markMn},
+	{0x9BE, 0x9C0, markMc},
+	{0x9C1, 0x9C4, markMn},
+	{0x9C7, 0x9C8, markMc},
+	{0x9CB, 0x9CC, markMc},
+	{0x9CD, 0x9CD, markMn},
+	{0x9D7, 0x9D7, markMc},
+	{0x9E2, 0x9E3, markMn},
+	{0x9FE, 0x9FE, markMn},
+	{0xA01, 0xA02, markMn},
+	{0xA03, 0xA03, markMc},
+	{0xA3C, 0xA3C, markMn},
+	{0xA3E, 0xA40, markMc},
+	{0xA41, 0xA42, markMn},
+	{0xA47, 0xA48, markMn},
+	{0xA4B, 0xA4D, markMn},
+	{0xA51, 0xA51, markMn},
+	{0xA70, 0xA71, markMn},
+	{0xA75, 0xA75, markMn},
+	{0xA81, 0xA82, markMn},
+	{0xA83, 0xA83, markMc},
+	{0xABC, 0xABC, markMn},
+	{0xABE, 0xAC0, markMc},
+	{0xAC1, 0xAC5, markMn},
+	{0xAC7, 0xAC8, markMn},
+	{0xAC9, 0xAC9, markMc},
+	{0xACB, 0xACC, markMc},
+	{0xACD, 0xACD, markMn},
+	{0xAE2, 0xAE3, markMn},
+	{0xAFA, 0xAFF, markMn},
+	{0xB01, 0xB01, markMn},
+	{0xB02, 0xB03, markMc},
+	{0xB3C, 0xB3C, markMn},
+	{0xB3E, 0xB3E, markMc},
+	{0xB3F, 0xB3F, markMn},
+	{0xB40, 0xB40, markMc},
+	{0xB41, 0xB44, markMn},
+	{0xB47, 0xB48, markMc},
+	{0xB4B, 0xB4C, markMc},
+	{0xB4D, 0xB4D, markMn},
+	{0xB55, 0xB56, markMn},
+	{0xB57, 0xB57, markMc},
+	{0xB62, 0xB63, markMn},
+	{0xB82, 0xB82, markMn},
+	{0xBBE, 0xBBF, markMc},
+	{0xBC0, 0xBC0, markMn},
+	{0xBC1, 0xBC2, markMc},
+	{0xBC6, 0xBC8, markMc},
+	{0xBCA, 0xBCC, markMc},
+	{0xBCD, 0xBCD, markMn},
+	{0xBD7, 0xBD7, markMc},
+	{0xC00, 0xC00, markMn},
+	{0xC01, 0xC03, markMc},
+	{0xC04, 0xC04, markMn},
+	{0xC3C, 0xC3C, markMn},
+	{0xC3E, 0xC40, markMn},
+	{0xC41, 0xC44, markMc},
+	{0xC46, 0xC48, markMn},
+	{0xC4A, 0xC4D, markMn},
+	{0xC55, 0xC56, markMn},
+	{0xC62, 0xC63, markMn},
+	{0xC81, 0xC81, markMn},
+	{0xC82, 0xC83, markMc},
+	{0xCBC, 0xCBC, markMn},
+	{0xCBE, 0xCBE, markMc},
+	{0xCBF, 0xCBF, markMn},
+	{0xCC0, 0xCC4, markMc},
+	{0xCC6, 0xCC6, markMn},
+	{0xCC7, 0xCC8, markMc},
+	{0xCCA, 0xCCB, markMc},
+	{0xCCC, 0xCCD, markMn},
+	{0xCD5, 0xCD6, markMc},
+	{0xCE2, 0xCE3, markMn},
+	{0xCF3, 0xCF3, markMc},
+	{0xD00, 0xD01, markMn},
+	{0xD02, 0xD03, markMc},
+	{0xD3B, 0xD3C, markMn},
+	{0xD3E, 0xD40, markMc},
+	{0xD41, 0xD44, markMn},
+	{0xD46, 0xD48, markMc},
+	{0xD4A, 0xD4C, markMc},
+	{0xD4D, 0xD4D, markMn},
+	{0xD57, 0xD57, markMc},
+	{0xD62, 0xD63, markMn},
+	{0xD81, 0xD81, markMn},
+	{0xD82, 0xD83, markMc},
+	{0xDCA, 0xDCA, markMn},
+	{0xDCF, 0xDD1, markMc},
+	{0xDD2, 0xDD4, markMn},
+	{0xDD6, 0xDD6, markMn},
+	{0xDD8, 0xDDF, markMc},
+	{0xDF2, 0xDF3, markMc},
+	{0xE31, 0xE31, markMn},
+	{0xE34, 0xE3A, markMn},
+	{0xE47, 0xE4E, markMn},
+	{0xEB1, 0xEB1, markMn},
+	{0xEB4, 0xEBC, markMn},
+	{0xEC8, 0xECE, markMn},
+	{0xF18, 0xF19, markMn},
+	{0xF35, 0xF35, markMn},
+	{0xF37, 0xF37, markMn},
+	{0xF39, 0xF39, markMn},
+	{0xF3E, 0xF3F, markMc},
+	{0xF71, 0xF7E, markMn},
+	{0xF7F, 0xF7F, markMc},
+	{0xF80, 0xF84, markMn},
+	{0xF86, 0xF87, markMn},
+	{0xF8D, 0xF97, markMn},
+	{0xF99, 0xFBC, markMn},
+	{0xFC6, 0xFC6, markMn},
+	{0x102B, 0x102C, markMc},
+	{0x102D, 0x1030, markMn},
+	{0x1031, 0x1031, markMc},
+	{0x1032, 0x1037, markMn},
+	{0x1038, 0x1038, markMc},
+	{0x1039, 0x103A, markMn},
+	{0x103B, 0x103C, markMc},
+	{0x103D, 0x103E, markMn},
+	{0x1056, 0x1057, markMc},
+	{0x1058, 0x1059, markMn},
+	{0x105E, 0x1060, markMn},
+	{0x1062, 0x1064, markMc},
+	{0x1067, 0x106D, markMc},
+	{0x1071, 0x1074, markMn},
+	{0x1082, 0x1082, markMn},
+	{0x1083, 0x1084, markMc},
+	{0x1085, 0x1086, markMn},
+	{0x1087, 0x108C, markMc},
+	{0x108D, 0x108D, markMn},
+	{0x108F, 0x108F, markMc},
+	{0x109A, 0x109C, markMc},
+	{0x109D, 0x109D, markMn},
+	{0x135D, 0x135F, markMn},
+	{0x1712, 0x1714, markMn},
+	{0x1715, 0x1715, markMc},
+	{0x1732, 0x1733, markMn},
+	{0x1734, 0x1734, markMc},
+	{0x1752, 0x1753, markMn},
+	{0x1772, 0x1773, markMn},
+	{0x17B4, 0x17B5, markMn},
+	{0x17B6, 0x17B6, markMc},
+	{0x17B7, 0x17BD, markMn},
+	{0x17BE, 0x17C5, markMc},
+	{0x17C6, 0x17C6, markMn},
+	{0x17C7, 0x17C8, markMc},
+	{0x17C9, 0x17D3, markMn},
+	{0x17DD, 0x17DD, markMn},
+	{0x180B, 0x180D, markMn},
+	{0x180F, 0x180F, markMn},
+	{0x1885, 0x1886, markMn},
+	{0x18A9, 0x18A9, markMn},
+	{0x1920, 0x1922, markMn},
+	{0x1923, 0x1926, markMc},
+	{0x1927, 0x1928, markMn},
+	{0x1929, 0x192B, markMc},
+	{0x1930, 0x1931, markMc},
+	{0x1932, 0x1932, markMn},
+	{0x1933, 0x1938, markMc},
+	{0x1939, 0x193B, markMn},
+	{0x1A17, 0x1A18, markMn},
+	{0x1A19, 0x1A1A, markMc},
+	{0x1A1B, 0x1A1B, markMn},
+	{0x1A55, 0x1A55, markMc},
+	{0x1A56, 0x1A56, markMn},
+	{0x1A57, 0x1A57, markMc},
+	{0x1A58, 0x1A5E, markMn},
+	{0x1A60, 0x1A60, markMn},
+	{0x1A61, 0x1A61, markMc},
+	{0x1A62, 0x1A62, markMn},
+	{0x1A63, 0x1A64, markMc},
+	{0x1A65, 0x1A6C, markMn},
+	{0x1A6D, 0x1A72, markMc},
+	{0x1A73, 0x1A7C, markMn},
+	{0x1A7F, 0x1A7F, markMn},
+	{0x1AB0, 0x1ABD, markMn},
+	{0x1ABE, 0x1ABE, markMe},
+	{0x1ABF, 0x1ACE, markMn},
+	{0x1B00, 0x1B03, markMn},
+	{0x1B04, 0x1B04, markMc},
+	{0x1B34, 0x1B34, markMn},
+	{0x1B35, 0x1B35, markMc},
+	{0x1B36, 0x1B3A, markMn},
+	{0x1B3B, 0x1B3B, markMc},
+	{0x1B3C, 0x1B3C, markMn},
+	{0x1B3D, 0x1B41, markMc},
+	{0x1B42, 0x1B42, markMn},
+	{0x1B43, 0x1B44, markMc},
+	{0x1B6B, 0x1B73, markMn},
+	{0x1B80, 0x1B81, markMn},
+	{0x1B82, 0x1B82, markMc},
+	{0x1BA1, 0x1BA1, markMc},
+	{0x1BA2, 0x1BA5, markMn},
+	{0x1BA6, 0x1BA7, markMc},
+	{0x1BA8, 0x1BA9, markMn},
+	{0x1BAA, 0x1BAA, markMc},
+	{0x1BAB, 0x1BAD, markMn},
+	{0x1BE6, 0x1BE6, markMn},
+	{0x1BE7, 0x1BE7, markMc},
+	{0x1BE8, 0x1BE9, markMn},
+	{0x1BEA, 0x1BEC, markMc},
+	{0x1BED, 0x1BED, markMn},
+	{0x1BEE, 0x1BEE, markMc},
+	{0x1BEF, 0x1BF1, markMn},
+	{0x1BF2, 0x1BF3, markMc},
+	{0x1C24, 0x1C2B, markMc},
+	{0x1C2C, 0x1C33, markMn},
+	{0x1C34, 0x1C35, markMc},
+	{0x1C36, 0x1C37, markMn},
+	{0x1CD0, 0x1CD2, markMn},
+	{0x1CD4, 0x1CE0, markMn},
+	{0x1CE1, 0x1CE1, markMc},
+	{0x1CE2, 0x1CE8, markMn},
+	{0x1CED, 0x1CED, markMn},
+	{0x1CF4, 0x1CF4, markMn},
+	{0x1CF7, 0x1CF7, markMc},
+	{0x1CF8, 0x1CF9, markMn},
+	{0x1DC0, 0x1DFF, markMn},
+	{0x20D0, 0x20DC, markMn},
+	{0x20DD, 0x20E0, markMe},
+	{0x20E1, 0x20E1, markMn},
+	{0x20E2, 0x20E4, markMe},
+	{0x20E5, 0x20F0, markMn},
+	{0x2CEF, 0x2CF1, markMn},
+	{0x2D7F, 0x2D7F, markMn},
+	{0x2DE0, 0x2DFF, markMn},
+	{0x302A, 0x302D, markMn},
+	{0x302E, 0x302F, markMc},
+	{0x3099, 0x309A, markMn},
+	{0xA66F, 0xA66F, markMn},
+	{0xA670, 0xA672, markMe},
+	{0xA674, 0xA67D, markMn},
+	{0xA69E, 0xA69F, markMn},
+	{0xA6F0, 0xA6F1, markMn},
+	{0xA802, 0xA802, markMn},
+	{0xA806, 0xA806, markMn},
+	{0xA80B, 0xA80B, markMn},
+	{0xA823, 0xA824, markMc},
+	{0xA825, 0xA826, markMn},
+	{0xA827, 0xA827, markMc},
+	{0xA82C, 0xA82C, markMn},
+	{0xA880, 0xA881, markMc},
+	{0xA8B4, 0xA8C3, markMc},
+	{0xA8C4, 0xA8C5, markMn},
+	{0xA8E0, 0xA8F1, markMn},
+	{0xA8FF, 0xA8FF, markMn},
+	{0xA926, 0xA92D, markMn},
+	{0xA947, 0xA951, markMn},
+	{0xA952, 0xA953, markMc},
+	{0xA980, 0xA982, markMn},
+	{0xA983, 0xA983, markMc},
+	{0xA9B3, 0xA9B3, markMn},
+	{0xA9B4, 0xA9B5, markMc},
+	{0xA9B6, 0xA9B9, markMn},
+	{0xA9BA, 0xA9BB, markMc},
+	{0xA9BC, 0xA9BD, markMn},
+	{0xA9BE, 0xA9C0, markMc},
+	{0xA9E5, 0xA9E5, markMn},
+	{0xAA29, 0xAA2E, markMn},
+	{0xAA2F, 0xAA30, markMc},
+	{0xAA31, 0xAA32, markMn},
+	{0xAA33, 0xAA34, markMc},
+	{0xAA35, 0xAA36, markMn},
+	{0xAA43, 0xAA43, markMn},
+	{0xAA4C, 0xAA4C, markMn},
+	{0xAA4D, 0xAA4D, markMc},
+	{0xAA7B, 0xAA7B, markMc},
+	{0xAA7C, 0xAA7C, markMn},
+	{0xAA7D, 0xAA7D, markMc},
+	{0xAAB0, 0xAAB0, markMn},
+	{0xAAB2, 0xAAB4, markMn},
+	{0xAAB7, 0xAAB8, markMn},
+	{0xAABE, 0xAABF, markMn},
+	{0xAAC1, 0xAAC1, markMn},
+	{0xAAEB, 0xAAEB, markMc},
+	{0xAAEC, 0xAAED, markMn},
+	{0xAAEE, 0xAAEF, markMc},
+	{0xAAF5, 0xAAF5, markMc},
+	{0xAAF6, 0xAAF6, markMn},
+	{0xABE3, 0xABE4, markMc},
+	{0xABE5, 0xABE5, markMn},
+	{0xABE6, 0xABE7, markMc},
+	{0xABE8, 0xABE8, markMn},
+	{0xABE9, 0xABEA, markMc},
+	{0xABEC, 0xABEC, markMc},
+	{0xABED, 0xABED, markMn},
+	{0xFB1E, 0xFB1E, markMn},
+	{0xFE00, 0xFE0F, markMn},
+	{0xFE20, 0xFE2F, markMn},
+	{0x101FD, 0x101FD, markMn},
+	{0x102E0, 0x102E0, markMn},
+	{0x10376, 0x1037A, markMn},
+	{0x10A01, 0x10A03, markMn},
+	{0x10A05, 0x10A06, markMn},
+	{0x10A0C, 0x10A0F, markMn},
+	{0x10A38, 0x10A3A, markMn},
+	{0x10A3F, 0x10A3F, markMn},
+	{0x10AE5, 0x10AE6, markMn},
+	{0x10D24, 0x10D27, markMn},
+	{0x10EAB, 0x10EAC, markMn},
+	{0x10EFD, 0x10EFF, markMn},
+	{0x10F46, 0x10F50, markMn},
+	{0x10F82, 0x10F85, markMn},
+	{0x11000, 0x11000, markMc},
+	{0x11001, 0x11001, markMn},
+	{0x11002, 0x11002, markMc},
+	{0x11038, 0x11046, markMn},
+	{0x11070, 0x11070, markMn},
+	{0x11073, 0x11074, markMn},
+	{0x1107F, 0x11081, markMn},
+	{0x11082, 0x11082, markMc},
+	{0x110B0, 0x110B2, markMc},
+	{0x110B3, 0x110B6, markMn},
+	{0x110B7, 0x110B8, markMc},
+	{0x110B9, 0x110BA, markMn},
+	{0x110C2, 0x110C2, markMn},
+	{0x11100, 0x11102, markMn},
+	{0x11127, 0x1112B, markMn},
+	{0x1112C, 0x1112C, markMc},
+	{0x1112D, 0x11134, markMn},
+	{0x11145, 0x11146, markMc},
+	{0x11173, 0x11173, markMn},
+	{0x11180, 0x11181, markMn},
+	{0x11182, 0x11182, markMc},
+	{0x111B3, 0x111B5, markMc},
+	{0x111B6, 0x111BE, markMn},
+	{0x111BF, 0x111C0, markMc},
+	{0x111C9, 0x111CC, markMn},
+	{0x111CE, 0x111CE, markMc},
+	{0x111CF, 0x111CF, markMn},
+	{0x1122C, 0x1122E, markMc},
+	{0x1122F, 0x11231, markMn},
+	{0x11232, 0x11233, markMc},
+	{0x11234, 0x11234, markMn},
+	{0x11235, 0x11235, markMc},
+	{0x11236, 0x11237, markMn},
+	{0x1123E, 0x1123E, markMn},
+	{0x11241, 0x11241, markMn},
+	{0x112DF, 0x112DF, markMn},
+	{0x112E0, 0x112E2, markMc},
+	{0x112E3, 0x112EA, markMn},
+	{0x11300, 0x11301, markMn},
+	{0x11302, 0x11303, markMc},
+	{0x1133B, 0x1133C, markMn},
+	{0x1133E, 0x1133F, markMc},
+	{0x11340, 0x11340, markMn},
+	{0x11341, 0x11344, markMc},
+	{0x11347, 0x11348, markMc},
+	{0x1134B, 0x1134D, markMc},
+	{0x11357, 0x11357, markMc},
+	{0x11362, 0x11363, markMc},
+	{0x11366, 0x1136C, markMn},
+	{0x11370, 0x11374, markMn},
+	{0x11435, 0x11437, markMc},
+	{0x11438, 0x1143F, markMn},
+	{0x11440, 0x11441, markMc},
+	{0x11442, 0x11444, markMn},
+	{0x11445, 0x11445, markMc},
+	{0x11446, 0x11446, markMn},
+	{0x1145E, 0x1145E, markMn},
+	{0x114B0, 0x114B2, markMc},
+	{0x114B3, 0x114B8, markMn},
+	{0x114B9, 0x114B9, markMc},
+	{0x114BA, 0x114BA, markMn},
+	{0x114BB, 0x114BE, markMc},
+	{0x114BF, 0x114C0, markMn},
+	{0x114C1, 0x114C1, markMc},
+	{0x114C2, 0x114C3, markMn},
+	{0x115AF, 0x115B1, markMc},
+	{0x115B2, 0x115B5, markMn},
+	{0x115B8, 0x115BB, markMc},
+	{0x115BC, 0x115BD, markMn},
+	{0x115BE, 0x115BE, markMc},
+	{0x115BF, 0x115C0, markMn},
+	{0x115DC, 0x115DD, markMn},
+	{0x11630, 0x11632, markMc},
+	{0x11633, 0x1163A, markMn},
+	{0x1163B, 0x1163C, markMc},
+	{0x1163D, 0x1163D, markMn},
+	{0x1163E, 0x1163E, markMc},
+	{0x1163F, 0x11640, markMn},
+	{0x116AB, 0x116AB, markMn},
+	{0x116AC, 0x116AC, markMc},
+	{0x116AD, 0x116AD, markMn},
+	{0x116AE, 0x116AF, markMc},
+	{0x116B0, 0x116B5, markMn},
+	{0x116B6, 0x116B6, markMc},
+	{0x116B7, 0x116B7, markMn},
+	{0x1171D, 0x1171F, markMn},
+	{0x11720, 0x11721, markMc},
+	{0x11722, 0x11725, markMn},
+	{0x11726, 0x11726, markMc},
+	{0x11727, 0x1172B, markMn},
+	{0x1182C, 0x1182E, markMc},
+	{0x1182F, 0x11837, markMn},
+	{0x11838, 0x11838, markMc},
+	{0x11839, 0x1183A, markMn},
+	{0x11930, 0x11935, markMc},
+	{0x11937, 0x11938, markMc},
+	{0x1193B, 0x1193C, markMn},
+	{0x1193D, 0x1193D, markMc},
+	{0x1193E, 0x1193E, markMn},
+	{0x11940, 0x11940, markMc},
+	{0x11942, 0x11942, markMc},
+	{0x11943, 0x11943, markMn},
+	{0x119D1, 0x119D3, markMc},
+	{0x119D4, 0x119D7, markMn},
+	{0x119DA, 0x119DB, markMn},
+	{0x119DC, 0x119DF, markMc},
+	{0x119E0, 0x119E0, markMn},
+	{0x119E4, 0x119E4, markMc},
+	{0x11A01, 0x11A0A, markMn},
+	{0x11A33, 0x11A38, markMn},
+	{0x11A39, 0x11A39, markMc},
+	{0x11A3B, 0x11A3E, markMn},
+	{0x11A47, 0x11A47, markMn},
+	{0x11A51, 0x11A56, markMn},
+	{0x11A57, 0x11A58, markMc},
+	{0x11A59, 0x11A5B, markMn},
+	{0x11A8A, 0x11A96, markMn},
+	{0x11A97, 0x11A97, markMc},
+	{0x11A98, 0x11A99, markMn},
+	{0x11C2F, 0x11C2F, markMc},
+	{0x11C30, 0x11C36, markMn},
+	{0x11C38, 0x11C3D, markMn},
+	{0x11C3E, 0x11C3E, markMc},
+	{0x11C3F, 0x11C3F, markMn},
+	{0x11C92, 0x11CA7, markMn},
+	{0x11CA9, 0x11CA9, markMc},
+	{0x11CAA, 0x11CB0, markMn},
+	{0x11CB1, 0x11CB1, markMc},
+	{0x11CB2, 0x11CB3, markMn},
+	{0x11CB4, 0x11CB4, markMc},
+	{0x11CB5, 0x11CB6, markMn},
+	{0x11D31, 0x11D36, markMn},
+	{0x11D3A, 0x11D3A, markMn},
+	{0x11D3C, 0x11D3D, markMn},
+	{0x11D3F, 0x11D45, markMn},
+	{0x11D47, 0x11D47, markMn},
+	{0x11D8A, 0x11D8E, markMc},
+	{0x11D90, 0x11D91, markMn},
+	{0x11D93, 0x11D94, markMc},
+	{0x11D95, 0x11D95, markMn},
+	{0x11D96, 0x11D96, markMc},
+	{0x11D97, 0x11D97, markMn},
+	{0x11EF3, 0x11EF4, markMn},
+	{0x11EF5, 0x11EF6, markMc},
+	{0x11F00, 0x11F01, markMn},
+	{0x11F03, 0x11F03, markMc},
+	{0x11F34, 0x11F35, markMc},
+	{0x11F36, 0x11F3A, markMn},
+	{0x11F3E, 0x11F3F, markMc},
+	{0x11F40, 0x11F40, markMn},
+	{0x11F41, 0x11F41, markMc},
+	{0x11F42, 0x11F42, markMn},
+	{0x13440, 0x13440, markMn},
+	{0x13447, 0x13455, markMn},
+	{0x16AF0, 0x16AF4, markMn},
+	{0x16B30, 0x16B36, markMn},
+	{0x16F4F, 0x16F4F, markMn},
+	{0x16F51, 0x16F87, markMc},
+	{0x16F8F, 0x16F92, markMn},
+	{0x16FE4, 0x16FE4, markMn},
+	{0x16FF0, 0x16FF1, markMc},
+	{0x1BC9D, 0x1BC9E, markMn},
+	{0x1CF00, 0x1CF2D, markMn},
+	{0x1CF30, 0x1CF46, markMn},
+	{0x1D165, 0x1D166, markMc},
+	{0x1D167, 0x1D169, markMn},
+	{0x1D16D, 0x1D172, markMc},
+	{0x1D17B, 0x1D182, markMn},
+	{0x1D185, 0x1D18B, markMn},
+	{0x1D1AA, 0x1D1AD, markMn},
+	{0x1D242, 0x1D244, markMn},
+	{0x1DA00, 0x1DA36, markMn},
+	{0x1DA3B, 0x1DA6C, markMn},
+	{0x1DA75, 0x1DA75, markMn},
+	{0x1DA84, 0x1DA84, markMn},
+	{0x1DA9B, 0x1DA9F, markMn},
+	{0x1DAA1, 0x1DAAF, markMn},
+	{0x1E000, 0x1E006, markMn},
+	{0x1E008, 0x1E018, markMn},
+	{0x1E01B, 0x1E021, markMn},
+	{0x1E023, 0x1E024, markMn},
+	{0x1E026, 0x1E02A, markMn},
+	{0x1E08F, 0x1E08F, markMn},
+	{0x1E130, 0x1E136, markMn},
+	{0x1E2AE, 0x1E2AE, markMn},
+	{0x1E2EC, 0x1E2EF, markMn},
+	{0x1E4EC, 0x1E4EF, markMn},
+	{0x1E8D0, 0x1E8D6, markMn},
+	{0x1E944, 0x1E94A, markMn},
+	{0xE0100, 0xE01EF, markMn},
+}
+
+// bidiClasses holds the ranges of code points with each Bidi_Class,
+// other than L, sorted and without overlaps.
+var bidiClasses = []classRange{
+	{0x0, 0x8, bidiBN},
+	{0x9, 0x9, bidiS},
+	{0xA, 0xA, bidiB},
+	{0xB, 0xB, bidiS},
+	{0xC, 0xC, bidiWS},
+	{0xD, 0xD, bidiB},
+	{0xE, 0x1B, bidiBN},
+	{0x1C, 0x1E, bidiB},
+	{0x1F, 0x1F, bidiS},
+	{0x20, 0x20, bidiWS},
+	{0x21, 0x22, bidiON},
+	{0x23, 0x25, bidiET},
+	{0x26, 0x2A, bidiON},
+	{0x2B, 0x2B, bidiES},
+	{0x2C, 0x2C, bidiCS},
+	{0x2D, 0x2D, bidiES},
+	{0x2E, 0x2F, bidiCS},
+	{0x30, 0x39, bidiEN},
+	{0x3A, 0x3A, bidiCS},
+	{0x3B, 0x40, bidiON},
+	{0x5B, 0x60, bidiON},
+	{0x7B, 0x7E, bidiON},
+	{0x7F, 0x84, bidiBN},
+	{0x85, 0x85, bidiB},
+	{0x86, 0x9F, bidiBN},
+	{0xA0, 0xA0, bidiCS},
+	{0xA1, 0xA1, bidiON},
+	{0xA2, 0xA5, bidiET},
+	{0xA6, 0xA9, bidiON},
+	{0xAB, 0xAC, bidiON},
+	{0xAD, 0xAD, bidiBN},
+	{0xAE, 0xAF, bidiON},
+	{0xB0, 0xB1, bidiET},
+	{0xB2, 0xB3, bidiEN},
+	{0xB4, 0xB4, bidiON},
+	{0xB6, 0xB8, bidiON},
+	{0xB9, 0xB9, bidiEN},
+	{0xBB, 0xBF, bidiON},
+	{0xD7, 0xD7, bidiON},
+	{0xF7, 0xF7, bidiON},
+	{0x2B9, 0x2BA, bidiON},
+	{0x2C2, 0x2CF, bidiON},
+	{0x2D2, 0x2DF, bidiON},
+	{0x2E5, 0x2ED, bidiON},
+	{0x2EF, 0x2FF, bidiON},
+	{0x300, 0x36F, bidiNSM},
+	{0x374, 0x375, bidiON},
+	{0x37E, 0x37E, bidiON},
+	{0x384, 0x385, bidiON},
+	{0x387, 0x387, bidiON},
+	{0x3F6, 0x3F6, bidiON},
+	{0x483, 0x489, bidiNSM},
+	{0x58A, 0x58A, bidiON},
+	{0x58D, 0x58E, bidiON},
+	{0x58F, 0x58F, bidiET},
+	{0x591, 0x5BD, bidiNSM},
+	{0x5BE, 0x5BE, bidiR},
+	{0x5BF, 0x5BF, bidiNSM},
+	{0x5C0, 0x5C0, bidiR},
+	{0x5C1, 0x5C2, bidiNSM},
+	{0x5C3, 0x5C3, bidiR},
+	{0x5C4, 0x5C5, bidiNSM},
+	{0x5C6, 0x5C6, bidiR},
+	{0x5C7, 0x5C7, bidiNSM},
+	{0x5D0, 0x5EA, bidiR},
+	{0x5EF, 0x5F4, bidiR},
+	{0x600, 0x605, bidiAN},
+	{0x606, 0x607, bidiON},
+	{0x608, 0x608, bidiAL},
+	{0x609, 0x60A, bidiET},
+	{0x60B, 0x60B, bidiAL},
+	{0x60C, 0x60C, bidiCS},
+	{0x60D, 0x60D, bidiAL},
+	{0x60E, 0x60F, bidiON},
+	{0x610, 0x61A, bidiNSM},
+	{0x61B, 0x64A, bidiAL},
+	{0x64B, 0x65F, bidiNSM},
+	{0x660, 0x669, bidiAN},
+	{0x66A, 0x66A, bidiET},
+	{0x66B, 0x66C, bidiAN},
+	{0x66D, 0x66F, bidiAL},
+	{0x670, 0x670, bidiNSM},
+	{0x671, 0x6D5, bidiAL},
+	{0x6D6, 0x6DC, bidiNSM},
+	{0x6DD, 0x6DD, bidiAN},
+	{0x6DE, 0x6DE, bidiON},
+	{0x6DF, 0x6E4, bidiNSM},
+	{0x6E5, 0x6E6, bidiAL},
+	{0x6E7, 0x6E8, bidiNSM},
+	{0x6E9, 0x6E9, bidiON},
+	{0x6EA, 0x6ED, bidiNSM},
+	{0x6EE, 0x6EF, bidiAL},
+	{0x6F0, 0x6F9, bidiEN},
+	{0x6FA, 0x70D, bidiAL},
+	{0x70F, 0x710, bidiAL},
+	{0x711, 0x711, bidiNSM},
+	{0x712, 0x72F, bidiAL},
+	{0x730, 0x74A, bidiNSM},
+	{0x74D, 0x7A5, bidiAL},
+	{0x7A6, 0x7B0, bidiNSM},
+	{0x7B1, 0x7B1, bidiAL},
+	{0x7C0, 0x7EA, bidiR},
+	{0x7EB, 0x7F3, bidiNSM},
+	{0x7F4, 0x7F5, bidiR},
+	{0x7F6, 0x7F9, bidiON},
+	{0x7FA, 0x7FA, bidiR},
+	{0x7FD, 0x7FD, bidiNSM},
+	{0x7FE, 0x815, bidiR},
+	{0x816, 0x819, bidiNSM},
+	{0x81A, 0x81A, bidiR},
+	{0x81B, 0x823, bidiNSM},
+	{0x824, 0x824, bidiR},
+	{0x825, 0x827, bidiNSM},
+	{0x828, 0x828, bidiR},
+	{0x829, 0x82D, bidiNSM},
+	{0x830, 0x83E, bidiR},
+	{0x840, 0x858, bidiR},
+	{0x859, 0x85B, bidiNSM},
+	{0x85E, 0x85E, bidiR},
+	{0x860, 0x86A, bidiAL},
+	{0x870, 0x88E, bidiAL},
+	{0x890, 0x891, bidiAN},
+	{0x898, 0x89F, bidiNSM},
+	{0x8A0, 0x8C9, bidiAL},
+	{0x8CA, 0x8E1, bidiNSM},
+	{0x8E2, 0x8E2, bidiAN},
+	{0x8E3, 0x902, bidiNSM},
+	{0x93A, 0x93A, bidiNSM},
+	{0x93C, 0x93C, bidiNSM},
+	{0x941, 0x948, bidiNSM},
+	{0x94D, 0x94D, bidiNSM},
+	{0x951, 0x957, bidiNSM},
+	{0x962, 0x963, bidiNSM},
+	{0x981, 0x981, bidiNSM},
+	{0x9BC, 0x9BC, bidiNSM},
+	{0x9C1, 0x9C4, bidiNSM},
+	{0x9CD, 0x9CD, bidiNSM},
+	{0x9E2, 0x9E3, bidiNSM},
+	{0x9F2, 0x9F3, bidiET},
+	{0x9FB, 0x9FB, bidiET},
+	{0x9FE, 0x9FE, bidiNSM},
+	{0xA01, 0xA02, bidiNSM},
+	{0xA3C, 0xA3C, bidiNSM},
+	{0xA41, 0xA42, bidiNSM},
+	{0xA47, 0xA48, bidiNSM},
+	{0xA4B, 0xA4D, bidiNSM},
+	{0xA51, 0xA51, bidiNSM},
+	{0xA70, 0xA71, bidiNSM},
+	{0xA75, 0xA75, bidiNSM},
+	{0xA81, 0xA82, bidiNSM},
+	{0xABC, 0xABC, bidiNSM},
+	{0xAC1, 0xAC5, bidiNSM},
+	{0xAC7, 0xAC8, bidiNSM},
+	{0xACD, 0xACD, bidiNSM},
+	{0xAE2, 0xAE3, bidiNSM},
+	{0xAF1, 0xAF1, bidiET},
+	{0xAFA, 0xAFF, bidiNSM},
+	{0xB01, 0xB01, bidiNSM},
+	{0xB3C, 0xB3C, bidiNSM},
+	{0xB3F, 0xB3F, bidiNSM},
+	{0xB41, 0xB44, bidiNSM},
+	{0xB4D, 0xB4D, bidiNSM},
+	{0xB55, 0xB56, bidiNSM},
+	{0xB62, 0xB63, bidiNSM},
+	{0xB82, 0xB82, bidiNSM},
+	{0xBC0, 0xBC0, bidiNSM},
+	{0xBCD, 0xBCD, bidiNSM},
+	{0xBF3, 0xBF8, bidiON},
+	{0xBF9, 0xBF9, bidiET},
+	{0xBFA, 0xBFA, bidiON},
+	{0xC00, 0xC00, bidiNSM},
+	{0xC04, 0xC04, bidiNSM},
+	{0xC3C, 0xC3C, bidiNSM},
+	{0xC3E, 0xC40, bidiNSM},
+	{0xC46, 0xC48, bidiNSM},
+	{0xC4A, 0xC4D, bidiNSM},
+	{0xC55, 0xC56, bidiNSM},
+	{0xC62, 0xC63, bidiNSM},
+	{0xC78, 0xC7E, bidiON},
+	{0xC81, 0xC81, bidiNSM},
+	{0xCBC, 0xCBC, bidiNSM},
+	{0xCCC, 0xCCD, bidiNSM},
+	{0xCE2, 0xCE3, bidiNSM},
+	{0xD00, 0xD01, bidiNSM},
+	{0xD3B, 0xD3C, bidiNSM},
+	{0xD41, 0xD44, bidiNSM},
+	{0xD4D, 0xD4D, bidiNSM},
+	{0xD62, 0xD63, bidiNSM},
+	{0xD81, 0xD81, bidiNSM},
+	{0xDCA, 0xDCA, bidiNSM},
+	{0xDD2, 0xDD4, bidiNSM},
+	{0xDD6, 0xDD6, bidiNSM},
+	{0xE31, 0xE31, bidiNSM},
+	{0xE34, 0xE3A, bidiNSM},
+	{0xE3F, 0xE3F, bidiET},
+	{0xE47, 0xE4E, bidiNSM},
+	{0xEB1, 0xEB1, bidiNSM},
+	{0xEB4, 0xEBC, bidiNSM},
+	{0xEC8, 0xECE, bidiNSM},
+	{0xF18, 0xF19, bidiNSM},
+	{0xF35, 0xF35, bidiNSM},
+	{0xF37, 0xF37, bidiNSM},
+	{0xF39, 0xF39, bidiNSM},
+	{0xF3A, 0xF3D, bidiON},
+	{0xF71, 0xF7E, bidiNSM},
+	{0xF80, 0xF84, bidiNSM},
+	{0xF86, 0xF87, bidiNSM},
+	{0xF8D, 0xF97, bidiNSM},
+	{0xF99, 0xFBC, bidiNSM},
+	{0xFC6, 0xFC6, bidiNSM},
+	{0x102D, 0x1030, bidiNSM},
+	{0x1032, 0x1037, bidiNSM},
+	{0x1039, 0x103A, bidiNSM},
+	{0x103D, 0x103E, bidiNSM},
+	{0x1058, 0x1059, bidiNSM},
+	{0x105E, 0x1060, bidiNSM},
+	{0x1071, 0x1074, bidiNSM},
+	{0x1082, 0x1082, bidiNSM},
+	{0x1085, 0x1086, bidiNSM},
+	{0x108D, 0x108D, bidiNSM},
+	{0x109D, 0x109D, bidiNSM},
+	{0x135D, 0x135F, bidiNSM},
+	{0x1390, 0x1399, bidiON},
+	{0x1400, 0x1400, bidiON},
+	{0x1680, 0x1680, bidiWS},
+	{0x169B, 0x169C, bidiON},
+	{0x1712, 0x1714, bidiNSM},
+	{0x1732, 0x1733, bidiNSM},
+	{0x1752, 0x1753, bidiNSM},
+	{0x1772, 0x1773, bidiNSM},
+	{0x17B4, 0x17B5, bidiNSM},
+	{0x17B7, 0x17BD, bidiNSM},
+	{0x17C6, 0x17C6, bidiNSM},
+	{0x17C9, 0x17D3, bidiNSM},
+	{0x17DB, 0x17DB, bidiET},
+	{0x17DD, 0x17DD, bidiNSM},
+	{0x17F0, 0x17F9, bidiON},
+	{0x1800, 0x180A, bidiON},
+	{0x180B, 0x180D, bidiNSM},
+	{0x180E, 0x180E, bidiBN},
+	{0x180F, 0x180F, bidiNSM},
+	{0x1885, 0x1886, bidiNSM},
+	{0x18A9, 0x18A9, bidiNSM},
+	{0x1920, 0x1922, bidiNSM},
+	{0x1927, 0x1928, bidiNSM},
+	{0x1932, 0x1932, bidiNSM},
+	{0x1939, 0x193B, bidiNSM},
+	{0x1940, 0x1940, bidiON},
+	{0x1944, 0x1945, bidiON},
+	{0x19DE, 0x19FF, bidiON},
+	{0x1A17, 0x1A18, bidiNSM},
+	{0x1A1B, 0x1A1B, bidiNSM},
+	{0x1A56, 0x1A56, bidiNSM},
+	{0x1A58, 0x1A5E, bidiNSM},
+	{0x1A60, 0x1A60, bidiNSM},
+	{0x1A62, 0x1A62, bidiNSM},
+	{0x1A65, 0x1A6C, bidiNSM},
+	{0x1A73, 0x1A7C, bidiNSM},
+	{0x1A7F, 0x1A7F, bidiNSM},
+	{0x1AB0, 0x1ACE, bidiNSM},
+	{0x1B00, 0x1B03, bidiNSM},
+	{0x1B34, 0x1B34, bidiNSM},
+	{0x1B36, 0x1B3A, bidiNSM},
+	{0x1B3C, 0x1B3C, bidiNSM},
+	{0x1B42, 0x1B42, bidiNSM},
+	{0x1B6B, 0x1B73, bidiNSM},
+	{0x1B80, 0x1B81, bidiNSM},
+	{0x1BA2, 0x1BA5, bidiNSM},
+	{0x1BA8, 0x1BA9, bidiNSM},
+	{0x1BAB, 0x1BAD, bidiNSM},
+	{0x1BE6, 0x1BE6, bidiNSM},
+	{0x1BE8, 0x1BE9, bidiNSM},
+	{0x1BED, 0x1BED, bidiNSM},
+	{0x1BEF, 0x1BF1, bidiNSM},
+	{0x1C2C, 0x1C33, bidiNSM},
+	{0x1C36, 0x1C37, bidiNSM},
+	{0x1CD0, 0x1CD2, bidiNSM},
+	{0x1CD4, 0x1CE0, bidiNSM},
+	{0x1CE2, 0x1CE8, bidiNSM},
+	{0x1CED, 0x1CED, bidiNSM},
+	{0x1CF4, 0x1CF4, bidiNSM},
+	{0x1CF8, 0x1CF9, bidiNSM},
+	{0x1DC0, 0x1DFF, bidiNSM},
+	{0x1FBD, 0x1FBD, bidiON},
+	{0x1FBF, 0x1FC1, bidiON},
+	{0x1FCD, 0x1FCF, bidiON},
+	{0x1FDD, 0x1FDF, bidiON},
+	{0x1FED, 0x1FEF, bidiON},
+	{0x1FFD, 0x1FFE, bidiON},
+	{0x2000, 0x200A, bidiWS},
+	{0x200B, 0x200D, bidiBN},
+	{0x200F, 0x200F, bidiR},
+	{0x2010, 0x2027, bidiON},
+	{0x2028, 0x2028, bidiWS},
+	{0x2029, 0x2029, bidiB},
+	{0x202A, 0x202A, bidiLRE},
+	{0x202B, 0x202B, bidiRLE},
+	{0x202C, 0x202C, bidiPDF},
+	{0x202D, 0x202D, bidiLRO},
+	{0x202E, 0x202E, bidiRLO},
+	{0x202F, 0x202F, bidiCS},
+	{0x2030, 0x2034, bidiET},
+	{0x2035, 0x2043, bidiON},
+	{0x2044, 0x2044, bidiCS},
+	{0x2045, 0x205E, bidiON},
+	{0x205F, 0x205F, bidiWS},
+	{0x2060, 0x2064, bidiBN},
+	{0x2066, 0x2066, bidiLRI},
+	{0x2067, 0x2067, bidiRLI},
+	{0x2068, 0x2068, bidiFSI},
+	{0x2069, 0x2069, bidiPDI},
+	{0x206A, 0x206F, bidiBN},
+	{0x2070, 0x2070, bidiEN},
+	{0x2074, 0x2079, bidiEN},
+	{0x207A, 0x207B, bidiES},
+	{0x207C, 0x207E, bidiON},
+	{0x2080, 0x2089, bidiEN},
+	{0x208A, 0x208B, bidiES},
+	{0x208C, 0x208E, bidiON},
+	{0x20A0, 0x20C0, bidiET},
+	{0x20D0, 0x20F0, bidiNSM},
+	{0x2100, 0x2101, bidiON},
+	{0x2103, 0x2106, bidiON},
+	{0x2108, 0x2109, bidiON},
+	{0x2114, 0x2114, bidiON},
+	{0x2116, 0x2118, bidiON},
+	{0x211E, 0x2123, bidiON},
+	{0x2125, 0x2125, bidiON},
+	{0x2127, 0x2127, bidiON},
+	{0x2129, 0x2129, bidiON},
+	{0x212E, 0x212E, bidiET},
+	{0x213A, 0x213B, bidiON},
+	{0x2140, 0x2144, bidiON},
+	{0x214A, 0x214D, bidiON},
+	{0x2150, 0x215F, bidiON},
+	{0x2189, 0x218B, bidiON},
+	{0x2190, 0x2211, bidiON},
+	{0x2212, 0x2212, bidiES},
+	{0x2213, 0x2213, bidiET},
+	{0x2214, 0x2335, bidiON},
+	{0x237B, 0x2394, bidiON},
+	{0x2396, 0x2426, bidiON},
+	{0x2440, 0x244A, bidiON},
+	{0x2460, 0x2487, bidiON},
+	{0x2488, 0x249B, bidiEN},
+	{0x24EA, 0x26AB, bidiON},
+	{0x26AD, 0x27FF, bidiON},
+	{0x2900, 0x2B73, bidiON},
+	{0x2B76, 0x2B95, bidiON},
+	{0x2B97, 0x2BFF, bidiON},
+	{0x2CE5, 0x2CEA, bidiON},
+	{0x2CEF, 0x2CF1, bidiNSM},
+	{0x2CF9, 0x2CFF, bidiON},
+	{0x2D7F, 0x2D7F, bidiNSM},
+	{0x2DE0, 0x2DFF, bidiNSM},
+	{0x2E00, 0x2E5D, bidiON},
+	{0x2E80, 0x2E99, bidiON},
+	{0x2E9B, 0x2EF3, bidiON},
+	{0x2F00, 0x2FD5, bidiON},
+	{0x2FF0, 0x2FFF, bidiON},
+	{0x3000, 0x3000, bidiWS},
+	{0x3001, 0x3004, bidiON},
+	{0x3008, 0x3020, bidiON},
+	{0x302A, 0x302D, bidiNSM},
+	{0x3030, 0x3030, bidiON},
+	{0x3036, 0x3037, bidiON},
+	{0x303D, 0x303F, bidiON},
+	{0x3099, 0x309A, bidiNSM},
+	{0x309B, 0x309C, bidiON},
+	{0x30A0, 0x30A0, bidiON},
+	{0x30FB, 0x30FB, bidiON},
+	{0x31C0, 0x31E3, bidiON},
+	{0x31EF, 0x31EF, bidiON},
+	{0x321D, 0x321E, bidiON},
+	{0x3250, 0x325F, bidiON},
+	{0x327C, 0x327E, bidiON},
+	{0x32B1, 0x32BF, bidiON},
+	{0x32CC, 0x32CF, bidiON},
+	{0x3377, 0x337A, bidiON},
+	{0x33DE, 0x33DF, bidiON},
+	{0x33FF, 0x33FF, bidiON},
+	{0x4DC0, 0x4DFF, bidiON},
+	{0xA490, 0xA4C6, bidiON},
+	{0xA60D, 0xA60F, bidiON},
+	{0xA66F, 0xA672, bidiNSM},
+	{0xA673, 0xA673, bidiON},
+	{0xA674, 0xA67D, bidiNSM},
+	{0xA67E, 0xA67F, bidiON},
+	{0xA69E, 0xA69F, bidiNSM},
+	{0xA6F0, 0xA6F1, bidiNSM},
+	{0xA700, 0xA721, bidiON},
+	{0xA788, 0xA788, bidiON},
+	{0xA802, 0xA802, bidiNSM},
+	{0xA806, 0xA806, bidiNSM},
+	{0xA80B, 0xA80B, bidiNSM},
+	{0xA825, 0xA826, bidiNSM},
+	{0xA828, 0xA82B, bidiON},
+	{0xA82C, 0xA82C, bidiNSM},
+	{0xA838, 0xA839, bidiET},
+	{0xA874, 0xA877, bidiON},
+	{0xA8C4, 0xA8C5, bidiNSM},
+	{0xA8E0, 0xA8F1, bidiNSM},
+	{0xA8FF, 0xA8FF, bidiNSM},
+	{0xA926, 0xA92D, bidiNSM},
+	{0xA947, 0xA951, bidiNSM},
+	{0xA980, 0xA982, bidiNSM},
+	{0xA9B3, 0xA9B3, bidiNSM},
+	{0xA9B6, 0xA9B9, bidiNSM},
+	{0xA9BC, 0xA9BD, bidiNSM},
+	{0xA9E5, 0xA9E5, bidiNSM},
+	{0xAA29, 0xAA2E, bidiNSM},
+	{0xAA31, 0xAA32, bidiNSM},
+	{0xAA35, 0xAA36, bidiNSM},
+	{0xAA43, 0xAA43, bidiNSM},
+	{0xAA4C, 0xAA4C, bidiNSM},
+	{0xAA7C, 0xAA7C, bidiNSM},
+	{0xAAB0, 0xAAB0, bidiNSM},
+	{0xAAB2, 0xAAB4, bidiNSM},
+	{0xAAB7, 0xAAB8, bidiNSM},
+	{0xAABE, 0xAABF, bidiNSM},
+	{0xAAC1, 0xAAC1, bidiNSM},
+	{0xAAEC, 0xAAED, bidiNSM},
+	{0xAAF6, 0xAAF6, bidiNSM},
+	{0xAB6A, 0xAB6B, bidiON},
+	{0xABE5, 0xABE5, bidiNSM},
+	{0xABE8, 0xABE8, bidiNSM},
+	{0xABED, 0xABED, bidiNSM},
+	{0xFB1D, 0xFB1D, bidiR},
+	{0xFB1E, 0xFB1E, bidiNSM},
+	{0xFB1F, 0xFB28, bidiR},
+	{0xFB29, 0xFB29, bidiES},
+	{0xFB2A, 0xFB36, bidiR},
+	{0xFB38, 0xFB3C, bidiR},
+	{0xFB3E, 0xFB3E, bidiR},
+	{0xFB40, 0xFB41, bidiR},
+	{0xFB43, 0xFB44, bidiR},
+	{0xFB46, 0xFB4F, bidiR},
+	{0xFB50, 0xFBC2, bidiAL},
+	{0xFBD3, 0xFD3D, bidiAL},
+	{0xFD3E, 0xFD4F, bidiON},
+	{0xFD50, 0xFD8F, bidiAL},
+	{0xFD92, 0xFDC7, bidiAL},
+	{0xFDCF, 0xFDCF, bidiON},
+	{0xFDF0, 0xFDFC, bidiAL},
+	{0xFDFD, 0xFDFF, bidiON},
+	{0xFE00, 0xFE0F, bidiNSM},
+	{0xFE10, 0xFE19, bidiON},
+	{0xFE20, 0xFE2F, bidiNSM},
+	{0xFE30, 0xFE4F, bidiON},
+	{0xFE50, 0xFE50, bidiCS},
+	{0xFE51, 0xFE51, bidiON},
+	{0xFE52, 0xFE52, bidiCS},
+	{0xFE54, 0xFE54, bidiON},
+	{0xFE55, 0xFE55, bidiCS},
+	{0xFE56, 0xFE5E, bidiON},
+	{0xFE5F, 0xFE5F, bidiET},
+	{0xFE60, 0xFE61, bidiON},
+	{0xFE62, 0xFE63, bidiES},
+	{0xFE64, 0xFE66, bidiON},
+	{0xFE68, 0xFE68, bidiON},
+	{0xFE69, 0xFE6A, bidiET},
+	{0xFE6B, 0xFE6B, bidiON},
+	{0xFE70, 0xFE74, bidiAL},
+	{0xFE76, 0xFEFC, bidiAL},
+	{0xFEFF, 0xFEFF, bidiBN},
+	{0xFF01, 0xFF02, bidiON},
+	{0xFF03, 0xFF05, bidiET},
+	{0xFF06, 0xFF0A, bidiON},
+	{0xFF0B, 0xFF0B, bidiES},
+	{0xFF0C, 0xFF0C, bidiCS},
+	{0xFF0D, 0xFF0D, bidiES},
+	{0xFF0E, 0xFF0F, bidiCS},
+	{0xFF10, 0xFF19, bidiEN},
+	{0xFF1A, 0xFF1A, bidiCS},
+	{0xFF1B, 0xFF20, bidiON},
+	{0xFF3B, 0xFF40, bidiON},
+	{0xFF5B, 0xFF65, bidiON},
+	{0xFFE0, 0xFFE1, bidiET},
+	{0xFFE2, 0xFFE4, bidiON},
+	{0xFFE5, 0xFFE6, bidiET},
+	{0xFFE8, 0xFFEE, bidiON},
+	{0xFFF9, 0xFFFD, bidiON},
+	{0x10101, 0x10101, bidiON},
+	{0x10140, 0x1018C, bidiON},
+	{0x10190, 0x1019C, bidiON},
+	{0x101A0, 0x101A0, bidiON},
+	{0x101FD, 0x101FD, bidiNSM},
+	{0x102E0, 0x102E0, bidiNSM},
+	{0x102E1, 0x102FB, bidiEN},
+	{0x10376, 0x1037A, bidiNSM},
+	{0x10800, 0x10805, bidiR},
+	{0x10808, 0x10808, bidiR},
+	{0x1080A, 0x10835, bidiR},
+	{0x10837, 0x10838, bidiR},
+	{0x1083C, 0x1083C, bidiR},
+	{0x1083F, 0x10855, bidiR},
+	{0x10857, 0x1089E, bidiR},
+	{0x108A7, 0x108AF, bidiR},
+	{0x108E0, 0x108F2, bidiR},
+	{0x108F4, 0x108F5, bidiR},
+	{0x108FB, 0x1091B, bidiR},
+	{0x1091F, 0x1091F, bidiON},
+	{0x10920, 0x10939, bidiR},
+	{0x1093F, 0x1093F, bidiR},
+	{0x10980, 0x109B7, bidiR},
+	{0x109BC, 0x109CF, bidiR},
+	{0x109D2, 0x10A00, bidiR},
+	{0x10A01, 0x10A03, bidiNSM},
+	{0x10A05, 0x10A06, bidiNSM},
+	{0x10A0C, 0x10A0F, bidiNSM},
+	{0x10A10, 0x10A13, bidiR},
+	{0x10A15, 0x10A17, bidiR},
+	{0x10A19, 0x10A35, bidiR},
+	{0x10A38, 0x10A3A, bidiNSM},
+	{0x10A3F, 0x10A3F, bidiNSM},
+	{0x10A40, 0x10A48, bidiR},
+	{0x10A50, 0x10A58, bidiR},
+	{0x10A60, 0x10A9F, bidiR},
+	{0x10AC0, 0x10AE4, bidiR},
+	{0x10AE5, 0x10AE6, bidiNSM},
+	{0x10AEB, 0x10AF6, bidiR},
+	{0x10B00, 0x10B35, bidiR},
+	{0x10B39, 0x10B3F, bidiON},
+	{0x10B40, 0x10B55, bidiR},
+	{0x10B58, 0x10B72, bidiR},
+	{0x10B78, 0x10B91, bidiR},
+	{0x10B99, 0x10B9C, bidiR},
+	{0x10BA9, 0x10BAF, bidiR},
+	{0x10C00, 0x10C48, bidiR},
+	{0x10C80, 0x10CB2, bidiR},
+	{0x10CC0, 0x10CF2, bidiR},
+	{0x10CFA, 0x10CFF, bidiR},
+	{0x10D00, 0x10D23, bidiAL},
+	{0x10D24, 0x10D27, bidiNSM},
+	{0x10D30, 0x10D39, bidiAN},
+	{0x10E60, 0x10E7E, bidiAN},
+	{0x10E80, 0x10EA9, bidiR},
+	{0x10EAB, 0x10EAC, bidiNSM},
+	{0x10EAD, 0x10EAD, bidiR},
+	{0x10EB0, 0x10EB1, bidiR},
+	{0x10EFD, 0x10EFF, bidiNSM},
+	{0x10F00, 0x10F27, bidiR},
+	{0x10F30, 0x10F45, bidiAL},
+	{0x10F46, 0x10F50, bidiNSM},
+	{0x10F51, 0x10F59, bidiAL},
+	{0x10F70, 0x10F81, bidiR},
+	{0x10F82, 0x10F85, bidiNSM},
+	{0x10F86, 0x10F89, bidiR},
+	{0x10FB0, 0x10FCB, bidiR},
+	{0x10FE0, 0x10FF6, bidiR},
+	{0x11001, 0x11001, bidiNSM},
+	{0x11038, 0x11046, bidiNSM},
+	{0x11052, 0x11065, bidiON},
+	{0x11070, 0x11070, bidiNSM},
+	{0x11073, 0x11074, bidiNSM},
+	{0x1107F, 0x11081, bidiNSM},
+	{0x110B3, 0x110B6, bidiNSM},
+	{0x110B9, 0x110BA, bidiNSM},
+	{0x110C2, 0x110C2, bidiNSM},
+	{0x11100, 0x11102, bidiNSM},
+	{0x11127, 0x1112B, bidiNSM},
+	{0x1112D, 0x11134, bidiNSM},
+	{0x11173, 0x11173, bidiNSM},
+	{0x11180, 0x11181, bidiNSM},
+	{0x111B6, 0x111BE, bidiNSM},
+	{0x111C9, 0x111CC, bidiNSM},
+	{0x111CF, 0x111CF, bidiNSM},
+	{0x1122F, 0x11231, bidiNSM},
+	{0x11234, 0x11234, bidiNSM},
+	{0x11236, 0x11237, bidiNSM},
+	{0x1123E, 0x1123E, bidiNSM},
+	{0x11241, 0x11241, bidiNSM},
+	{0x112DF, 0x112DF, bidiNSM},
+	{0x112E3, 0x112EA, bidiNSM},
+	{0x11300, 0x11301, bidiNSM},
+	{0x1133B, 0x1133C, bidiNSM},
+	{0x11340, 0x11340, bidiNSM},
+	{0x11366, 0x1136C, bidiNSM},
+	{0x11370, 0x11374, bidiNSM},
+	{0x11438, 0x1143F, bidiNSM},
+	{0x11442, 0x11444, bidiNSM},
+	{0x11446, 0x11446, bidiNSM},
+	{0x1145E, 0x1145E, bidiNSM},
+	{0x114B3, 0x114B8, bidiNSM},
+	{0x114BA, 0x114BA, bidiNSM},
+	{0x114BF, 0x114C0, bidiNSM},
+	{0x114C2, 0x114C3, bidiNSM},
+	{0x115B2, 0x115B5, bidiNSM},
+	{0x115BC, 0x115BD, bidiNSM},
+	{0x115BF, 0x115C0, bidiNSM},
+	{0x115DC, 0x115DD, bidiNSM},
+	{0x11633, 0x1163A, bidiNSM},
+	{0x1163D, 0x1163D, bidiNSM},
+	{0x1163F, 0x11640, bidiNSM},
+	{0x11660, 0x1166C, bidiON},
+	{0x116AB, 0x116AB, bidiNSM},
+	{0x116AD, 0x116AD, bidiNSM},
+	{0x116B0, 0x116B5, bidiNSM},
+	{0x116B7, 0x116B7, bidiNSM},
+	{0x1171D, 0x1171F, bidiNSM},
+	{0x11722, 0x11725, bidiNSM},
+	{0x11727, 0x1172B, bidiNSM},
+	{0x1182F, 0x11837, bidiNSM},
+	{0x11839, 0x1183A, bidiNSM},
+	{0x1193B, 0x1193C, bidiNSM},
+	{0x1193E, 0x1193E, bidiNSM},
+	{0x11943, 0x11943, bidiNSM},
+	{0x119D4, 0x119D7, bidiNSM},
+	{0x119DA, 0x119DB, bidiNSM},
+	{0x119E0, 0x119E0, bidiNSM},
+	{0x11A01, 0x11A06, bidiNSM},
+	{0x11A09, 0x11A0A, bidiNSM},
+	{0x11A33, 0x11A38, bidiNSM},
+	{0x11A3B, 0x11A3E, bidiNSM},
+	{0x11A47, 0x11A47, bidiNSM},
+	{0x11A51, 0x11A56, bidiNSM},
+	{0x11A59, 0x11A5B, bidiNSM},
+	{0x11A8A, 0x11A96, bidiNSM},
+	{0x11A98, 0x11A99, bidiNSM},
+	{0x11C30, 0x11C36, bidiNSM},
+	{0x11C38, 0x11C3D, bidiNSM},
+	{0x11C92, 0x11CA7, bidiNSM},
+	{0x11CAA, 0x11CB0, bidiNSM},
+	{0x11CB2, 0x11CB3, bidiNSM},
+	{0x11CB5, 0x11CB6, bidiNSM},
+	{0x11D31, 0x11D36, bidiNSM},
+	{0x11D3A, 0x11D3A, bidiNSM},
+	{0x11D3C, 0x11D3D, bidiNSM},
+	{0x11D3F, 0x11D45, bidiNSM},
+	{0x11D47, 0x11D47, bidiNSM},
+	{0x11D90, 0x11D91, bidiNSM},
+	{0x11D95, 0x11D95, bidiNSM},
+	{0x11D97, 0x11D97, bidiNSM},
+	{0x11EF3, 0x11EF4, bidiNSM},
+	{0x11F00, 0x11F01, bidiNSM},
+	{0x11F36, 0x11F3A, bidiNSM},
+	{0x11F40, 0x11F40, bidiNSM},
+	{0x11F42, 0x11F42, bidiNSM},
+	{0x11FD5, 0x11FDC, bidiON},
+	{0x11FDD, 0x11FE0, bidiET},
+	{0x11FE1, 0x11FF1, bidiON},
+	{0x13440, 0x13440, bidiNSM},
+	{0x13447, 0x13455, bidiNSM},
+	{0x16AF0, 0x16AF4, bidiNSM},
+	{0x16B30, 0x16B36, bidiNSM},
+	{0x16F4F, 0x16F4F, bidiNSM},
+	{0x16F8F, 0x16F92, bidiNSM},
+	{0x16FE2, 0x16FE2, bidiON},
+	{0x16FE4, 0x16FE4, bidiNSM},
+	{0x1BC9D, 0x1BC9E, bidiNSM},
+	{0x1BCA0, 0x1BCA3, bidiBN},
+	{0x1CF00, 0x1CF2D, bidiNSM},
+	{0x1CF30, 0x1CF46, bidiNSM},
+	{0x1D167, 0x1D169, bidiNSM},
+	{0x1D173, 0x1D17A, bidiBN},
+	{0x1D17B, 0x1D182, bidiNSM},
+	{0x1D185, 0x1D18B, bidiNSM},
+	{0x1D1AA, 0x1D1AD, bidiNSM},
+	{0x1D1E9, 0x1D1EA, bidiON},
+	{0x1D200, 0x1D241, bidiON},
+	{0x1D242, 0x1D244, bidiNSM},
+	{0x1D245, 0x1D245, bidiON},
+	{0x1D300, 0x1D356, bidiON},
+	{0x1D6DB, 0x1D6DB, bidiON},
+	{0x1D715, 0x1D715, bidiON},
+	{0x1D74F, 0x1D74F, bidiON},
+	{0x1D789, 0x1D789, bidiON},
+	{0x1D7C3, 0x1D7C3, bidiON},
+	{0x1D7CE, 0x1D7FF, bidiEN},
+	{0x1DA00, 0x1DA36, bidiNSM},
+	{0x1DA3B, 0x1DA6C, bidiNSM},
+	{0x1DA75, 0x1DA75, bidiNSM},
+	{0x1DA84, 0x1DA84, bidiNSM},
+	{0x1DA9B, 0x1DA9F, bidiNSM},
+	{0x1DAA1, 0x1DAAF, bidiNSM},
+	{0x1E000, 0x1E006, bidiNSM},
+	{0x1E008, 0x1E018, bidiNSM},
+	{0x1E01B, 0x1E021, bidiNSM},
+	{0x1E023, 0x1E024, bidiNSM},
+	{0x1E026, 0x1E02A, bidiNSM},
+	{0x1E08F, 0x1E08F, bidiNSM},
+	{0x1E130, 0x1E136, bidiNSM},
+	{0x1E2AE, 0x1E2AE, bidiNSM},
+	{0x1E2EC, 0x1E2EF, bidiNSM},
+	{0x1E2FF, 0x1E2FF, bidiET},
+	{0x1E4EC, 0x1E4EF, bidiNSM},
+	{0x1E800, 0x1E8C4, bidiR},
+	{0x1E8C7, 0x1E8CF, bidiR},
+	{0x1E8D0, 0x1E8D6, bidiNSM},
+	{0x1E900, 0x1E943, bidiR},
+	{0x1E944, 0x1E94A, bidiNSM},
+	{0x1E94B, 0x1E94B, bidiR},
+	{0x1E950, 0x1E959, bidiR},
+	{0x1E95E, 0x1E95F, bidiR},
+	{0x1EC71, 0x1ECB4, bidiAL},
+	{0x1ED01, 0x1ED3D, bidiAL},
+	{0x1EE00, 0x1EE03, bidiAL},
+	{0x1EE05, 0x1EE1F, bidiAL},
+	{0x1EE21, 0x1EE22, bidiAL},
+	{0x1EE24, 0x1EE24, bidiAL},
+	{0x1EE27, 0x1EE27, bidiAL},
+	{0x1EE29, 0x1EE32, bidiAL},
+	{0x1EE34, 0x1EE37, bidiAL},
+	{0x1EE39, 0x1EE39, bidiAL},
+	{0x1EE3B, 0x1EE3B, bidiAL},
+	{0x1EE42, 0x1EE42, bidiAL},
+	{0x1EE47, 0x1EE47, bidiAL},
+	{0x1EE49, 0x1EE49, bidiAL},
+	{0x1EE4B, 0x1EE4B, bidiAL},
+	{0x1EE4D, 0x1EE4F, bidiAL},
+	{0x1EE51, 0x1EE52, bidiAL},
+	{0x1EE54, 0x1EE54, bidiAL},
+	{0x1EE57, 0x1EE57, bidiAL},
+	{0x1EE59, 0x1EE59, bidiAL},
+	{0x1EE5B, 0x1EE5B, bidiAL},
+	{0x1EE5D, 0x1EE5D, bidiAL},
+	{0x1EE5F, 0x1EE5F, bidiAL},
+	{0x1EE61, 0x1EE62, bidiAL},
+	{0x1EE64, 0x1EE64, bidiAL},
+	{0x1EE67, 0x1EE6A, bidiAL},
+	{0x1EE6C, 0x1EE72, bidiAL},
+	{0x1EE74, 0x1EE77, bidiAL},
+	{0x1EE79, 0x1EE7C, bidiAL},
+	{0x1EE7E, 0x1EE7E, bidiAL},
+	{0x1EE80, 0x1EE89, bidiAL},
+	{0x1EE8B, 0x1EE9B, bidiAL},
+	{0x1EEA1, 0x1EEA3, bidiAL},
+	{0x1EEA5, 0x1EEA9, bidiAL},
+	{0x1EEAB, 0x1EEBB, bidiAL},
+	{0x1EEF0, 0x1EEF1, bidiON},
+	{0x1F000, 0x1F02B, bidiON},
+	{0x1F030, 0x1F093, bidiON},
+	{0x1F0A0, 0x1F0AE, bidiON},
+	{0x1F0B1, 0x1F0BF, bidiON},
+	{0x1F0C1, 0x1F0CF, bidiON},
+	{0x1F0D1, 0x1F0F5, bidiON},
+	{0x1F100, 0x1F10A, bidiEN},
+	{0x1F10B, 0x1F10F, bidiON},
+	{0x1F12F, 0x1F12F, bidiON},
+	{0x1F16A, 0x1F16F, bidiON},
+	{0x1F1AD, 0x1F1AD, bidiON},
+	{0x1F260, 0x1F265, bidiON},
+	{0x1F300, 0x1F6D7, bidiON},
+	{0x1F6DC, 0x1F6EC, bidiON},
+	{0x1F6F0, 0x1F6FC, bidiON},
+	{0x1F700, 0x1F776, bidiON},
+	{0x1F77B, 0x1F7D9, bidiON},
+	{0x1F7E0, 0x1F7EB, bidiON},
+	{0x1F7F0, 0x1F7F0, bidiON},
+	{0x1F800, 0x1F80B, bidiON},
+	{0x1F810, 0x1F847, bidiON},
+	{0x1F850, 0x1F859, bidiON},
+	{0x1F860, 0x1F887, bidiON},
+	{0x1F890, 0x1F8AD, bidiON},
+	{0x1F8B0, 0x1F8B1, bidiON},
+	{0x1F900, 0x1FA53, bidiON},
+	{0x1FA60, 0x1FA6D, bidiON},
+	{0x1FA70, 0x1FA7C, bidiON},
+	{0x1FA80, 0x1FA88, bidiON},
+	{0x1FA90, 0x1FABD, bidiON},
+	{0x1FABF, 0x1FAC5, bidiON},
+	{0x1FACE, 0x1FADB, bidiON},
+	{0x1FAE0, 0x1FAE8, bidiON},
+	{0x1FAF0, 0x1FAF8, bidiON},
+	{0x1FB00, 0x1FB92, bidiON},
+	{0x1FB94, 0x1FBCA, bidiON},
+	{0x1FBF0, 0x1FBF9, bidiEN},
+	{0xE0001, 0xE0001, bidiBN},
+	{0xE0020, 0xE007F, bidiBN},
+	{0xE0100, 0xE01EF, bidiNSM},
+}
+
+// joiningTypes holds the ranges of code points with each Joining_Type,
+// other than U, sorted and without overlaps.
+var joiningTypes = []classRange{
+	{0xAD, 0xAD, joiningT},
+	{0x300, 0x36F, joiningT},
+	{0x483, 0x489, joiningT},
+	{0x591, 0x5BD, joiningT},
+	{0x5BF, 0x5BF, joiningT},
+	{0x5C1, 0x5C2, joiningT},
+	{0x5C4, 0x5C5, joiningT},
+	{0x5C7, 0x5C7, joiningT},
+	{0x610, 0x61A, joiningT},
+	{0x61C, 0x61C, joiningT},
+	{0x620, 0x620, joiningD},
+	{0x622, 0x625, joiningR},
+	{0x626, 0x626, joiningD},
+	{0x627, 0x627, joiningR},
+	{0x628, 0x628, joiningD},
+	{0x629, 0x629, joiningR},
+	{0x62A, 0x62E, joiningD},
+	{0x62F, 0x632, joiningR},
+	{0x633, 0x63F, joiningD},
+	{0x640, 0x640, joiningC},
+	{0x641, 0x647, joiningD},
+	{0x648, 0x648, joiningR},
+	{0x649, 0x64A, joiningD},
+	{0x64B, 0x65F, joiningT},
+	{0x66E, 0x66F, joiningD},
+	{0x670, 0x670, joiningT},
+	{0x671, 0x673, joiningR},
+	{0x675, 0x677, joiningR},
+	{0x678, 0x687, joiningD},
+	{0x688, 0x699, joiningR},
+	{0x69A, 0x6BF, joiningD},
+	{0x6C0, 0x6C0, joiningR},
+	{0x6C1, 0x6C2, joiningD},
+	{0x6C3, 0x6CB, joiningR},
+	{0x6CC, 0x6CC, joiningD},
+	{0x6CD, 0x6CD, joiningR},
+	{0x6CE, 0x6CE, joiningD},
+	{0x6CF, 0x6CF, joiningR},
+	{0x6D0, 0x6D1, joiningD},
+	{0x6D2, 0x6D3, joiningR},
+	{0x6D5, 0x6D5, joiningR},
+	{0x6D6, 0x6DC, joiningT},
+	{0x6DF, 0x6E4, joiningT},
+	{0x6E7, 0x6E8, joiningT},
+	{0x6EA, 0x6ED, joiningT},
+	{0x6EE, 0x6EF, joiningR},
+	{0x6FA, 0x6FC, joiningD},
+	{0x6FF, 0x6FF, joiningD},
+	{0x70F, 0x70F, joiningT},
+	{0x710, 0x710, joiningR},
+	{0x711, 0x711, joiningT},
+	{0x712, 0x714, joiningD},
+	{0x715, 0x719, joiningR},
+	{0x71A, 0x71D, joiningD},
+	{0x71E, 0x71E, joiningR},
+	{0x71F, 0x727, joiningD},
+	{0x728, 0x728, joiningR},
+	{0x729, 0x729, joiningD},
+	{0x72A, 0x72A, joiningR},
+	{0x72B, 0x72B, joiningD},
+	{0x72C, 0x72C, joiningR},
+	{0x72D, 0x72E, joiningD},
+	{0x72F, 0x72F, joiningR},
+	{0x730, 0x74A, joiningT},
+	{0x74D, 0x74D, joiningR},
+	{0x74E, 0x758, joiningD},
+	{0x759, 0x75B, joiningR},
+	{0x75C, 0x76A, joiningD},
+	{0x76B, 0x76C, joiningR},
+	{0x76D, 0x770, joiningD},
+	{0x771, 0x771, joiningR},
+	{0x772, 0x772, joiningD},
+	{0x773, 0x774, joiningR},
+	{0x775, 0x777, joiningD},
+	{0x778, 0x779, joiningR},
+	{0x77A, 0x77F, joiningD},
+	{0x7A6, 0x7B0, joiningT},
+	{0x7CA, 0x7EA, joiningD},
+	{0x7EB, 0x7F3, joiningT},
+	{0x7FA, 0x7FA, joiningC},
+	{0x7FD, 0x7FD, joiningT},
+	{0x816, 0x819, joiningT},
+	{0x81B, 0x823, joiningT},
+	{0x825, 0x827, joiningT},
+	{0x829, 0x82D, joiningT},
+	{0x840, 0x840, joiningR},
+	{0x841, 0x845, joiningD},
+	{0x846, 0x847, joiningR},
+	{0x848, 0x848, joiningD},
+	{0x849, 0x849, joiningR},
+	{0x84A, 0x853, joiningD},
+	{0x854, 0x854, joiningR},
+	{0x855, 0x855, joiningD},
+	{0x856, 0x858, joiningR},
+	{0x859, 0x85B, joiningT},
+	{0x860, 0x860, joiningD},
+	{0x862, 0x865, joiningD},
+	{0x867, 0x867, joiningR},
+	{0x868, 0x868, joiningD},
+	{0x869, 0x86A, joiningR},
+	{0x870, 0x882, joiningR},
+	{0x883, 0x885, joiningC},
+	{0x886, 0x886, joiningD},
+	{0x889, 0x88D, joiningD},
+	{0x88E, 0x88E, joiningR},
+	{0x898, 0x89F, joiningT},
+	{0x8A0, 0x8A9, joiningD},
+	{0x8AA, 0x8AC, joiningR},
+	{0x8AE, 0x8AE, joiningR},
+	{0x8AF, 0x8B0, joiningD},
+	{0x8B1, 0x8B2, joiningR},
+	{0x8B3, 0x8B8, joiningD},
+	{0x8B9, 0x8B9, joiningR},
+	{0x8BA, 0x8C8, joiningD},
+	{0x8CA, 0x8E1, joiningT},
+	{0x8E3, 0x902, joiningT},
+	{0x93A, 0x93A, joiningT},
+	{0x93C, 0x93C, joiningT},
+	{0x941, 0x948, joiningT},
+	{0x94D, 0x94D, joiningT},
+	{0x951, 0x957, joiningT},
+	{0x962, 0x963, joiningT},
+	{0x981, 0x981, joiningT},
+	{0x9BC, 0x9BC, joiningT},
+	{0x9C1, 0x9C4, joiningT},
+	{0x9CD, 0x9CD, joiningT},
+	{0x9E2, 0x9E3, joiningT},
+	{0x9FE, 0x9FE, joiningT},
+	{0xA01, 0xA02, joiningT},
+	{0xA3C, 0xA3C, joiningT},
+	{0xA41, 0xA42, joiningT},
+	{0xA47, 0xA48, joiningT},
+	{0xA4B, 0xA4D, joiningT},
+	{0xA51, 0xA51, joiningT},
+	{0xA70, 0xA71, joiningT},
+	{0xA75, 0xA75, joiningT},
+	{0xA81, 0xA82, joiningT},
+	{0xABC, 0xABC, joiningT},
+	{0xAC1, 0xAC5, joiningT},
+	{0xAC7, 0xAC8, joiningT},
+	{0xACD, 0xACD, joiningT},
+	{0xAE2, 0xAE3, joiningT},
+	{0xAFA, 0xAFF, joiningT},
+	{0xB01, 0xB01, joiningT},
+	{0xB3C, 0xB3C, joiningT},
+	{0xB3F, 0xB3F, joiningT},
+	{0xB41, 0xB44, joiningT},
+	{0xB4D, 0xB4D, joiningT},
+	{0xB55, 0xB56, joiningT},
+	{0xB62, 0xB63, joiningT},
+	{0xB82, 0xB82, joiningT},
+	{0xBC0, 0xBC0, joiningT},
+	{0xBCD, 0xBCD, joiningT},
+	{0xC00, 0xC00, joiningT},
+	{0xC04, 0xC04, joiningT},
+	{0xC3C, 0xC3C, joiningT},
+	{0xC3E, 0xC40, joiningT},
+	{0xC46, 0xC48, joiningT},
+	{0xC4A, 0xC4D, joiningT},
+	{0xC55, 0xC56, joiningT},
+	{0xC62, 0xC63, joiningT},
+	{0xC81, 0xC81, joiningT},
+	{0xCBC, 0xCBC, joiningT},
+	{0xCBF, 0xCBF, joiningT},
+	{0xCC6, 0xCC6, joiningT},
+	{0xCCC, 0xCCD, joiningT},
+	{0xCE2, 0xCE3, joiningT},
+	{0xD00, 0xD01, joiningT},
+	{0xD3B, 0xD3C, joiningT},
+	{0xD41, 0xD44, joiningT},
+	{0xD4D, 0xD4D, joiningT},
+	{0xD62, 0xD63, joiningT},
+	{0xD81, 0xD81, joiningT},
+	{0xDCA, 0xDCA, joiningT},
+	{0xDD2, 0xDD4, joiningT},
+	{0xDD6, 0xDD6, joiningT},
+	{0xE31, 0xE31, joiningT},
+	{0xE34, 0xE3A, joiningT},
+	{0xE47, 0xE4E, joiningT},
+	{0xEB1, 0xEB1, joiningT},
+	{0xEB4, 0xEBC, joiningT},
+	{0xEC8, 0xECE, joiningT},
+	{0xF18, 0xF19, joiningT},
+	{0xF35, 0xF35, joiningT},
+	{0xF37, 0xF37, joiningT},
+	{0xF39, 0xF39, joiningT},
+	{0xF71, 0xF7E, joiningT},
+	{0xF80, 0xF84, joiningT},
+	{0xF86, 0xF87, joiningT},
+	{0xF8D, 0xF97, joiningT},
+	{0xF99, 0xFBC, joiningT},
+	{0xFC6, 0xFC6, joiningT},
+	{0x102D, 0x1030, joiningT},
+	{0x1032, 0x1037, joiningT},
+	{0x1039, 0x103A, joiningT},
+	{0x103D, 0x103E, joiningT},
+	{0x1058, 0x1059, joiningT},
+	{0x105E, 0x1060, joiningT},
+	{0x1071, 0x1074, joiningT},
+	{0x1082, 0x1082, joiningT},
+	{0x1085, 0x1086, joiningT},
+	{0x108D, 0x108D, joiningT},
+	{0x109D, 0x109D, joiningT},
+	{0x135D, 0x135F, joiningT},
+	{0x1712, 0x1714, joiningT},
+	{0x1732, 0x1733, joiningT},
+	{0x1752, 0x1753, joiningT},
+	{0x1772, 0x1773, joiningT},
+	{0x17B4, 0x17B5, joiningT},
+	{0x17B7, 0x17BD, joiningT},
+	{0x17C6, 0x17C6, joiningT},
+	{0x17C9, 0x17D3, joiningT},
+	{0x17DD, 0x17DD, joiningT},
+	{0x1807, 0x1807, joiningD},
+	{0x180A, 0x180A, joiningC},
+	{0x180B, 0x180D, joiningT},
+	{0x180F, 0x180F, joiningT},
+	{0x1820, 0x1878, joiningD},
+	{0x1885, 0x1886, joiningT},
+	{0x1887, 0x18A8, joiningD},
+	{0x18A9, 0x18A9, joiningT},
+	{0x18AA, 0x18AA, joiningD},
+	{0x1920, 0x1922, joiningT},
+	{0x1927, 0x1928, joiningT},
+	{0x1932, 0x1932, joiningT},
+	{0x1939, 0x193B, joiningT},
+	{0x1A17, 0x1A18, joiningT},
+	{0x1A1B, 0x1A1B, joiningT},
+	{0x1A56, 0x1A56, joiningT},
+	{0x1A58, 0x1A5E, joiningT},
+	{0x1A60, 0x1A60, joiningT},
+	{0x1A62, 0x1A62, joiningT},
+	{0x1A65, 0x1A6C, joiningT},
+	{0x1A73, 0x1A7C, joiningT},
+	{0x1A7F, 0x1A7F, joiningT},
+	{0x1AB0, 0x1ACE, joiningT},
+	{0x1B00, 0x1B03, joiningT},
+	{0x1B34, 0x1B34, joiningT},
+	{0x1B36, 0x1B3A, joiningT},
+	{0x1B3C, 0x1B3C, joiningT},
+	{0x1B42, 0x1B42, joiningT},
+	{0x1B6B, 0x1B73, joiningT},
+	{0x1B80, 0x1B81, joiningT},
+	{0x1BA2, 0x1BA5, joiningT},
+	{0x1BA8, 0x1BA9, joiningT},
+	{0x1BAB, 0x1BAD, joiningT},
+	{0x1BE6, 0x1BE6, joiningT},
+	{0x1BE8, 0x1BE9, joiningT},
+	{0x1BED, 0x1BED, joiningT},
+	{0x1BEF, 0x1BF1, joiningT},
+	{0x1C2C, 0x1C33, joiningT},
+	{0x1C36, 0x1C37, joiningT},
+	{0x1CD0, 0x1CD2, joiningT},
+	{0x1CD4, 0x1CE0, joiningT},
+	{0x1CE2, 0x1CE8, joiningT},
+	{0x1CED, 0x1CED, joiningT},
+	{0x1CF4, 0x1CF4, joiningT},
+	{0x1CF8, 0x1CF9, joiningT},
+	{0x1DC0, 0x1DFF, joiningT},
+	{0x200B, 0x200B, joiningT},
+	{0x200D, 0x200D, joiningC},
+	{0x200E, 0x200F, joiningT},
+	{0x202A, 0x202E, joiningT},
+	{0x2060, 0x2064, joiningT},
+	{0x206A, 0x206F, joiningT},
+	{0x20D0, 0x20F0, joiningT},
+	{0x2CEF, 0x2CF1, joiningT},
+	{0x2D7F, 0x2D7F, joiningT},
+	{0x2DE0, 0x2DFF, joiningT},
+	{0x302A, 0x302D, joiningT},
+	{0x3099, 0x309A, joiningT},
+	{0xA66F, 0xA672, joiningT},
+	{0xA674, 0xA67D, joiningT},
+	{0xA69E, 0xA69F, joiningT},
+	{0xA6F0, 0xA6F1, joiningT},
+	{0xA802, 0xA802, joiningT},
+	{0xA806, 0xA806, joiningT},
+	{0xA80B, 0xA80B, joiningT},
+	{0xA825, 0xA826, joiningT},
+	{0xA82C, 0xA82C, joiningT},
+	{0xA840, 0xA871, joiningD},
+	{0xA872, 0xA872, joiningL},
+	{0xA8C4, 0xA8C5, joiningT},
+	{0xA8E0, 0xA8F1, joiningT},
+	{0xA8FF, 0xA8FF, joiningT},
+	{0xA926, 0xA92D, joiningT},
+	{0xA947, 0xA951, joiningT},
+	{0xA980, 0xA982, joiningT},
+	{0xA9B3, 0xA9B3, joiningT},
+	{0xA9B6, 0xA9B9, joiningT},
+	{0xA9BC, 0xA9BD, joiningT},
+	{0xA9E5, 0xA9E5, joiningT},
+	{0xAA29, 0xAA2E, joiningT},
+	{0xAA31, 0xAA32, joiningT},
+	{0xAA35, 0xAA36, joiningT},
+	{0xAA43, 0xAA43, joiningT},
+	{0xAA4C, 0xAA4C, joiningT},
+	{0xAA7C, 0xAA7C, joiningT},
+	{0xAAB0, 0xAAB0, joiningT},
+	{0xAAB2, 0xAAB4, joiningT},
+	{0xAAB7, 0xAAB8, joiningT},
+	{0xAABE, 0xAABF, joiningT},
+	{0xAAC1, 0xAAC1, joiningT},
+	{0xAAEC, 0xAAED, joiningT},
+	{0xAAF6, 0xAAF6, joiningT},
+	{0xABE5, 0xABE5, joiningT},
+	{0xABE8, 0xABE8, joiningT},
+	{0xABED, 0xABED, joiningT},
+	{0xFB1E, 0xFB1E, joiningT},
+	{0xFE00, 0xFE0F, joiningT},
+	{0xFE20, 0xFE2F, joiningT},
+	{0xFEFF, 0xFEFF, joiningT},
+	{0xFFF9, 0xFFFB, joiningT},
+	{0x101FD, 0x101FD, joiningT},
+	{0x102E0, 0x102E0, joiningT},
+	{0x10376, 0x1037A, joiningT},
+	{0x10A01, 0x10A03, joiningT},
+	{0x10A05, 0x10A06, joiningT},
+	{0x10A0C, 0x10A0F, joiningT},
+	{0x10A38, 0x10A3A, joiningT},
+	{0x10A3F, 0x10A3F, joiningT},
+	{0x10AC0, 0x10AC4, joiningD},
+	{0x10AC5, 0x10AC5, joiningR},
+	{0x10AC7, 0x10AC7, joiningR},
+	{0x10AC9, 0x10ACA, joiningR},
+	{0x10ACD, 0x10ACD, joiningL},
+	{0x10ACE, 0x10AD2, joiningR},
+	{0x10AD3, 0x10AD6, joiningD},
+	{0x10AD7, 0x10AD7, joiningL},
+	{0x10AD8, 0x10ADC, joiningD},
+	{0x10ADD, 0x10ADD, joiningR},
+	{0x10ADE, 0x10AE0, joiningD},
+	{0x10AE1, 0x10AE1, joiningR},
+	{0x10AE4, 0x10AE4, joiningR},
+	{0x10AE5, 0x10AE6, joiningT},
+	{0x10AEB, 0x10AEE, joiningD},
+	{0x10AEF, 0x10AEF, joiningR},
+	{0x10B80, 0x10B80, joiningD},
+	{0x10B81, 0x10B81, joiningR},
+	{0x10B82, 0x10B82, joiningD},
+	{0x10B83, 0x10B85, joiningR},
+	{0x10B86, 0x10B88, joiningD},
+	{0x10B89, 0x10B89, joiningR},
+	{0x10B8A, 0x10B8B, joiningD},
+	{0x10B8C, 0x10B8C, joiningR},
+	{0x10B8D, 0x10B8D, joiningD},
+	{0x10B8E, 0x10B8F, joiningR},
+	{0x10B90, 0x10B90, joiningD},
+	{0x10B91, 0x10B91, joiningR},
+	{0x10BA9, 0x10BAC, joiningR},
+	{0x10BAD, 0x10BAE, joiningD},
+	{0x10D00, 0x10D00, joiningL},
+	{0x10D01, 0x10D21, joiningD},
+	{0x10D22, 0x10D22, joiningR},
+	{0x10D23, 0x10D23, joiningD},
+	{0x10D24, 0x10D27, joiningT},
+	{0x10EAB, 0x10EAC, joiningT},
+	{0x10EFD, 0x10EFF, joiningT},
+	{0x10F30, 0x10F32, joiningD},
+	{0x10F33, 0x10F33, joiningR},
+	{0x10F34, 0x10F44, joiningD},
+	{0x10F46, 0x10F50, joiningT},
+	{0x10F51, 0x10F53, joiningD},
+	{0x10F54, 0x10F54, joiningR},
+	{0x10F70, 0x10F73, joiningD},
+	{0x10F74, 0x10F75, joiningR},
+	{0x10F76, 0x10F81, joiningD},
+	{0x10F82, 0x10F85, joiningT},
+	{0x10FB0, 0x10FB0, joiningD},
+	{0x10FB2, 0x10FB3, joiningD},
+	{0x10FB4, 0x10FB6, joiningR},
+	{0x10FB8, 0x10FB8, joiningD},
+	{0x10FB9, 0x10FBA, joiningR},
+	{0x10FBB, 0x10FBC, joiningD},
+	{0x10FBD, 0x10FBD, joiningR},
+	{0x10FBE, 0x10FBF, joiningD},
+	{0x10FC1, 0x10FC1, joiningD},
+	{0x10FC2, 0x10FC3, joiningR},
+	{0x10FC4, 0x10FC4, joiningD},
+	{0x10FC9, 0x10FC9, joiningR},
+	{0x10FCA, 0x10FCA, joiningD},
+	{0x10FCB, 0x10FCB, joiningL},
+	{0x11001, 0x11001, joiningT},
+	{0x11038, 0x11046, joiningT},
+	{0x11070, 0x11070, joiningT},
+	{0x11073, 0x11074, joiningT},
+	{0x1107F, 0x11081, joiningT},
+	{0x110B3, 0x110B6, joiningT},
+	{0x110B9, 0x110BA, joiningT},
+	{0x110C2, 0x110C2, joiningT},
+	{0x11100, 0x11102, joiningT},
+	{0x11127, 0x1112B, joiningT},
+	{0x1112D, 0x11134, joiningT},
+	{0x11173, 0x11173, joiningT},
+	{0x11180, 0x11181, joiningT},
+	{0x111B6, 0x111BE, joiningT},
+	{0x111C9, 0x111CC, joiningT},
+	{0x111CF, 0x111CF, joiningT},
+	{0x1122F, 0x11231, joiningT},
+	{0x11234, 0x11234, joiningT},
+	{0x11236, 0x11237, joiningT},
+	{0x1123E, 0x1123E, joiningT},
+	{0x11241, 0x11241, joiningT},
+	{0x112DF, 0x112DF, joiningT},
+	{0x112E3, 0x112EA, joiningT},
+	{0x11300, 0x11301, joiningT},
+	{0x1133B, 0x1133C, joiningT},
+	{0x11340, 0x11340, joiningT},
+	{0x11366, 0x1136C, joiningT},
+	{0x11370, 0x11374, joiningT},
+	{0x11438, 0x1143F, joiningT},
+	{0x11442, 0x11444, joiningT},
+	{0x11446, 0x11446, joiningT},
+	{0x1145E, 0x1145E, joiningT},
+	{0x114B3, 0x114B8, joiningT},
+	{0x114BA, 0x114BA, joiningT},
+	{0x114BF, 0x114C0, joiningT},
+	{0x114C2, 0x114C3, joiningT},
+	{0x115B2, 0x115B5, joiningT},
+	{0x115BC, 0x115BD, joiningT},
+	{0x115BF, 0x115C0, joiningT},
+	{0x115DC, 0x115DD, joiningT},
+	{0x11633, 0x1163A, joiningT},
+	{0x1163D, 0x1163D, joiningT},
+	{0x1163F, 0x11640, joiningT},
+	{0x116AB, 0x116AB, joiningT},
+	{0x116AD, 0x116AD, joiningT},
+	{0x116B0, 0x116B5, joiningT},
+	{0x116B7, 0x116B7, joiningT},
+	{0x1171D, 0x1171F, joiningT},
+	{0x11722, 0x11725, joiningT},
+	{0x11727, 0x1172B, joiningT},
+	{0x1182F, 0x11837, joiningT},
+	{0x11839, 0x1183A, joiningT},
+	{0x1193B, 0x1193C, joiningT},
+	{0x1193E, 0x1193E, joiningT},
+	{0x11943, 0x11943, joiningT},
+	{0x119D4, 0x119D7, joiningT},
+	{0x119DA, 0x119DB, joiningT},
+	{0x119E0, 0x119E0, joiningT},
+	{0x11A01, 0x11A0A, joiningT},
+	{0x11A33, 0x11A38, joiningT},
+	{0x11A3B, 0x11A3E, joiningT},
+	{0x11A47, 0x11A47, joiningT},
+	{0x11A51, 0x11A56, joiningT},
+	{0x11A59, 0x11A5B, joiningT},
+	{0x11A8A, 0x11A96, joiningT},
+	{0x11A98, 0x11A99, joiningT},
+	{0x11C30, 0x11C36, joiningT},
+	{0x11C38, 0x11C3D, joiningT},
+	{0x11C3F, 0x11C3F, joiningT},
+	{0x11C92, 0x11CA7, joiningT},
+	{0x11CAA, 0x11CB0, joiningT},
+	{0x11CB2, 0x11CB3, joiningT},
+	{0x11CB5, 0x11CB6, joiningT},
+	{0x11D31, 0x11D36, joiningT},
+	{0x11D3A, 0x11D3A, joiningT},
+	{0x11D3C, 0x11D3D, joiningT},
+	{0x11D3F, 0x11D45, joiningT},
+	{0x11D47, 0x11D47, joiningT},
+	{0x11D90, 0x11D91, joiningT},
+	{0x11D95, 0x11D95, joiningT},
+	{0x11D97, 0x11D97, joiningT},
+	{0x11EF3, 0x11EF4, joiningT},
+	{0x11F00, 0x11F01, joiningT},
+	{0x11F36, 0x11F3A, joiningT},
+	{0x11F40, 0x11F40, joiningT},
+	{0x11F42, 0x11F42, joiningT},
+	{0x13430, 0x13440, joiningT},
+	{0x13447, 0x13455, joiningT},
+	{0x16AF0, 0x16AF4, joiningT},
+	{0x16B30, 0x16B36, joiningT},
+	{0x16F4F, 0x16F4F, joiningT},
+	{0x16F8F, 0x16F92, joiningT},
+	{0x16FE4, 0x16FE4, joiningT},
+	{0x1BC9D, 0x1BC9E, joiningT},
+	{0x1BCA0, 0x1BCA3, joiningT},
+	{0x1CF00, 0x1CF2D, joiningT},
+	{0x1CF30, 0x1CF46, joiningT},
+	{0x1D167, 0x1D169, joiningT},
+	{0x1D173, 0x1D182, joiningT},
+	{0x1D185, 0x1D18B, joiningT},
+	{0x1D1AA, 0x1D1AD, joiningT},
+	{0x1D242, 0x1D244, joiningT},
+	{0x1DA00, 0x1DA36, joiningT},
+	{0x1DA3B, 0x1DA6C, joiningT},
+	{0x1DA75, 0x1DA75, joiningT},
+	{0x1DA84, 0x1DA84, joiningT},
+	{0x1DA9B, 0x1DA9F, joiningT},
+	{0x1DAA1, 0x1DAAF, joiningT},
+	{0x1E000, 0x1E006, joiningT},
+	{0x1E008, 0x1E018, joiningT},
+	{0x1E01B, 0x1E021, joiningT},
+	{0x1E023, 0x1E024, joiningT},
+	{0x1E026, 0x1E02A, joiningT},
+	{0x1E08F, 0x1E08F, joiningT},
+	{0x1E130, 0x1E136, joiningT},
+	{0x1E2AE, 0x1E2AE, joiningT},
+	{0x1E2EC, 0x1E2EF, joiningT},
+	{0x1E4EC, 0x1E4EF, joiningT},
+	{0x1E8D0, 0x1E8D6, joiningT},
+	{0x1E900, 0x1E943, joiningD},
+	{0x1E944, 0x1E94B, joiningT},
+	{0xE0001, 0xE0001, joiningT},
+	{0xE0020, 0xE007F, joiningT},
+	{0xE0100, 0xE01EF, joiningT},
+}
+
+// scripts holds the ranges of code points with each Script the rules of
+// RFC5892 Appendix A name, sorted and without overlaps.
+var scripts = []classRange{
+	{0x370, 0x373, scriptGreek},
+	{0x375, 0x377, scriptGreek},
+	{0x37A, 0x37D, scriptGreek},
+	{0x37F, 0x37F, scriptGreek},
+	{0x384, 0x384, scriptGreek},
+	{0x386, 0x386, scriptGreek},
+	{0x388, 0x38A, scriptGreek},
+	{0x38C, 0x38C, scriptGreek},
+	{0x38E, 0x3A1, scriptGreek},
+	{0x3A3, 0x3E1, scriptGreek},
+	{0x3F0, 0x3FF, scriptGreek},
+	{0x591, 0x5C7, scriptHebrew},
+	{0x5D0, 0x5EA, scriptHebrew},
+	{0x5EF, 0x5F4, scriptHebrew},
+	{0x1D26, 0x1D2A, scriptGreek},
+	{0x1D5D, 0x1D61, scriptGreek},
+	{0x1D66, 0x1D6A, scriptGreek},
+	{0x1DBF, 0x1DBF, scriptGreek},
+	{0x1F00, 0x1F15, scriptGreek},
+	{0x1F18, 0x1F1D, scriptGreek},
+	{0x1F20, 0x1F45, scriptGreek},
+	{0x1F48, 0x1F4D, scriptGreek},
+	{0x1F50, 0x1F57, scriptGreek},
+	{0x1F59, 0x1F59, scriptGreek},
+	{0x1F5B, 0x1F5B, scriptGreek},
+	{0x1F5D, 0x1F5D, scriptGreek},
+	{0x1F5F, 0x1F7D, scriptGreek},
+	{0x1F80, 0x1FB4, scriptGreek},
+	{0x1FB6, 0x1FC4, scriptGreek},
+	{0x1FC6, 0x1FD3, scriptGreek},
+	{0x1FD6, 0x1FDB, scriptGreek},
+	{0x1FDD, 0x1FEF, scriptGreek},
+	{0x1FF2, 0x1FF4, scriptGreek},
+	{0x1FF6, 0x1FFE, scriptGreek},
+	{0x2126, 0x2126, scriptGreek},
+	{0x2E80, 0x2E99, scriptHan},
+	{0x2E9B, 0x2EF3, scriptHan},
+	{0x2F00, 0x2FD5, scriptHan},
+	{0x3005, 0x3005, scriptHan},
+	{0x3007, 0x3007, scriptHan},
+	{0x3021, 0x3029, scriptHan},
+	{0x3038, 0x303B, scriptHan},
+	{0x3041, 0x3096, scriptHiragana},
+	{0x309D, 0x309F, scriptHiragana},
+	{0x30A1, 0x30FA, scriptKatakana},
+	{0x30FD, 0x30FF, scriptKatakana},
+	{0x31F0, 0x31FF, scriptKatakana},
+	{0x32D0, 0x32FE, scriptKatakana},
+	{0x3300, 0x3357, scriptKatakana},
+	{0x3400, 0x4DBF, scriptHan},
+	{0x4E00, 0x9FFF, scriptHan},
+	{0xAB65, 0xAB65, scriptGreek},
+	{0xF900, 0xFA6D, scriptHan},
+	{0xFA70, 0xFAD9, scriptHan},
+	{0xFB1D, 0xFB36, scriptHebrew},
+	{0xFB38, 0xFB3C, scriptHebrew},
+	{0xFB3E, 0xFB3E, scriptHebrew},
+	{0xFB40, 0xFB41, scriptHebrew},
+	{0xFB43, 0xFB44, scriptHebrew},
+	{0xFB46, 0xFB4F, scriptHebrew},
+	{0xFF66, 0xFF6F, scriptKatakana},
+	{0xFF71, 0xFF9D, scriptKatakana},
+	{0x10140, 0x1018E, scriptGreek},
+	{0x101A0, 0x101A0, scriptGreek},
+	{0x16FE2, 0x16FE3, scriptHan},
+	{0x16FF0, 0x16FF1, scriptHan},
+	{0x1AFF0, 0x1AFF3, scriptKatakana},
+	{0x1AFF5, 0x1AFFB, scriptKatakana},
+	{0x1AFFD, 0x1AFFE, scriptKatakana},
+	{0x1B000, 0x1B000, scriptKatakana},
+	{0x1B001, 0x1B11F, scriptHiragana},
+	{0x1B120, 0x1B122, scriptKatakana},
+	{0x1B132, 0x1B132, scriptHiragana},
+	{0x1B150, 0x1B152, scriptHiragana},
+	{0x1B155, 0x1B155, scriptKatakana},
+	{0x1B164, 0x1B167, scriptKatakana},
+	{0x1D200, 0x1D245, scriptGreek},
+	{0x1F200, 0x1F200, scriptHiragana},
+	{0x20000, 0x2A6DF, scriptHan},
+	{0x2A700, 0x2B739, scriptHan},
+	{0x2B740, 0x2B81D, scriptHan},
+	{0x2B820, 0x2CEA1, scriptHan},
+	{0x2CEB0, 0x2EBE0, scriptHan},
+	{0x2EBF0, 0x2EE5D, scriptHan},
+	{0x2F800, 0x2FA1D, scriptHan},
+	{0x30000, 0x3134A, scriptHan},
+	{0x31350, 0x323AF, scriptHan},
+}
@@ -0,0 +1,362 @@
+// Package metrics collects counters and histograms about RDAP and bootstrap
+// activity and exposes them in the Prometheus text exposition format.
+//
+// A nil *Collector is valid and discards everything recorded against it,
+// so callers can leave metrics disabled without extra checks.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// DefaultBuckets are the upper bounds (in seconds) used for latency
+	// histograms when a Collector doesn't specify its own.
+	DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+)
+
+// Collector records RDAP client and bootstrap activity.
+//
+// Collector implements http.Handler and can be mounted directly on a
+// server mux (e.g. at /metrics).
+type Collector struct {
+	// Buckets are the histogram upper bounds in seconds. They must be
+	// sorted in increasing order and should not be changed after the
+	// first observation.
+	Buckets []float64
+
+	mu sync.Mutex
+
+	requests    map[requestKey]uint64
+	latencies   map[latencyKey]*histogram
+	cache       map[cacheKey]uint64
+	retries     map[latencyKey]uint64
+	rateLimited map[string]uint64
+	coalesced   map[latencyKey]uint64
+	responses   map[responseCacheKey]uint64
+}
+
+type requestKey struct {
+	host, class, code string
+}
+
+type latencyKey struct {
+	host, class string
+}
+
+type cacheKey struct {
+	registry, result string
+}
+
+type responseCacheKey struct {
+	class, result string
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, non-cumulative
+	count  uint64
+	sum    float64
+}
+
+// New returns an empty Collector using DefaultBuckets.
+func New() *Collector {
+	return &Collector{
+		Buckets: DefaultBuckets,
+	}
+}
+
+func (c *Collector) init() {
+	if c.requests == nil {
+		c.requests = make(map[requestKey]uint64)
+		c.latencies = make(map[latencyKey]*histogram)
+		c.cache = make(map[cacheKey]uint64)
+		c.retries = make(map[latencyKey]uint64)
+		c.rateLimited = make(map[string]uint64)
+		c.coalesced = make(map[latencyKey]uint64)
+		c.responses = make(map[responseCacheKey]uint64)
+	}
+	if len(c.Buckets) == 0 {
+		c.Buckets = DefaultBuckets
+	}
+}
+
+// ObserveRequest records a completed HTTP request against host for the
+// given object class (e.g. "domain", "ip network" or "bootstrap").
+//
+// A code of zero means the request failed before a response was read.
+func (c *Collector) ObserveRequest(host, class string, code int, took time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+
+	status := "error"
+	if code > 0 {
+		status = strconv.Itoa(code)
+	}
+	c.requests[requestKey{host, class, status}]++
+
+	k := latencyKey{host, class}
+	h, ok := c.latencies[k]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.Buckets))}
+		c.latencies[k] = h
+	}
+	secs := took.Seconds()
+	for i := range c.Buckets {
+		if secs <= c.Buckets[i] {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += secs
+}
+
+// CacheHit records a bootstrap registry (e.g. "dns") served from cache.
+func (c *Collector) CacheHit(registry string) {
+	c.cacheResult(registry, "hit")
+}
+
+// CacheMiss records a bootstrap registry (e.g. "dns") which had to be
+// fetched from its endpoint.
+func (c *Collector) CacheMiss(registry string) {
+	c.cacheResult(registry, "miss")
+}
+
+func (c *Collector) cacheResult(registry, result string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.cache[cacheKey{registry, result}]++
+}
+
+// ResponseCache records a lookup of an RDAP response cache for the given
+// object class. result is "hit", "miss" or "revalidated" (a stale response
+// the server confirmed is unchanged).
+func (c *Collector) ResponseCache(class, result string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.responses[responseCacheKey{class, result}]++
+}
+
+// Retry records a request against host which is being retried.
+func (c *Collector) Retry(host, class string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.retries[latencyKey{host, class}]++
+}
+
+// RateLimited records a 429 (Too Many Requests) response from host.
+func (c *Collector) RateLimited(host string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.rateLimited[host]++
+}
+
+// Coalesced records a request against host which wasn't made because an
+// identical one was already in flight, and shared its response.
+func (c *Collector) Coalesced(host, class string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init()
+	c.coalesced[latencyKey{host, class}]++
+}
+
+// ServeHTTP writes every recorded metric in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	c.WriteTo(w)
+}
+
+// WriteTo writes every recorded metric in the Prometheus text format to w.
+// Series are sorted by their labels so output is stable.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	if c != nil {
+		c.mu.Lock()
+		c.init()
+		c.write(cw)
+		c.mu.Unlock()
+	}
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, cw.w.Flush()
+}
+
+func (c *Collector) write(w *countingWriter) {
+	// rdap_requests_total
+	w.printf("# HELP rdap_requests_total HTTP requests made, by host, object class and status code.\n")
+	w.printf("# TYPE rdap_requests_total counter\n")
+	reqs := make([]requestKey, 0, len(c.requests))
+	for k := range c.requests {
+		reqs = append(reqs, k)
+	}
+	sort.Slice(reqs, func(i, j int) bool {
+		if reqs[i].host != reqs[j].host {
+			return reqs[i].host < reqs[j].host
+		}
+		if reqs[i].class != reqs[j].class {
+			return reqs[i].class < reqs[j].class
+		}
+		return reqs[i].code < reqs[j].code
+	})
+	for _, k := range reqs {
+		w.printf("rdap_requests_total{host=%s,class=%s,code=%s} %d\n", quote(k.host), quote(k.class), quote(k.code), c.requests[k])
+	}
+
+	// rdap_request_duration_seconds
+	w.printf("# HELP rdap_request_duration_seconds HTTP request latency, by host and object class.\n")
+	w.printf("# TYPE rdap_request_duration_seconds histogram\n")
+	lats := make([]latencyKey, 0, len(c.latencies))
+	for k := range c.latencies {
+		lats = append(lats, k)
+	}
+	sortLatencyKeys(lats)
+	for _, k := range lats {
+		h := c.latencies[k]
+		labels := fmt.Sprintf("host=%s,class=%s", quote(k.host), quote(k.class))
+		var cumulative uint64
+		for i := range c.Buckets {
+			cumulative += h.counts[i]
+			w.printf("rdap_request_duration_seconds_bucket{%s,le=%s} %d\n", labels, quote(formatFloat(c.Buckets[i])), cumulative)
+		}
+		w.printf("rdap_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		w.printf("rdap_request_duration_seconds_sum{%s} %s\n", labels, formatFloat(h.sum))
+		w.printf("rdap_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	// rdap_bootstrap_cache_total
+	w.printf("# HELP rdap_bootstrap_cache_total Bootstrap registry lookups, by registry and cache result.\n")
+	w.printf("# TYPE rdap_bootstrap_cache_total counter\n")
+	caches := make([]cacheKey, 0, len(c.cache))
+	for k := range c.cache {
+		caches = append(caches, k)
+	}
+	sort.Slice(caches, func(i, j int) bool {
+		if caches[i].registry != caches[j].registry {
+			return caches[i].registry < caches[j].registry
+		}
+		return caches[i].result < caches[j].result
+	})
+	for _, k := range caches {
+		w.printf("rdap_bootstrap_cache_total{registry=%s,result=%s} %d\n", quote(k.registry), quote(k.result), c.cache[k])
+	}
+
+	// rdap_response_cache_total
+	w.printf("# HELP rdap_response_cache_total RDAP response cache lookups, by object class and cache result.\n")
+	w.printf("# TYPE rdap_response_cache_total counter\n")
+	responses := make([]responseCacheKey, 0, len(c.responses))
+	for k := range c.responses {
+		responses = append(responses, k)
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		if responses[i].class != responses[j].class {
+			return responses[i].class < responses[j].class
+		}
+		return responses[i].result < responses[j].result
+	})
+	for _, k := range responses {
+		w.printf("rdap_response_cache_total{class=%s,result=%s} %d\n", quote(k.class), quote(k.result), c.responses[k])
+	}
+
+	// rdap_retries_total
+	w.printf("# HELP rdap_retries_total Requests retried, by host and object class.\n")
+	w.printf("# TYPE rdap_retries_total counter\n")
+	retries := make([]latencyKey, 0, len(c.retries))
+	for k := range c.retries {
+		retries = append(retries, k)
+	}
+	sortLatencyKeys(retries)
+	for _, k := range retries {
+		w.printf("rdap_retries_total{host=%s,class=%s} %d\n", quote(k.host), quote(k.class), c.retries[k])
+	}
+
+	// rdap_rate_limited_total
+	w.printf("# HELP rdap_rate_limited_total Responses with HTTP 429 (Too Many Requests), by host.\n")
+	w.printf("# TYPE rdap_rate_limited_total counter\n")
+	hosts := make([]string, 0, len(c.rateLimited))
+	for k := range c.rateLimited {
+		hosts = append(hosts, k)
+	}
+	sort.Strings(hosts)
+	for _, h := range hosts {
+		w.printf("rdap_rate_limited_total{host=%s} %d\n", quote(h), c.rateLimited[h])
+	}
+
+	// rdap_coalesced_total
+	w.printf("# HELP rdap_coalesced_total Requests which shared the response of an identical in-flight request, by host and object class.\n")
+	w.printf("# TYPE rdap_coalesced_total counter\n")
+	coalesced := make([]latencyKey, 0, len(c.coalesced))
+	for k := range c.coalesced {
+		coalesced = append(coalesced, k)
+	}
+	sortLatencyKeys(coalesced)
+	for _, k := range coalesced {
+		w.printf("rdap_coalesced_total{host=%s,class=%s} %d\n", quote(k.host), quote(k.class), c.coalesced[k])
+	}
+}
+
+func sortLatencyKeys(keys []latencyKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].host != keys[j].host {
+			return keys[i].host < keys[j].host
+		}
+		return keys[i].class < keys[j].class
+	})
+}
+
+// quote escapes a label value per the Prometheus text format.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(w.w, format, args...)
+	w.n += int64(n)
+	w.err = err
+}
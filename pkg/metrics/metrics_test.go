@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCollector__nil(t *testing.T) {
+	var c *Collector
+	c.ObserveRequest("example.com", "domain", 200, time.Second)
+	c.CacheHit("dns")
+	c.Retry("example.com", "domain")
+	c.RateLimited("example.com")
+	c.Coalesced("example.com", "domain")
+	c.ResponseCache("domain", "hit")
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("got %q", buf.String())
+	}
+}
+
+func TestCollector__prometheus(t *testing.T) {
+	c := New()
+	c.Buckets = []float64{0.1, 1}
+	c.ObserveRequest("rdap.example.com", "domain", 200, 50*time.Millisecond)
+	c.ObserveRequest("rdap.example.com", "domain", 200, 500*time.Millisecond)
+	c.ObserveRequest("rdap.example.com", "domain", 0, 2*time.Second)
+	c.CacheHit("dns")
+	c.CacheHit("dns")
+	c.CacheMiss("dns")
+	c.Retry("rdap.example.com", "domain")
+	c.RateLimited(`we"ird`)
+	c.Coalesced("rdap.example.com", "domain")
+	c.Coalesced("rdap.example.com", "domain")
+	c.ResponseCache("domain", "hit")
+	c.ResponseCache("domain", "revalidated")
+
+	w := httptest.NewRecorder()
+	c.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if v := w.Header().Get("Content-Type"); !strings.HasPrefix(v, "text/plain") {
+		t.Errorf("Content-Type: %q", v)
+	}
+
+	body := w.Body.String()
+	expected := []string{
+		`rdap_requests_total{host="rdap.example.com",class="domain",code="200"} 2`,
+		`rdap_requests_total{host="rdap.example.com",class="domain",code="error"} 1`,
+		`rdap_request_duration_seconds_bucket{host="rdap.example.com",class="domain",le="0.1"} 1`,
+		`rdap_request_duration_seconds_bucket{host="rdap.example.com",class="domain",le="1"} 2`,
+		`rdap_request_duration_seconds_bucket{host="rdap.example.com",class="domain",le="+Inf"} 3`,
+		`rdap_request_duration_seconds_sum{host="rdap.example.com",class="domain"} 2.55`,
+		`rdap_request_duration_seconds_count{host="rdap.example.com",class="domain"} 3`,
+		`rdap_bootstrap_cache_total{registry="dns",result="hit"} 2`,
+		`rdap_bootstrap_cache_total{registry="dns",result="miss"} 1`,
+		`rdap_retries_total{host="rdap.example.com",class="domain"} 1`,
+		`rdap_rate_limited_total{host="we\"ird"} 1`,
+		`rdap_coalesced_total{host="rdap.example.com",class="domain"} 2`,
+		`rdap_response_cache_total{class="domain",result="hit"} 1`,
+		`rdap_response_cache_total{class="domain",result="revalidated"} 1`,
+		`# TYPE rdap_request_duration_seconds histogram`,
+	}
+	for i := range expected {
+		if !strings.Contains(body, expected[i]+"\n") {
+			t.Errorf("missing %q in:\n%s", expected[i], body)
+		}
+	}
+}
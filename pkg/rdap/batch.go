@@ -0,0 +1,545 @@
+package rdap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/adamdecaf/rdap/pkg/idna"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+var (
+	// DefaultBatchConcurrency is how many requests a Batch makes at once
+	// when its Concurrency isn't set.
+	DefaultBatchConcurrency = 16
+
+	// DefaultBatchPerServer is how many requests a Batch makes at once to
+	// any one server when its PerServer isn't set.
+	DefaultBatchPerServer = 4
+
+	// DefaultBatchBacklog is how many queries a Batch holds beyond its
+	// servers' queues when its Backlog isn't set.
+	DefaultBatchBacklog = 1024
+)
+
+// QueryType is the kind of lookup a Query makes.
+type QueryType string
+
+const (
+	QueryDomain     QueryType = "domain"
+	QueryNameserver QueryType = "nameserver"
+	QueryIP         QueryType = "ip"
+)
+
+// Query is a single lookup in a Batch.
+type Query struct {
+	Type  QueryType
+	Value string
+}
+
+func (q Query) String() string {
+	return fmt.Sprintf("%s %s", q.Type, q.Value)
+}
+
+// Result is the outcome of a Query in a Batch.
+type Result struct {
+	Query Query
+
+	// Server is the RDAP server the query was sent to, empty if it
+	// couldn't be bootstrapped.
+	Server string
+
+	// Object is a *Domain, *Nameserver or *IPNetwork depending on the
+	// query type, and nil when Err is set.
+	Object Object
+	Err    error
+
+	// Deduped is true when the query shared the response of an identical
+	// query which was already in flight.
+	Deduped bool
+}
+
+// Progress counts the queries a Batch has seen.
+type Progress struct {
+	// Queued is every query read from the input.
+	Queued int64
+
+	// Running is how many requests are in flight.
+	Running int64
+
+	// Done is every result sent, including failures.
+	Done   int64
+	Failed int64
+
+	// Deduped is how many queries shared an in-flight request.
+	Deduped int64
+}
+
+// Batch looks up a stream of queries, each against its authoritative
+// server.
+//
+// Queries are bootstrapped and queued by server. Requests are then made
+// by Concurrency workers which take from each server in turn, so a large
+// run of queries for one server doesn't hold up the rest. No more than
+// PerServer requests are made to a server at once, and no faster than
+// PerServerRate.
+//
+// A Batch must not be run more than once at a time.
+type Batch struct {
+	// Client holds the settings (Underlying, MaxRetries, Metrics, etc)
+	// used for each server. BaseAddress is used when there's no Bootstrap
+	// registry. A nil Client uses the defaults.
+	Client *Client
+
+	// Bootstrap finds the server for each query. When nil every query is
+	// sent to the Client's BaseAddress. Every query is bootstrapped, so its
+	// cache can't be disabled (with a negative CacheTTL) or each would
+	// fetch a bootstrap file again.
+	Bootstrap *bootstrap.Registry
+
+	// Concurrency is the most requests made at once, and PerServer the
+	// most made to any one server.
+	Concurrency int
+	PerServer   int
+
+	// PerServerRate, if positive, limits requests per second to each
+	// server.
+	PerServerRate float64
+
+	// Backlog is the most queries held for servers whose queue is full,
+	// so queries for other servers can still be read. Reading stops while
+	// the backlog is full.
+	Backlog int
+
+	progress Progress
+}
+
+// Progress returns counts for the current or last run.
+func (b *Batch) Progress() Progress {
+	return Progress{
+		Queued:  atomic.LoadInt64(&b.progress.Queued),
+		Running: atomic.LoadInt64(&b.progress.Running),
+		Done:    atomic.LoadInt64(&b.progress.Done),
+		Failed:  atomic.LoadInt64(&b.progress.Failed),
+		Deduped: atomic.LoadInt64(&b.progress.Deduped),
+	}
+}
+
+// Run reads queries until the channel is closed and sends a Result for
+// each one on the returned channel, which is closed once every query is
+// done. Results aren't in the order queries were read.
+//
+// Cancelling ctx stops the batch: in-flight requests are aborted, queued
+// and unread queries are dropped and the result channel is closed.
+func (b *Batch) Run(ctx context.Context, queries <-chan Query) <-chan Result {
+	atomic.StoreInt64(&b.progress.Queued, 0)
+	atomic.StoreInt64(&b.progress.Running, 0)
+	atomic.StoreInt64(&b.progress.Done, 0)
+	atomic.StoreInt64(&b.progress.Failed, 0)
+	atomic.StoreInt64(&b.progress.Deduped, 0)
+	r := &batchRun{
+		batch:    b,
+		ctx:      ctx,
+		results:  make(chan Result, b.concurrency()),
+		servers:  make(map[string]*serverQueue),
+		inflight: make(map[string]*batchCall),
+		clients:  make(map[string]*Client),
+		reading:  true,
+	}
+	r.cond = sync.NewCond(&r.mu)
+
+	finished := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			r.mu.Lock()
+			r.cond.Broadcast()
+			r.mu.Unlock()
+		case <-finished:
+		}
+	}()
+
+	var workers sync.WaitGroup
+	for i := 0; i < b.concurrency(); i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			r.work()
+		}()
+	}
+	go func() {
+		r.read(queries)
+		workers.Wait()
+		r.mu.Lock()
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+		r.mu.Unlock()
+		close(finished)
+		close(r.results)
+	}()
+	return r.results
+}
+
+func (b *Batch) concurrency() int {
+	if b.Concurrency > 0 {
+		return b.Concurrency
+	}
+	return DefaultBatchConcurrency
+}
+
+func (b *Batch) perServer() int {
+	if b.PerServer > 0 {
+		return b.PerServer
+	}
+	return DefaultBatchPerServer
+}
+
+func (b *Batch) backlog() int {
+	if b.Backlog > 0 {
+		return b.Backlog
+	}
+	return DefaultBatchBacklog
+}
+
+// queueSize is how many calls each server holds before the rest go to the
+// backlog.
+func (b *Batch) queueSize() int {
+	return 4 * b.perServer()
+}
+
+// batchRun is the state of one Batch.Run.
+type batchRun struct {
+	batch   *Batch
+	ctx     context.Context
+	results chan Result
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	servers  map[string]*serverQueue
+	order    []*serverQueue // round robin order
+	next     int
+	queued   int
+	backlog  int // calls queued beyond their server's queueSize
+	reading  bool
+	inflight map[string]*batchCall
+	clients  map[string]*Client
+
+	// timer wakes workers waiting on PerServerRate at wakeAt. There's only
+	// one, set for the earliest time any worker is waiting for.
+	timer  *time.Timer
+	wakeAt time.Time
+}
+
+// serverQueue holds the calls waiting for one server.
+type serverQueue struct {
+	server  string
+	calls   []*batchCall
+	running int
+	// nextStart is the earliest the next request may start, to honor
+	// PerServerRate.
+	nextStart time.Time
+}
+
+// batchCall is a request shared by identical queries.
+type batchCall struct {
+	key     string
+	typ     QueryType
+	value   string // normalized query value
+	server  string
+	queries []Query // the first made the call, the rest are deduped
+}
+
+// read bootstraps and queues every query. It only waits when a query's
+// server has a full queue and the backlog is full too, so a slow or rate
+// limited server doesn't hold up queries for the others.
+func (r *batchRun) read(queries <-chan Query) {
+	defer func() {
+		r.mu.Lock()
+		r.reading = false
+		r.cond.Broadcast()
+		r.mu.Unlock()
+	}()
+
+	queueSize, backlog := r.batch.queueSize(), r.batch.backlog()
+	for {
+		var q Query
+		var ok bool
+		select {
+		case q, ok = <-queries:
+		case <-r.ctx.Done():
+			return
+		}
+		if !ok {
+			return
+		}
+		atomic.AddInt64(&r.batch.progress.Queued, 1)
+
+		value, err := normalizeQuery(q)
+		if err != nil {
+			r.send(Result{Query: q, Err: err})
+			continue
+		}
+		key := string(q.Type) + " " + value
+
+		r.mu.Lock()
+		if call, ok := r.inflight[key]; ok {
+			call.queries = append(call.queries, q)
+			r.mu.Unlock()
+			continue
+		}
+		r.mu.Unlock()
+
+		server, err := r.server(q.Type, value)
+		if err != nil {
+			r.send(Result{Query: q, Err: err})
+			continue
+		}
+
+		r.mu.Lock()
+		sq, ok := r.servers[server]
+		if !ok {
+			sq = &serverQueue{server: server}
+			r.servers[server] = sq
+			r.order = append(r.order, sq)
+		}
+		for len(sq.calls) >= queueSize && r.backlog >= backlog && r.ctx.Err() == nil {
+			r.cond.Wait()
+		}
+		call := &batchCall{key: key, typ: q.Type, value: value, server: server, queries: []Query{q}}
+		r.inflight[key] = call
+		if len(sq.calls) >= queueSize {
+			r.backlog++
+		}
+		sq.calls = append(sq.calls, call)
+		r.queued++
+		r.cond.Broadcast()
+		r.mu.Unlock()
+	}
+}
+
+// normalizeQuery returns the form of q's value requests are made with, so
+// equivalent queries are deduped.
+func normalizeQuery(q Query) (string, error) {
+	switch q.Type {
+	case QueryDomain, QueryNameserver:
+		return idna.ToASCII(q.Value)
+	case QueryIP:
+		p, err := parsePrefix(q.Value)
+		if err != nil {
+			return "", err
+		}
+		if ones, bits := p.Mask.Size(); ones == bits {
+			return p.IP.String(), nil
+		}
+		return p.String(), nil
+	}
+	return "", fmt.Errorf("unknown query type %q", q.Type)
+}
+
+// server returns the RDAP server for a normalized query value.
+func (r *batchRun) server(typ QueryType, value string) (string, error) {
+	boot := r.batch.Bootstrap
+	if boot == nil {
+		if c := r.batch.Client; c != nil && c.BaseAddress != "" {
+			return strings.TrimSuffix(c.BaseAddress, "/"), nil
+		}
+		return DefaultServer, nil
+	}
+	if boot.CacheTTL < 0 {
+		return "", errors.New("bootstrap registry has caching disabled, which a Batch needs")
+	}
+
+	var server string
+	var err error
+	switch typ {
+	case QueryDomain, QueryNameserver:
+		server, err = boot.ForDomain(value)
+	case QueryIP:
+		server, err = boot.ForIPNetwork(value)
+	}
+	if err != nil {
+		return "", fmt.Errorf("bootstrapping %s: %v", value, err)
+	}
+	if server == "" {
+		return "", fmt.Errorf("no RDAP server found for %s", value)
+	}
+	return strings.TrimSuffix(server, "/"), nil
+}
+
+// work makes requests until every query is done or the run is cancelled.
+func (r *batchRun) work() {
+	for {
+		call, sq := r.take()
+		if call == nil {
+			return
+		}
+		atomic.AddInt64(&r.batch.progress.Running, 1)
+		obj, err := r.lookup(call)
+		atomic.AddInt64(&r.batch.progress.Running, -1)
+
+		r.mu.Lock()
+		sq.running--
+		delete(r.inflight, call.key)
+		queries := call.queries
+		r.cond.Broadcast()
+		r.mu.Unlock()
+
+		if r.ctx.Err() != nil {
+			return
+		}
+		for i, q := range queries {
+			if i > 0 {
+				atomic.AddInt64(&r.batch.progress.Deduped, 1)
+			}
+			if !r.send(Result{Query: q, Server: call.server, Object: obj, Err: err, Deduped: i > 0}) {
+				return
+			}
+		}
+	}
+}
+
+// take waits for the next call a worker may make, going round robin
+// through the servers. nil is returned once the batch is finished or
+// cancelled.
+func (r *batchRun) take() (*batchCall, *serverQueue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interval := time.Duration(0)
+	if rate := r.batch.PerServerRate; rate > 0 {
+		interval = time.Duration(float64(time.Second) / rate)
+	}
+	for {
+		if r.ctx.Err() != nil {
+			return nil, nil
+		}
+		if r.queued == 0 && !r.reading {
+			return nil, nil
+		}
+
+		now := time.Now()
+		var wake time.Time
+		for i := 0; i < len(r.order); i++ {
+			sq := r.order[(r.next+i)%len(r.order)]
+			if len(sq.calls) == 0 || sq.running >= r.batch.perServer() {
+				continue
+			}
+			if now.Before(sq.nextStart) {
+				if wake.IsZero() || sq.nextStart.Before(wake) {
+					wake = sq.nextStart
+				}
+				continue
+			}
+			r.next = (r.next + i + 1) % len(r.order)
+
+			if len(sq.calls) > r.batch.queueSize() {
+				r.backlog--
+			}
+			call := sq.calls[0]
+			sq.calls[0] = nil
+			sq.calls = sq.calls[1:]
+			sq.running++
+			sq.nextStart = now.Add(interval)
+			r.queued--
+			r.cond.Broadcast() // wake the reader if it's waiting on space
+			return call, sq
+		}
+		if !wake.IsZero() {
+			r.wake(wake)
+		}
+		r.cond.Wait()
+	}
+}
+
+// wake makes sure waiting workers are woken at t, moving the timer when t
+// is earlier than it's set for. r.mu must be held.
+func (r *batchRun) wake(t time.Time) {
+	if !r.wakeAt.IsZero() && !t.Before(r.wakeAt) {
+		return
+	}
+	r.wakeAt = t
+	if r.timer == nil {
+		r.timer = time.AfterFunc(time.Until(t), func() {
+			r.mu.Lock()
+			r.wakeAt = time.Time{}
+			r.cond.Broadcast()
+			r.mu.Unlock()
+		})
+		return
+	}
+	r.timer.Stop()
+	r.timer.Reset(time.Until(t))
+}
+
+// lookup makes the request for call.
+func (r *batchRun) lookup(call *batchCall) (Object, error) {
+	c := r.client(call.server)
+	switch call.typ {
+	case QueryDomain:
+		return objectOrNil(c.Domain(call.value))
+	case QueryNameserver:
+		return objectOrNil(c.Nameserver(call.value))
+	case QueryIP:
+		return objectOrNil(c.IP(call.value))
+	}
+	return nil, fmt.Errorf("unknown query type %q", call.typ)
+}
+
+// objectOrNil drops the typed nil pointers lookups return on failure.
+func objectOrNil(obj interface{}, err error) (Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch v := obj.(type) {
+	case *Domain:
+		return v, nil
+	case *Nameserver:
+		return v, nil
+	case *IPNetwork:
+		return v, nil
+	}
+	return nil, fmt.Errorf("unexpected response type %T", obj)
+}
+
+// client returns the Client used for server, copying the Batch's Client
+// settings.
+func (r *batchRun) client(server string) *Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c, ok := r.clients[server]; ok {
+		return c
+	}
+	c := &Client{BaseAddress: server, ctx: r.ctx}
+	if tmpl := r.batch.Client; tmpl != nil {
+		c.Underlying = tmpl.Underlying
+		c.Debug = tmpl.Debug
+		c.MaxRetries = tmpl.MaxRetries
+		c.Metrics = tmpl.Metrics
+		c.Tracer = tmpl.Tracer
+		c.Cache = tmpl.Cache
+		c.CachePolicy = tmpl.CachePolicy
+	}
+	r.clients[server] = c
+	return c
+}
+
+// send delivers a result, returning false if the run was cancelled first.
+func (r *batchRun) send(res Result) bool {
+	select {
+	case r.results <- res:
+		atomic.AddInt64(&r.batch.progress.Done, 1)
+		if res.Err != nil {
+			atomic.AddInt64(&r.batch.progress.Failed, 1)
+		}
+		return true
+	case <-r.ctx.Done():
+		return false
+	}
+}
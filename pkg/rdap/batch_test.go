@@ -0,0 +1,320 @@
+package rdap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+// batchServer answers every domain and ip query, tracking how many
+// requests are in flight at once.
+type batchServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	paths    []string
+	running  int
+	maxSeen  int
+	delay    time.Duration
+	blocking bool
+}
+
+func newBatchServer(delay time.Duration) *batchServer {
+	s := &batchServer{delay: delay}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.paths = append(s.paths, r.URL.Path)
+		s.running++
+		if s.running > s.maxSeen {
+			s.maxSeen = s.running
+		}
+		blocking := s.blocking
+		s.mu.Unlock()
+		defer func() {
+			s.mu.Lock()
+			s.running--
+			s.mu.Unlock()
+		}()
+
+		if blocking {
+			<-r.Context().Done()
+			return
+		}
+		time.Sleep(s.delay)
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/domain/missing"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasPrefix(r.URL.Path, "/domain/"):
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"` + strings.TrimPrefix(r.URL.Path, "/domain/") + `"}`))
+		case strings.HasPrefix(r.URL.Path, "/ip/"):
+			w.Write([]byte(`{"objectClassName":"ip network","startAddress":"192.0.2.0","endAddress":"192.0.2.255"}`))
+		}
+	}))
+	return s
+}
+
+func runBatch(b *Batch, ctx context.Context, queries ...Query) []Result {
+	in := make(chan Query)
+	go func() {
+		defer close(in)
+		for _, q := range queries {
+			select {
+			case in <- q:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var out []Result
+	for res := range b.Run(ctx, in) {
+		out = append(out, res)
+	}
+	return out
+}
+
+func TestBatch(t *testing.T) {
+	registry := newBatchServer(20 * time.Millisecond)
+	defer registry.Close()
+	rir := newBatchServer(20 * time.Millisecond)
+	defer rir.Close()
+
+	boot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/dns" {
+			w.Write([]byte(`{"version":"1.0","services":[[["com"],["` + registry.URL + `/"]]]}`))
+			return
+		}
+		w.Write([]byte(`{"version":"1.0","services":[[["192.0.0.0/8"],["` + rir.URL + `/"]]]}`))
+	}))
+	defer boot.Close()
+
+	b := &Batch{
+		Client: &Client{Underlying: http.DefaultClient},
+		Bootstrap: &bootstrap.Registry{
+			DNSEndpoint:  boot.URL + "/dns",
+			IPv4Endpoint: boot.URL + "/ipv4",
+		},
+		Concurrency: 4,
+		PerServer:   2,
+	}
+	var queries []Query
+	for _, name := range []string{"a.com", "b.com", "c.com", "d.com", "e.com", "f.com", "A.COM", "missing.com", "example.org", "-bad.com"} {
+		queries = append(queries, Query{QueryDomain, name})
+	}
+	for _, ip := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.0/24", "192.0.2.1", "10.0.0.1"} {
+		queries = append(queries, Query{QueryIP, ip})
+	}
+	results := runBatch(b, context.Background(), queries...)
+	if len(results) != len(queries) {
+		t.Fatalf("got %d results for %d queries", len(results), len(queries))
+	}
+
+	byQuery := make(map[Query][]Result)
+	for _, res := range results {
+		byQuery[res.Query] = append(byQuery[res.Query], res)
+	}
+	for _, q := range []Query{{QueryDomain, "a.com"}, {QueryDomain, "A.COM"}, {QueryIP, "192.0.2.0/24"}} {
+		res := byQuery[q]
+		if len(res) != 1 || res[0].Err != nil || res[0].Object == nil {
+			t.Errorf("%v: got %v", q, res)
+		}
+	}
+	if d, ok := byQuery[Query{QueryDomain, "a.com"}][0].Object.(*Domain); !ok || d.LDHName != "a.com" {
+		t.Errorf("got %#v", byQuery[Query{QueryDomain, "a.com"}][0].Object)
+	}
+	for _, q := range []Query{{QueryDomain, "missing.com"}, {QueryDomain, "example.org"}, {QueryDomain, "-bad.com"}, {QueryIP, "10.0.0.1"}} {
+		res := byQuery[q]
+		if len(res) != 1 || res[0].Err == nil {
+			t.Errorf("%v: expected error, got %v", q, res)
+		}
+	}
+	if res := byQuery[Query{QueryDomain, "missing.com"}][0]; !IsNotFound(res.Err) || res.Server != registry.URL {
+		t.Errorf("got %v from %q", res.Err, res.Server)
+	}
+
+	// a.com and A.COM, and both 192.0.2.1 queries, may share a request
+	// if they were in flight together.
+	progress := b.Progress()
+	requests := len(registry.paths) + len(rir.paths)
+	if progress.Queued != int64(len(queries)) || progress.Done != int64(len(queries)) || progress.Failed != 4 || progress.Running != 0 {
+		t.Errorf("got %+v", progress)
+	}
+	if int64(requests)+progress.Deduped != 12 {
+		t.Errorf("made %d requests, %d deduped", requests, progress.Deduped)
+	}
+	if registry.maxSeen > 2 || rir.maxSeen > 2 {
+		t.Errorf("per server concurrency exceeded: %d, %d", registry.maxSeen, rir.maxSeen)
+	}
+}
+
+func TestBatch__dedupe(t *testing.T) {
+	svc := newBatchServer(50 * time.Millisecond)
+	defer svc.Close()
+
+	b := &Batch{
+		Client: &Client{Underlying: svc.Client(), BaseAddress: svc.URL},
+	}
+	results := runBatch(b, context.Background(),
+		Query{QueryDomain, "example.com"}, Query{QueryDomain, "EXAMPLE.com."}, Query{QueryDomain, "example.com"})
+	if len(results) != 3 || len(svc.paths) != 1 {
+		t.Fatalf("got %d results from %d requests", len(results), len(svc.paths))
+	}
+	var deduped int
+	for _, res := range results {
+		if res.Err != nil || res.Object == nil {
+			t.Errorf("%v: %v", res.Query, res.Err)
+		}
+		if res.Deduped {
+			deduped++
+		}
+	}
+	if deduped != 2 || b.Progress().Deduped != 2 {
+		t.Errorf("got %d deduped", deduped)
+	}
+}
+
+func TestBatch__rate(t *testing.T) {
+	svc := newBatchServer(0)
+	defer svc.Close()
+
+	b := &Batch{
+		Client:        &Client{Underlying: svc.Client(), BaseAddress: svc.URL},
+		PerServerRate: 20,
+	}
+	start := time.Now()
+	results := runBatch(b, context.Background(),
+		Query{QueryDomain, "a.com"}, Query{QueryDomain, "b.com"}, Query{QueryDomain, "c.com"},
+		Query{QueryDomain, "d.com"}, Query{QueryDomain, "e.com"})
+	if len(results) != 5 {
+		t.Fatalf("got %d results", len(results))
+	}
+	if took := time.Since(start); took < 180*time.Millisecond {
+		t.Errorf("5 requests at 20/s took %v", took)
+	}
+}
+
+func TestBatch__fairness(t *testing.T) {
+	busy := newBatchServer(0)
+	defer busy.Close()
+	idle := newBatchServer(0)
+	defer idle.Close()
+
+	boot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"1.0","services":[[["com"],["` + busy.URL + `/"]],[["org"],["` + idle.URL + `/"]]]}`))
+	}))
+	defer boot.Close()
+
+	b := &Batch{
+		Client:        &Client{Underlying: http.DefaultClient},
+		Bootstrap:     &bootstrap.Registry{DNSEndpoint: boot.URL},
+		Concurrency:   2,
+		PerServerRate: 5,
+	}
+	// The rate limited server takes 4s to answer its queries, which mustn't
+	// hold up the query for the other server behind them.
+	var queries []Query
+	for i := 0; i < 20; i++ {
+		queries = append(queries, Query{QueryDomain, fmt.Sprintf("%d.com", i)})
+	}
+	queries = append(queries, Query{QueryDomain, "example.org"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan Query, len(queries))
+	for _, q := range queries {
+		in <- q
+	}
+	close(in)
+	start := time.Now()
+	for res := range b.Run(ctx, in) {
+		if res.Query.Value != "example.org" {
+			continue
+		}
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if took := time.Since(start); took > time.Second {
+			t.Errorf("idle server's query took %v", took)
+		}
+		cancel()
+	}
+}
+
+func TestBatch__cancel(t *testing.T) {
+	svc := newBatchServer(0)
+	svc.blocking = true
+	defer svc.Close()
+
+	b := &Batch{
+		Client: &Client{Underlying: svc.Client(), BaseAddress: svc.URL},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Query) // never closed
+	results := b.Run(ctx, in)
+	in <- Query{QueryDomain, "example.com"}
+	in <- Query{QueryDomain, "example.net"}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+	done := make(chan struct{})
+	go func() {
+		for range results {
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch didn't stop after cancel")
+	}
+}
+
+func TestBatch__bootstrapCache(t *testing.T) {
+	svc := newBatchServer(0)
+	defer svc.Close()
+
+	var fetches int64
+	boot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&fetches, 1)
+		w.Write([]byte(`{"version":"1.0","services":[[["com"],["` + svc.URL + `/"]]]}`))
+	}))
+	defer boot.Close()
+
+	var queries []Query
+	for i := 0; i < 100; i++ {
+		queries = append(queries, Query{QueryDomain, fmt.Sprintf("example%d.com", i)})
+	}
+
+	// The default CacheTTL fetches dns.json once for every query
+	b := &Batch{
+		Client:    &Client{Underlying: svc.Client()},
+		Bootstrap: &bootstrap.Registry{DNSEndpoint: boot.URL, Underlying: boot.Client()},
+	}
+	for _, res := range runBatch(b, context.Background(), queries...) {
+		if res.Err != nil {
+			t.Errorf("%v: %v", res.Query, res.Err)
+		}
+	}
+	if n := atomic.LoadInt64(&fetches); n != 1 {
+		t.Errorf("fetched bootstrap file %d times", n)
+	}
+
+	// A Batch won't run without the cache
+	atomic.StoreInt64(&fetches, 0)
+	b.Bootstrap = &bootstrap.Registry{DNSEndpoint: boot.URL, Underlying: boot.Client(), CacheTTL: -1}
+	results := runBatch(b, context.Background(), queries[:3]...)
+	for _, res := range results {
+		if res.Err == nil || !strings.Contains(res.Err.Error(), "caching disabled") {
+			t.Errorf("%v: got %v", res.Query, res.Err)
+		}
+	}
+	if n := atomic.LoadInt64(&fetches); len(results) != 3 || n != 0 || len(svc.paths) != 100 {
+		t.Errorf("got %d results, %d bootstrap fetches and %d requests", len(results), n, len(svc.paths))
+	}
+}
@@ -1,17 +1,227 @@
 package bootstrap
 
 import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/metrics"
 )
 
 func TestBootstrap__domain(t *testing.T) {
+	bs := []byte(`{"version":"1.0","publication":"2018-01-07T10:11:12Z","services":[
+  [["com","net"],["http://rdap.example.com/","https://rdap.example.com/"]],
+  [["example.com"],["https://rdap.example.net/"]],
+  [["xn--p1ai"],["https://rdap.example.ru/"]]
+]}`)
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bs)
+	}))
+	defer svc.Close()
+
+	r := Registry{DNSEndpoint: svc.URL}
+	cases := map[string]string{
+		"google.com":            "https://rdap.example.com/",
+		"GOOGLE.NET.":           "https://rdap.example.com/",
+		"example.com":           "https://rdap.example.net/",
+		"a.b.example.com":       "https://rdap.example.net/",
+		"notexample.com":        "https://rdap.example.com/",
+		"пример.рф":             "https://rdap.example.ru/",
+		"xn--e1afmkfd.xn--p1ai": "https://rdap.example.ru/",
+		"notcom":                "",
+		"example.org":           "",
+	}
+	for domain, expected := range cases {
+		server, err := r.ForDomain(domain)
+		if err != nil {
+			t.Errorf("%s: %v", domain, err)
+			continue
+		}
+		if server != expected {
+			t.Errorf("%s: got %q, expected %q", domain, server, expected)
+		}
+	}
 
+	if _, err := r.ForDomain("-bad-.com"); err == nil {
+		t.Error("expected error")
+	}
 }
 
 func TestBootstrap__ipNetwork(t *testing.T) {
+	files := make(map[string][]byte)
+	for _, name := range []string{"ipv4", "ipv6"} {
+		bs, err := ioutil.ReadFile("../../../testdata/rfc-7484-" + name + "-address.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		files["/"+name] = bs
+	}
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(files[r.URL.Path])
+	}))
+	defer svc.Close()
+
+	r := Registry{
+		IPv4Endpoint: svc.URL + "/ipv4",
+		IPv6Endpoint: svc.URL + "/ipv6",
+	}
+	cases := map[string]string{
+		// RFC7484 Section 5.1 example
+		"192.0.2.1/25":         "http://example.org/",
+		"192.0.2.1":            "http://example.org/",
+		"192.0.3.1":            "https://rir1.example.com/myrdap/",
+		"192.0.0.0/8":          "https://rir1.example.com/myrdap/",
+		"28.3.4.0/24":          "https://example.net/rdaprir2/",
+		"192.0.0.0/7":          "",
+		"10.0.0.1":             "",
+		"2001:0200:1000::/48":  "https://example.net/rdaprir2/",
+		"2001:0200:2000::/48":  "https://rir2.example.com/myrdap/",
+		"2001:db8::1":          "https://rir2.example.com/myrdap/",
+		"2001:0200::/22":       "",
+		"2600:1:2:3::1":        "http://example.org/",
+		"::ffff:192.0.2.1":     "http://example.org/",
+		"2001:0200:1000::/128": "https://example.net/rdaprir2/",
+	}
+	for ip, expected := range cases {
+		server, err := r.ForIPNetwork(ip)
+		if err != nil {
+			t.Errorf("%s: %v", ip, err)
+			continue
+		}
+		if server != expected {
+			t.Errorf("%s: got %q, expected %q", ip, server, expected)
+		}
+	}
 
+	for _, ip := range []string{"", "192.0.2", "example.com", "192.0.2.0/33"} {
+		if _, err := r.ForIPNetwork(ip); err == nil {
+			t.Errorf("%q: expected error", ip)
+		}
+	}
 }
 
 func TestBootstrap__ASNumber(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../../testdata/rfc-7484-asnum.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bs)
+	}))
+	defer svc.Close()
+
+	r := Registry{ASNEndpoint: svc.URL}
+	cases := map[string]string{
+		"2045":    "https://rir3.example.com/myrdap/",
+		"AS10000": "http://example.org/",
+		"as12000": "http://example.org/",
+		"350000":  "http://example.org/",
+		"65000":   "https://example.net/rdaprir2/",
+		"2046":    "",
+		"12001":   "",
+	}
+	for asn, expected := range cases {
+		server, err := r.ForASNumber(asn)
+		if err != nil {
+			t.Errorf("%s: %v", asn, err)
+			continue
+		}
+		if server != expected {
+			t.Errorf("%s: got %q, expected %q", asn, server, expected)
+		}
+	}
+
+	for _, asn := range []string{"", "AS", "-1", "4294967296", "AS 1"} {
+		if _, err := r.ForASNumber(asn); err == nil {
+			t.Errorf("%q: expected error", asn)
+		}
+	}
+}
+
+func TestBootstrap__cache(t *testing.T) {
+	bs := []byte(`{"version":"1.0","publication":"2018-01-07T10:11:12Z","services":[[["com"],["https://rdap.example.com/"]]]}`)
+	var requests int
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(bs)
+	}))
+	defer svc.Close()
+
+	m := metrics.New()
+	r := Registry{
+		DNSEndpoint: svc.URL,
+		Underlying:  svc.Client(),
+		Metrics:     m,
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := r.ForDomain("example.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests", requests)
+	}
+
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+	if !strings.Contains(buf.String(), `rdap_bootstrap_cache_total{registry="dns",result="hit"} 2`) {
+		t.Errorf("missing cache hits:\n%s", buf.String())
+	}
+
+	// Disabled caching fetches every time
+	r = Registry{
+		DNSEndpoint: svc.URL,
+		Underlying:  svc.Client(),
+		CacheTTL:    -1,
+	}
+	requests = 0
+	for i := 0; i < 2; i++ {
+		if _, err := r.ForDomain("example.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests", requests)
+	}
+}
+
+func TestBootstrap__entity(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../../testdata/rfc-8521-object-tags.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests int
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(bs)
+	}))
+	defer svc.Close()
 
+	r := Registry{ObjectTagsEndpoint: svc.URL}
+	cases := map[string]string{
+		"XXXX-YYYY":     "https://example.com/rdap/",
+		"ABC-DEF-yyyy":  "https://example.com/rdap/",
+		"CLIENTID-ZZ54": "http://rdap.example.org/",
+		"12345-1754":    "https://example.net/rdap/",
+		"XXXX-ARIN":     "",
+		"XXXX":          "",
+		"XXXX-":         "",
+		"-YYYY":         "",
+	}
+	for handle, expected := range cases {
+		server, err := r.ForEntity(handle)
+		if err != nil {
+			t.Errorf("%s: %v", handle, err)
+			continue
+		}
+		if server != expected {
+			t.Errorf("%s: got %q, expected %q", handle, server, expected)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests", requests)
+	}
 }
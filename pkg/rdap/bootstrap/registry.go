@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/adamdecaf/rdap/pkg/httputil"
+	"github.com/adamdecaf/rdap/pkg/idna"
+	"github.com/adamdecaf/rdap/pkg/metrics"
+	"github.com/adamdecaf/rdap/pkg/trace"
 )
 
 var (
@@ -21,18 +26,24 @@ var (
 	IPv4Url = "https://data.iana.org/rdap/ipv4.json"
 	IPv6Url = "https://data.iana.org/rdap/ipv6.json"
 
+	// RFC8521 object tags registry, for entity handles
+	ObjectTagsUrl = "https://data.iana.org/rdap/object-tags.json"
+
 	// Setup for the http.Client used
 	DefaultHTTPClient = &http.Client{
 		Transport: httputil.Transport(nil),
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
 	}
+
+	// DefaultCacheTTL is how long a fetched bootstrap file is kept by a
+	// Registry before it's requested again.
+	DefaultCacheTTL = 24 * time.Hour
 )
 
 // Registry is a type which returns the RDAP server for a given
 // domain, ip network or AS number.
 //
-// The default implementation does not perform any caching and instead
-// calls its Grabber on every request.
+// Each bootstrap file is fetched once and cached in memory for CacheTTL.
 type Registry struct {
 	// Endpoints
 	ASNEndpoint  string
@@ -40,12 +51,29 @@ type Registry struct {
 	IPv4Endpoint string
 	IPv6Endpoint string
 
+	ObjectTagsEndpoint string
+
 	// The http.Client used by this registry
 	Underlying *http.Client
 
+	// CacheTTL is how long bootstrap files are cached. Zero uses
+	// DefaultCacheTTL and a negative value disables caching.
+	CacheTTL time.Duration
+
+	// Metrics, if non-nil, records bootstrap fetches and cache usage.
+	Metrics *metrics.Collector
+
+	// Tracer, if non-nil, is given timings for fetching bootstrap files
+	// and for reads served from the cache.
+	Tracer trace.Tracer
+
+	mu    sync.Mutex
+	cache map[string]cachedResponse
+
 	asSetup  sync.Once
 	dnsSetup sync.Once
 	ipSetup  sync.Once
+	tagSetup sync.Once
 	setup    sync.Once
 }
 
@@ -88,39 +116,52 @@ func (r *Registry) ForDomain(domain string) (string, error) {
 	// example.com entries in the registry, then the longest match applies
 	// and the example.com entry is used by the client.
 
-	req, err := http.NewRequest("GET", r.DNSEndpoint, nil)
-	if err != nil {
-		return "", err // TODO(adam)
-	}
-	r.fixReqPath(req, r.DNSEndpoint)
-
-	resp, err := r.do(req)
+	// RFC7484 Section 4
+	// Internationalized Domain Name labels used as entries or keys in
+	// the registry are in A-label form.
+	name, err := idna.ToASCII(domain)
 	if err != nil {
-		return "", err // TODO(adam)
+		return "", err
 	}
 
-	// Parse response
-	response, err := r.readResponse(resp.Body)
+	response, err := r.fetch("dns", r.DNSEndpoint)
 	if err != nil {
 		return "", err // TODO(adam)
 	}
 
-	// naive lookup
+	var urls []string
+	longest := -1
 	for i := range response.Services {
 		svc := response.Services[i]
 		if len(svc) != 2 {
-			panic(svc)
+			return "", fmt.Errorf("invalid bootstrap service: %v", svc)
 		}
-		for j := range svc[0] {
-			// TOOD(adam): actually match accorind to RFC
-			if strings.HasSuffix(domain, svc[0][j]) {
-				// TODO(adam): need to sort by HTTPS
-				return svc[1][0], nil
+		for _, entry := range svc[0] {
+			entry = strings.ToLower(strings.Trim(entry, "."))
+			if name != entry && !strings.HasSuffix(name, "."+entry) {
+				continue
+			}
+			if labels := strings.Count(entry, ".") + 1; labels > longest {
+				longest, urls = labels, svc[1]
 			}
 		}
 	}
+	return preferHTTPS(urls), nil
+}
 
-	return "", nil
+// RFC7484 Section 3
+// If the client finds multiple base URLs for a single service, it
+// SHOULD choose the HTTPS one (if present).
+func preferHTTPS(urls []string) string {
+	for _, u := range urls {
+		if strings.HasPrefix(strings.ToLower(u), "https://") {
+			return u
+		}
+	}
+	if len(urls) > 0 {
+		return urls[0]
+	}
+	return ""
 }
 
 func (r *Registry) ForIPNetwork(ip string) (string, error) {
@@ -131,11 +172,8 @@ func (r *Registry) ForIPNetwork(ip string) (string, error) {
 		if r.IPv6Endpoint == "" {
 			r.IPv6Endpoint = IPv6Url
 		}
-
 	})
 
-	// r.fixReqPath(req, <host>) // TODO
-
 	// RFC7484 Section 5.1
 	// For IP address space, the authoritative registration data service is
 	// found by doing a longest match of the target address with the values
@@ -149,8 +187,61 @@ func (r *Registry) ForIPNetwork(ip string) (string, error) {
 	// For example, a query for "192.0.2.1/25" matches the "192.0.0.0/8"
 	// entry and the "192.0.2.0/24" entry in the example registry above.
 	// The latter is chosen by the client given the longest match.
+	target, err := parseNetwork(ip)
+	if err != nil {
+		return "", err
+	}
+	name, endpoint := "ipv6", r.IPv6Endpoint
+	if target.IP.To4() != nil {
+		name, endpoint = "ipv4", r.IPv4Endpoint
+	}
+	targetBits, _ := target.Mask.Size()
 
-	return "", nil
+	response, err := r.fetch(name, endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	var urls []string
+	longest := -1
+	for i := range response.Services {
+		svc := response.Services[i]
+		if len(svc) != 2 {
+			return "", fmt.Errorf("invalid bootstrap service: %v", svc)
+		}
+		for _, entry := range svc[0] {
+			_, n, err := net.ParseCIDR(strings.TrimSpace(entry))
+			if err != nil {
+				continue
+			}
+			bits, _ := n.Mask.Size()
+			if bits <= targetBits && bits > longest && n.Contains(target.IP) {
+				longest, urls = bits, svc[1]
+			}
+		}
+	}
+	return preferHTTPS(urls), nil
+}
+
+// parseNetwork reads an IP address or CIDR prefix. Addresses are treated
+// as a prefix of their full length.
+func parseNetwork(s string) (*net.IPNet, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip or cidr specified: %q", s)
+		}
+		return n, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid ip or cidr specified: %q", s)
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
 }
 
 func (r *Registry) ForASNumber(asn string) (string, error) {
@@ -164,12 +255,168 @@ func (r *Registry) ForASNumber(asn string) (string, error) {
 	// that represents the range of AS numbers between the two elements of the
 	// array. A single AS number is represented as a range of two identical AS
 	// numbers.
+	target, err := ParseASN(asn)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := r.fetch("asn", r.ASNEndpoint)
+	if err != nil {
+		return "", err
+	}
+	for i := range response.Services {
+		svc := response.Services[i]
+		if len(svc) != 2 {
+			return "", fmt.Errorf("invalid bootstrap service: %v", svc)
+		}
+		for _, entry := range svc[0] {
+			bounds := strings.SplitN(strings.TrimSpace(entry), "-", 2)
+			if len(bounds) == 1 {
+				bounds = append(bounds, bounds[0])
+			}
+			first, err1 := strconv.ParseUint(bounds[0], 10, 32)
+			last, err2 := strconv.ParseUint(bounds[1], 10, 32)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			if uint64(target) >= first && uint64(target) <= last {
+				return preferHTTPS(svc[1]), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// ParseASN reads an AS number in asplain form (RFC5396), with or without
+// an "AS" prefix.
+func ParseASN(asn string) (uint32, error) {
+	s := strings.TrimSpace(asn)
+	if len(s) > 2 && strings.EqualFold(s[:2], "as") {
+		s = s[2:]
+	}
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AS number: %q", asn)
+	}
+	return uint32(n), nil
+}
 
-	// r.fixReqPath(req, r.ASNEndpoint)
+// ForEntity returns the RDAP server for an entity handle carrying an
+// object tag (e.g. "XXXX-ARIN"). An empty string is returned for handles
+// without a tag, or whose tag isn't registered.
+func (r *Registry) ForEntity(handle string) (string, error) {
+	r.tagSetup.Do(func() {
+		if r.ObjectTagsEndpoint == "" {
+			r.ObjectTagsEndpoint = ObjectTagsUrl
+		}
+	})
+
+	// RFC8521 Section 2
+	// ... the object tag is appended to the end of the identifier using
+	// the hyphen character "-" as a separator ... Matching of the tag
+	// string MUST be case insensitive.
+	tag := ObjectTag(handle)
+	if tag == "" {
+		return "", nil
+	}
 
+	response, err := r.fetch("object-tags", r.ObjectTagsEndpoint)
+	if err != nil {
+		return "", err
+	}
+	for i := range response.Services {
+		// RFC8521 Section 3
+		// Each service is an array of three arrays: the registrant's
+		// contact information, the object tags and the base RDAP URLs.
+		svc := response.Services[i]
+		if len(svc) != 3 {
+			return "", fmt.Errorf("invalid bootstrap service: %v", svc)
+		}
+		for _, entry := range svc[1] {
+			if strings.EqualFold(strings.TrimSpace(entry), tag) {
+				return preferHTTPS(svc[2]), nil
+			}
+		}
+	}
 	return "", nil
 }
 
+// ObjectTag returns the RFC8521 object tag of an entity handle, the part
+// after its last hyphen, or an empty string if it doesn't have one.
+func ObjectTag(handle string) string {
+	idx := strings.LastIndex(handle, "-")
+	if idx <= 0 || idx == len(handle)-1 {
+		return ""
+	}
+	return handle[idx+1:]
+}
+
+type cachedResponse struct {
+	response *Response
+	expires  time.Time
+}
+
+// fetch returns the parsed bootstrap file at endpoint, reading from the
+// cache when possible. name identifies the registry (e.g. "dns") in metrics.
+func (r *Registry) fetch(name, endpoint string) (*Response, error) {
+	start := time.Now()
+	ttl := r.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	if ttl > 0 {
+		r.mu.Lock()
+		cached, ok := r.cache[endpoint]
+		r.mu.Unlock()
+		if ok && time.Now().Before(cached.expires) {
+			r.Metrics.CacheHit(name)
+			trace.Emit(r.Tracer, trace.BootstrapCache, endpoint, start, nil)
+			return cached.response, nil
+		}
+	}
+	r.Metrics.CacheMiss(name)
+
+	response, err := r.get(endpoint)
+	trace.Emit(r.Tracer, trace.BootstrapFetch, endpoint, start, err)
+	if err != nil {
+		return nil, err
+	}
+	if ttl > 0 {
+		r.mu.Lock()
+		if r.cache == nil {
+			r.cache = make(map[string]cachedResponse)
+		}
+		r.cache[endpoint] = cachedResponse{
+			response: response,
+			expires:  time.Now().Add(ttl),
+		}
+		r.mu.Unlock()
+	}
+	return response, nil
+}
+
+// get requests and parses the bootstrap file at endpoint.
+func (r *Registry) get(endpoint string) (*Response, error) {
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.fixReqPath(req, endpoint)
+
+	start := time.Now()
+	resp, err := r.do(trace.WithRequest(r.Tracer, req))
+	if err != nil {
+		r.Metrics.ObserveRequest(req.URL.Host, "bootstrap", 0, time.Since(start))
+		return nil, err
+	}
+	r.Metrics.ObserveRequest(req.URL.Host, "bootstrap", resp.StatusCode, time.Since(start))
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%d error fetching bootstrap file %s", resp.StatusCode, req.URL)
+	}
+	return r.readResponse(resp.Body)
+}
+
 func (r *Registry) do(req *http.Request) (*http.Response, error) {
 	r.setup.Do(func() {
 		if r.Underlying == nil {
@@ -183,7 +430,7 @@ func (r *Registry) do(req *http.Request) (*http.Response, error) {
 	}
 
 	// Perform the request
-	return r.Underlying.Do(req)
+	return trace.FollowRedirects(r.Tracer, r.Underlying).Do(trace.MarkStart(req))
 }
 
 func (r *Registry) readResponse(rdr io.ReadCloser) (*Response, error) {
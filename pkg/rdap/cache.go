@@ -0,0 +1,362 @@
+package rdap
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// DefaultResponseTTL is how long a response is cached when the server
+	// doesn't say and the CachePolicy doesn't set a TTL. Registration data
+	// changes slowly, so this errs on the long side.
+	DefaultResponseTTL = time.Hour
+
+	// DefaultNegativeTTL is how long a 404 (Not Found) response is cached
+	// when the CachePolicy doesn't set a NegativeTTL.
+	DefaultNegativeTTL = 5 * time.Minute
+)
+
+// Cache stores RDAP responses for a Client. Keys are opaque strings built
+// from the request URL and credentials.
+//
+// Implementations must be safe for concurrent use. Caching is best-effort,
+// so implementations should treat a failed write as a no-op and a failed
+// read as a miss.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+	Delete(key string)
+}
+
+// CacheEntry is a cached response.
+type CacheEntry struct {
+	// Status is 200 for a successful response, or 404 for a negatively
+	// cached one whose Body is the RDAP error (if any).
+	Status int    `json:"status"`
+	Body   []byte `json:"body"`
+
+	// ETag and LastModified are the response's validators, used to
+	// revalidate the entry once it's stale.
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+
+	// Expires is when the entry becomes stale.
+	Expires time.Time `json:"expires"`
+}
+
+// Fresh returns true if the entry can be used without asking the server.
+func (e CacheEntry) Fresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+// CachePolicy sets how long a Client caches responses. Cache-Control
+// (max-age, no-cache and no-store) and Expires headers are honored unless
+// overridden by ClassTTL.
+type CachePolicy struct {
+	// TTL is used when the server doesn't send caching headers. Zero uses
+	// DefaultResponseTTL.
+	TTL time.Duration
+
+	// ClassTTL sets the TTL by object class (e.g. "domain" or "ip network"),
+	// taking precedence over the server's headers. A negative value
+	// disables caching for that class. no-store is always honored.
+	ClassTTL map[string]time.Duration
+
+	// NegativeTTL is how long 404 (Not Found) responses are cached. Zero
+	// uses DefaultNegativeTTL and a negative value disables negative
+	// caching.
+	NegativeTTL time.Duration
+}
+
+// expires returns when a response to a request for class, with header h,
+// becomes stale. false is returned if it mustn't be cached.
+func (p CachePolicy) expires(class string, h http.Header, now time.Time) (time.Time, bool) {
+	cc := parseCacheControl(h.Get("Cache-Control"))
+	if _, ok := cc["no-store"]; ok {
+		return time.Time{}, false
+	}
+	if ttl, ok := p.ClassTTL[class]; ok {
+		return now.Add(ttl), ttl > 0
+	}
+	// A stale entry is still useful with a validator to revalidate it.
+	validators := h.Get("ETag") != "" || h.Get("Last-Modified") != ""
+	if _, ok := cc["no-cache"]; ok {
+		return now, validators
+	}
+	if v, ok := cc["max-age"]; ok {
+		if secs, err := strconv.Atoi(v); err == nil {
+			if age, err := strconv.Atoi(h.Get("Age")); err == nil && age > 0 {
+				secs -= age
+			}
+			return now.Add(time.Duration(secs) * time.Second), secs > 0 || validators
+		}
+	}
+	if v := h.Get("Expires"); v != "" {
+		// Invalid dates, such as "0", mean already expired.
+		t, err := http.ParseTime(v)
+		if err != nil || !t.After(now) {
+			return now, validators
+		}
+		return t, true
+	}
+	ttl := p.TTL
+	if ttl == 0 {
+		ttl = DefaultResponseTTL
+	}
+	return now.Add(ttl), ttl > 0
+}
+
+// negativeTTL returns how long to cache a 404 for class, or zero to not
+// cache it.
+func (p CachePolicy) negativeTTL(class string) time.Duration {
+	if ttl, ok := p.ClassTTL[class]; ok && ttl <= 0 {
+		return 0
+	}
+	ttl := p.NegativeTTL
+	if ttl == 0 {
+		ttl = DefaultNegativeTTL
+	}
+	if ttl < 0 {
+		return 0
+	}
+	return ttl
+}
+
+func parseCacheControl(v string) map[string]string {
+	out := make(map[string]string)
+	for _, directive := range strings.Split(v, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+		parts := strings.SplitN(directive, "=", 2)
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		if len(parts) == 2 {
+			out[name] = strings.Trim(strings.TrimSpace(parts[1]), `"`)
+		} else {
+			out[name] = ""
+		}
+	}
+	return out
+}
+
+// readCached is read backed by the Client's Cache. Fresh entries are
+// returned as-is, stale ones are revalidated with the server when they have
+// a validator and everything else is requested and stored.
+func (c *Client) readCached(key, class string, req *http.Request) ([]byte, error) {
+	now := time.Now()
+	entry, ok := c.Cache.Get(key)
+	if ok && entry.Fresh(now) {
+		c.Metrics.ResponseCache(class, "hit")
+		return entry.response()
+	}
+	revalidating := ok && entry.Status == http.StatusOK && (entry.ETag != "" || entry.LastModified != "")
+	if revalidating {
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	bs, resp, err := c.readOnce(class, req)
+	if err != nil {
+		if IsNotFound(err) {
+			if ttl := c.CachePolicy.negativeTTL(class); ttl > 0 {
+				body, _ := json.Marshal(err)
+				c.Cache.Set(key, CacheEntry{
+					Status:  http.StatusNotFound,
+					Body:    body,
+					Expires: now.Add(ttl),
+				})
+			}
+		}
+		c.Metrics.ResponseCache(class, "miss")
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		if !revalidating {
+			c.Metrics.ResponseCache(class, "miss")
+			return nil, fmt.Errorf("%s returned 304 Not Modified without a cached response to revalidate", req.URL)
+		}
+		c.Metrics.ResponseCache(class, "revalidated")
+		// RFC7232 Section 4.1, the 304 carries the headers which would
+		// have been sent with a 200.
+		if expires, cacheable := c.CachePolicy.expires(class, resp.Header, now); cacheable {
+			entry.Expires = expires
+			if v := resp.Header.Get("ETag"); v != "" {
+				entry.ETag = v
+			}
+			if v := resp.Header.Get("Last-Modified"); v != "" {
+				entry.LastModified = v
+			}
+			c.Cache.Set(key, entry)
+		} else {
+			c.Cache.Delete(key)
+		}
+		return entry.Body, nil
+	}
+
+	c.Metrics.ResponseCache(class, "miss")
+	if resp.StatusCode == http.StatusOK {
+		if expires, cacheable := c.CachePolicy.expires(class, resp.Header, now); cacheable {
+			c.Cache.Set(key, CacheEntry{
+				Status:       http.StatusOK,
+				Body:         bs,
+				ETag:         resp.Header.Get("ETag"),
+				LastModified: resp.Header.Get("Last-Modified"),
+				Expires:      expires,
+			})
+		} else if ok {
+			c.Cache.Delete(key)
+		}
+	}
+	return bs, nil
+}
+
+// response returns the entry as read would have.
+func (e CacheEntry) response() ([]byte, error) {
+	if e.Status == http.StatusNotFound {
+		rdapErr := &Error{}
+		if len(e.Body) == 0 || json.Unmarshal(e.Body, rdapErr) != nil {
+			rdapErr = &Error{Title: http.StatusText(http.StatusNotFound)}
+		}
+		rdapErr.Code = http.StatusNotFound
+		return nil, rdapErr
+	}
+	return e.Body, nil
+}
+
+// MemoryCache is a Cache holding up to a fixed number of entries in
+// memory, evicting the least recently used.
+type MemoryCache struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+}
+
+type memoryItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding up to size entries.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	m.order.MoveToFront(el)
+	return el.Value.(*memoryItem).entry, true
+}
+
+func (m *MemoryCache) Set(key string, entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryItem).entry = entry
+		m.order.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryItem{key: key, entry: entry})
+	for m.size > 0 && m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryItem).key)
+	}
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		m.order.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+// Len returns how many entries are cached.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// DiskCache is a Cache storing each entry as a file in a directory, which
+// lets cached responses outlive the process. Entries are never evicted,
+// stale ones are only replaced.
+type DiskCache struct {
+	Dir string
+}
+
+// path returns the file for key. Keys are hashed as they're URLs.
+func (d DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d DiskCache) Get(key string) (CacheEntry, bool) {
+	bs, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(bs, &entry); err != nil {
+		return CacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (d DiskCache) Set(key string, entry CacheEntry) {
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(d.Dir, 0755); err != nil {
+		return
+	}
+	// Write to a temporary file first so readers never see a partial entry.
+	f, err := ioutil.TempFile(d.Dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(bs)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+func (d DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
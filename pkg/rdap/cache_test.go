@@ -0,0 +1,237 @@
+package rdap
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/adamdecaf/rdap/pkg/metrics"
+)
+
+func TestMemoryCache__evicts(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", CacheEntry{Body: []byte("a")})
+	cache.Set("b", CacheEntry{Body: []byte("b")})
+	if _, ok := cache.Get("a"); !ok { // a is now most recently used
+		t.Fatal("a missing")
+	}
+	cache.Set("c", CacheEntry{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("b wasn't evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if e, ok := cache.Get(key); !ok || string(e.Body) != key {
+			t.Errorf("%s: got %q (%v)", key, e.Body, ok)
+		}
+	}
+	if n := cache.Len(); n != 2 {
+		t.Errorf("got %d entries", n)
+	}
+
+	cache.Delete("a")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("a wasn't deleted")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rdap-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := DiskCache{Dir: dir}
+	key := "GET https://rdap.example/domain/example.com"
+	if _, ok := cache.Get(key); ok {
+		t.Fatal("empty cache had an entry")
+	}
+	expires := time.Now().Add(time.Hour).Round(time.Second)
+	cache.Set(key, CacheEntry{Status: 200, Body: []byte(`{}`), ETag: `"abc"`, Expires: expires})
+
+	entry, ok := (DiskCache{Dir: dir}).Get(key)
+	if !ok {
+		t.Fatal("entry missing")
+	}
+	if entry.Status != 200 || string(entry.Body) != "{}" || entry.ETag != `"abc"` || !entry.Expires.Equal(expires) {
+		t.Errorf("got %#v", entry)
+	}
+
+	// Corrupt entries are misses
+	if err := ioutil.WriteFile(cache.path(key), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get(key); ok {
+		t.Error("corrupt entry was a hit")
+	}
+	cache.Delete(key)
+	if _, err := os.Stat(cache.path(key)); !os.IsNotExist(err) {
+		t.Errorf("entry wasn't deleted: %v", err)
+	}
+}
+
+func TestCachePolicy__expires(t *testing.T) {
+	now := time.Now()
+	policy := CachePolicy{
+		TTL:      time.Minute,
+		ClassTTL: map[string]time.Duration{ClassEntity: 2 * time.Hour, ClassAutnum: -1},
+	}
+	cases := []struct {
+		class     string
+		header    http.Header
+		ttl       time.Duration
+		cacheable bool
+	}{
+		{ClassDomain, http.Header{}, time.Minute, true},
+		{ClassDomain, http.Header{"Cache-Control": {"public, max-age=300"}}, 5 * time.Minute, true},
+		{ClassDomain, http.Header{"Cache-Control": {"max-age=300"}, "Age": {"100"}}, 200 * time.Second, true},
+		{ClassDomain, http.Header{"Cache-Control": {"max-age=0"}}, 0, false},
+		{ClassDomain, http.Header{"Cache-Control": {"max-age=0"}, "Etag": {`"x"`}}, 0, true},
+		{ClassDomain, http.Header{"Cache-Control": {"no-cache"}}, 0, false},
+		{ClassDomain, http.Header{"Cache-Control": {"no-cache"}, "Last-Modified": {"x"}}, 0, true},
+		{ClassDomain, http.Header{"Cache-Control": {"no-store"}}, 0, false},
+		{ClassDomain, http.Header{"Expires": {now.Add(time.Hour).UTC().Format(http.TimeFormat)}}, time.Hour, true},
+		{ClassDomain, http.Header{"Expires": {"0"}}, 0, false},
+		{ClassEntity, http.Header{"Cache-Control": {"max-age=10"}}, 2 * time.Hour, true},
+		{ClassEntity, http.Header{"Cache-Control": {"no-store"}}, 0, false},
+		{ClassAutnum, http.Header{}, 0, false},
+	}
+	for i, tc := range cases {
+		expires, cacheable := policy.expires(tc.class, tc.header, now)
+		if cacheable != tc.cacheable {
+			t.Errorf("case %d: cacheable=%v", i, cacheable)
+			continue
+		}
+		if !cacheable {
+			continue
+		}
+		// Expires headers only have second precision.
+		if d := expires.Sub(now) - tc.ttl; d > time.Second || d < -time.Second {
+			t.Errorf("case %d: got ttl %v", i, expires.Sub(now))
+		}
+	}
+
+	if ttl := policy.negativeTTL(ClassDomain); ttl != DefaultNegativeTTL {
+		t.Errorf("got negative ttl %v", ttl)
+	}
+	if ttl := policy.negativeTTL(ClassAutnum); ttl != 0 {
+		t.Errorf("got negative ttl %v for disabled class", ttl)
+	}
+	if ttl := (CachePolicy{NegativeTTL: -1}).negativeTTL(ClassDomain); ttl != 0 {
+		t.Errorf("got negative ttl %v when disabled", ttl)
+	}
+}
+
+func TestClient__cache(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	var conditional []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		if v := r.Header.Get("If-None-Match"); v != "" {
+			conditional = append(conditional, r.URL.Path)
+		}
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/domain/fresh.example":
+			w.Header().Set("Cache-Control", "max-age=3600")
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"fresh.example"}`))
+		case "/domain/etag.example":
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("ETag", `"v1"`)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"etag.example"}`))
+		case "/domain/private.example":
+			w.Header().Set("Cache-Control", "no-store")
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"private.example"}`))
+		case "/domain/unasked.example":
+			w.WriteHeader(http.StatusNotModified)
+		case "/nameserver/ns1.example":
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Write([]byte(`{"objectClassName":"nameserver","ldhName":"ns1.example"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorCode":404,"title":"Not Found","description":["no such object"]}`))
+		}
+	}))
+	defer svc.Close()
+
+	m := metrics.New()
+	client := &Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+		Metrics:     m,
+		Cache:       NewMemoryCache(10),
+		CachePolicy: CachePolicy{
+			ClassTTL: map[string]time.Duration{ClassNameserver: time.Hour},
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		for _, name := range []string{"fresh.example", "etag.example", "private.example"} {
+			domain, err := client.Domain(name)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if domain.LDHName != name {
+				t.Errorf("%s: got %s", name, domain.LDHName)
+			}
+		}
+		ns, err := client.Nameserver("ns1.example")
+		if err != nil || ns.LDHName != "ns1.example" {
+			t.Fatalf("got %v: %v", ns, err)
+		}
+		_, err = client.Domain("missing.example")
+		if !IsNotFound(err) {
+			t.Fatalf("expected not found, got %v", err)
+		}
+		if e, ok := err.(*Error); !ok || len(e.Description) != 1 || e.Description[0] != "no such object" {
+			t.Errorf("got %#v", err)
+		}
+	}
+
+	expected := map[string]int{
+		"/domain/fresh.example":   1,
+		"/domain/etag.example":    3, // revalidated each time
+		"/domain/private.example": 3,
+		"/nameserver/ns1.example": 1, // ClassTTL overrides no-cache
+		"/domain/missing.example": 1,
+	}
+	for path, n := range expected {
+		if requests[path] != n {
+			t.Errorf("%s: made %d requests, expected %d", path, requests[path], n)
+		}
+	}
+	if len(conditional) != 2 {
+		t.Errorf("got conditional requests %v", conditional)
+	}
+
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+	for _, line := range []string{
+		`rdap_response_cache_total{class="domain",result="hit"} 4`,
+		`rdap_response_cache_total{class="domain",result="miss"} 6`,
+		`rdap_response_cache_total{class="domain",result="revalidated"} 2`,
+		`rdap_response_cache_total{class="nameserver",result="hit"} 2`,
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("missing %s in\n%s", line, buf.String())
+		}
+	}
+	// A 304 without a cached response isn't an empty success
+	if _, err := client.Domain("unasked.example"); err == nil || !strings.Contains(err.Error(), "304") {
+		t.Errorf("got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package rdap
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,11 +11,16 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/adamdecaf/rdap/pkg/httputil"
+	"github.com/adamdecaf/rdap/pkg/idna"
+	"github.com/adamdecaf/rdap/pkg/metrics"
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+	"github.com/adamdecaf/rdap/pkg/trace"
 )
 
 var (
@@ -31,7 +37,7 @@ var (
 	// Setup for the http.Client used
 	DefaultHTTPClient = &http.Client{
 		Transport: httputil.Transport(nil),
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
 	}
 
 	// RFC7480 Section 4.2 and RFC7483 Section 10.1
@@ -41,6 +47,10 @@ var (
 	// However, some implementations don't support the new content type
 	// so we use the standard json mime type.
 	DefaultAcceptHeader = "application/json"
+
+	// MaxRetryAfter caps how long a Client will wait on a Retry-After
+	// header before retrying a request.
+	MaxRetryAfter = 30 * time.Second
 )
 
 // Client is is used to make RDAP HTTP requests against a server.
@@ -54,6 +64,42 @@ type Client struct {
 	// Enable debug logging
 	Debug bool
 
+	// MaxRetries is how many times a request is retried after a 429
+	// (Too Many Requests) or 503 (Service Unavailable) response. The
+	// Retry-After header is honored, up to MaxRetryAfter. Zero, the
+	// default, returns those responses as errors.
+	MaxRetries int
+
+	// MaxNetworkDepth limits how many networks ParentNetworks and
+	// NetworkHierarchy will walk through, in case a server links networks
+	// in a loop which can't be detected by handle. Zero uses
+	// DefaultMaxNetworkDepth.
+	MaxNetworkDepth int
+
+	// Metrics, if non-nil, records each request made by the Client.
+	Metrics *metrics.Collector
+
+	// Tracer, if non-nil, is given timings for each phase of a request
+	// (connection setup, redirects, reading and decoding the response).
+	Tracer trace.Tracer
+
+	// Bootstrap, if non-nil, is used to find the authoritative server for
+	// lookups which aren't tied to one, such as ReverseDomains. Otherwise
+	// BaseAddress is used.
+	Bootstrap *bootstrap.Registry
+
+	// Cache, if non-nil, stores responses so repeated lookups aren't sent
+	// to the server while they're fresh. CachePolicy sets how long they're
+	// kept for.
+	Cache       Cache
+	CachePolicy CachePolicy
+
+	// ctx, if set, is attached to every request so they can be cancelled.
+	ctx context.Context
+
+	// flights coalesces identical in-flight requests.
+	flights flightGroup
+
 	setup sync.Once
 }
 
@@ -79,47 +125,50 @@ func (c *Client) IP(addr string) (*IPNetwork, error) {
 		addr = net.String()
 	}
 
-	// Build and make the actual HTTP request
-	req, err := c.makeRequest(fmt.Sprintf("/ip/%s", addr))
-	if c.Debug {
-		fmt.Println("Using", req.URL)
-	}
+	bs, err := c.get("ip network", fmt.Sprintf("/ip/%s", addr))
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.do(req)
+	var ipNetwork IPNetwork
+	if err := c.decode(bs, &ipNetwork); err != nil {
+		return nil, fmt.Errorf("error parsing ip network response: %v", err)
+	}
+	if ipNetwork.ObjectClassName != "ip network" {
+		return &ipNetwork, fmt.Errorf("unknown objectClassName: %q", ipNetwork.ObjectClassName)
+	}
+	return &ipNetwork, nil
+}
+
+// RFC7482 3.1.2.  Autonomous System Path Segment Specification
+//
+//	Syntax: autnum/<autonomous system number>
+//
+// /autnum/XXX/ ... where XXX is an asplain Autonomous System number [RFC5396]
+//
+// asn may have an "AS" prefix, which is dropped before querying.
+func (c *Client) Autnum(asn string) (*Autnum, error) {
+	n, err := bootstrap.ParseASN(asn)
 	if err != nil {
 		return nil, err
 	}
-	if resp == nil || resp.Body == nil {
-		return nil, fmt.Errorf("no body on successful response for %s", req.URL)
-	}
-	defer resp.Body.Close()
 
-	// Parse successful response
-	bs, err := ioutil.ReadAll(resp.Body)
+	bs, err := c.get(ClassAutnum, "/autnum/"+strconv.FormatUint(uint64(n), 10))
 	if err != nil {
-		return nil, fmt.Errorf("unable to read body from %s", req.URL)
+		return nil, err
 	}
-	if c.Debug {
-		fmt.Println(string(bs))
+	var autnum Autnum
+	if err := c.decode(bs, &autnum); err != nil {
+		return nil, fmt.Errorf("error parsing autnum response: %v", err)
 	}
-	var ipNetwork IPNetworkJSON
-	if err := json.Unmarshal(bs, &ipNetwork); err != nil {
-		return nil, fmt.Errorf("error parsing ip network response: %v", err)
+	if autnum.ObjectClassName != ClassAutnum {
+		return &autnum, fmt.Errorf("unknown objectClassName: %q", autnum.ObjectClassName)
 	}
-	return nil, errors.New("") // TODO(Adam): parse successful response
+	return &autnum, nil
 }
 
-// RFC7482 3.1.2.  Autonomous System Path Segment Specification
-//    Syntax: autnum/<autonomous system number>
-//
-// /autnum/XXX/ ... where XXX is an asplain Autonomous System number [RFC5396]
-// TODO(adam): Does RFC5396 specify any format?
-func (c *Client) Autnum() {}
-
 // RFC7482 3.1.3.  Domain Path Segment Specification
-//    Syntax: domain/<domain name>
+//
+//	Syntax: domain/<domain name>
 //
 // Queries for domain information are of the form /domain/XXXX/...,
 // where XXXX is a fully qualified (relative to the root) domain name
@@ -129,41 +178,110 @@ func (c *Client) Autnum() {}
 //
 // Internationalized Domain Names (IDNs) represented in either A-label
 // or U-label format [RFC5890] are also valid domain names.
+//
+// U-labels are converted to A-labels before querying, as not every server
+// accepts them.
 func (c *Client) Domain(fqdn string) (*Domain, error) {
-	// TODO(adam): parse domain?
 	if fqdn == "" {
 		return nil, errors.New("empty FQDN provided")
 	}
-
-	req, err := c.makeRequest(fmt.Sprintf("/domain/%s", fqdn))
-	fmt.Println(req.URL)
+	name, err := idna.ToASCII(fqdn)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.do(req)
-	if err != nil {
-		return nil, err
-	}
-	if resp == nil || resp.Body == nil {
-		return nil, fmt.Errorf("no body on successful response for %s", req.URL)
-	}
-	defer resp.Body.Close()
 
-	// Parse successful response
-	bs, err := ioutil.ReadAll(resp.Body)
+	bs, err := c.get("domain", "/domain/"+url.PathEscape(name))
 	if err != nil {
-		return nil, fmt.Errorf("unable to read body from %s", req.URL)
+		return nil, err
 	}
 	var domain Domain
-	if err := json.Unmarshal(bs, &domain); err != nil {
+	if err := c.decode(bs, &domain); err != nil {
 		return nil, fmt.Errorf("error parsing domain response: %v", err)
 	}
 	if domain.ObjectClassName != "domain" {
 		return &domain, fmt.Errorf("unknown objectClassName: %q", domain.ObjectClassName)
 	}
+	domain.Source = SourceRegistry
+	domain.LDHName, domain.UnicodeName = names(domain.LDHName, domain.UnicodeName, name)
 	return &domain, nil
 }
 
+// names fills in whichever of the LDH and Unicode names a server left off
+// a domain or nameserver. RFC7483 Section 3 only requires unicodeName for
+// IDNs, so it's left empty for other names.
+func names(ldhName, unicodeName, queried string) (string, string) {
+	if ldhName == "" {
+		if unicodeName != "" {
+			ldhName, _ = idna.ToASCII(unicodeName)
+		}
+		if ldhName == "" {
+			ldhName = queried
+		}
+	}
+	if unicodeName == "" && idna.IsIDN(ldhName) {
+		unicodeName, _ = idna.ToUnicode(ldhName)
+	}
+	return ldhName, unicodeName
+}
+
+// RelatedDomains follows each rel="related" link on d which points to an
+// RDAP domain and returns the responses.
+//
+// Registries of thin gTLDs (e.g. .com) return a minimal domain with a
+// related link to the sponsoring registrar's RDAP service, which holds
+// the contact information. Returned domains have their Source set to
+// SourceRegistrar.
+func (c *Client) RelatedDomains(d *Domain) ([]*Domain, error) {
+	if d == nil {
+		return nil, errors.New("nil Domain")
+	}
+	var out []*Domain
+	for _, link := range d.Links.Rel(RelRelated) {
+		target := link.Target()
+		if target == nil || !target.IsAbs() {
+			continue
+		}
+		// Some servers leave off the type, so fall back to the path.
+		if !link.IsRDAP() && (link.Type != "" || !strings.Contains(target.Path, "/domain/")) {
+			continue
+		}
+
+		bs, err := c.getURL("domain", target)
+		if err != nil {
+			return out, fmt.Errorf("following related link %s: %v", target, err)
+		}
+		var related Domain
+		if err := c.decode(bs, &related); err != nil {
+			return out, fmt.Errorf("error parsing domain response from %s: %v", target, err)
+		}
+		if related.ObjectClassName != "domain" {
+			return out, fmt.Errorf("unknown objectClassName from %s: %q", target, related.ObjectClassName)
+		}
+		related.Source = SourceRegistrar
+		out = append(out, &related)
+	}
+	return out, nil
+}
+
+// DomainWithRegistrar looks up fqdn on the registry and then follows its
+// related link to the registrar. Both responses are merged into one view,
+// see MergeDomains.
+//
+// If the registry doesn't link to a registrar, or the registrar can't be
+// reached, the registry's response is returned on its own along with the
+// error (if any) from the registrar.
+func (c *Client) DomainWithRegistrar(fqdn string) (*MergedDomain, error) {
+	registry, err := c.Domain(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	related, err := c.RelatedDomains(registry)
+	if len(related) == 0 {
+		return MergeDomains(registry, nil), err
+	}
+	return MergeDomains(registry, related[0]), err
+}
+
 // RFC7482 3.2.1 Domain Search
 //
 // /domains?name=XXXX
@@ -186,16 +304,66 @@ func (c *Client) Domain(fqdn string) (*Domain, error) {
 //
 // RFC7483 Section 6
 // for /domains searches, the array is "domainSearchResults"
-func (c *Client) DomainSearch() {}
+//
+// by is one of "name", "nsLdhName" or "nsIp". Only the first page of
+// results is returned, see DomainSearchNext.
+func (c *Client) DomainSearch(by, pattern string) (*DomainSearchResults, error) {
+	u, err := c.searchURL("/domains", by, pattern, "name", "nsLdhName", "nsIp")
+	if err != nil {
+		return nil, err
+	}
+	var results DomainSearchResults
+	if err := c.search(ClassDomain, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// DomainSearchNext returns the page of results after r, or nil once r is
+// the last page.
+func (c *Client) DomainSearchNext(r *DomainSearchResults) (*DomainSearchResults, error) {
+	u := r.NextPage()
+	if u == nil {
+		return nil, nil
+	}
+	var results DomainSearchResults
+	if err := c.search(ClassDomain, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
 
 // RFC7482 3.1.4.  Nameserver Path Segment Specification
-//    Syntax: nameserver/<nameserver name>
+//
+//	Syntax: nameserver/<nameserver name>
 //
 // The <nameserver name> parameter represents a fully qualified host
 // name as specified in [RFC0952] and [RFC1123].  Internationalized
 // names represented in either A-label or U-label format [RFC5890] are
 // also valid nameserver names.
-func (c *Client) Nameserver() {}
+func (c *Client) Nameserver(host string) (*Nameserver, error) {
+	if host == "" {
+		return nil, errors.New("empty nameserver name provided")
+	}
+	name, err := idna.ToASCII(host)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := c.get("nameserver", "/nameserver/"+url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+	var ns Nameserver
+	if err := c.decode(bs, &ns); err != nil {
+		return nil, fmt.Errorf("error parsing nameserver response: %v", err)
+	}
+	if ns.ObjectClassName != ClassNameserver {
+		return &ns, fmt.Errorf("unknown objectClassName: %q", ns.ObjectClassName)
+	}
+	ns.LDHName, ns.UnicodeName = names(ns.LDHName, ns.UnicodeName, name)
+	return &ns, nil
+}
 
 // RFC7482 3.2.2.  Nameserver Search
 // Syntax: nameservers?name=<nameserver search pattern>
@@ -203,16 +371,61 @@ func (c *Client) Nameserver() {}
 //
 // RFC7483 Section 6
 // for /nameservers searches, the array is "nameserverSearchResults"
-func (c *Client) NameserverSearch() {}
+//
+// by is one of "name" or "ip". Only the first page of results is
+// returned, see NameserverSearchNext.
+func (c *Client) NameserverSearch(by, pattern string) (*NameserverSearchResults, error) {
+	u, err := c.searchURL("/nameservers", by, pattern, "name", "ip")
+	if err != nil {
+		return nil, err
+	}
+	var results NameserverSearchResults
+	if err := c.search(ClassNameserver, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// NameserverSearchNext returns the page of results after r, or nil once r
+// is the last page.
+func (c *Client) NameserverSearchNext(r *NameserverSearchResults) (*NameserverSearchResults, error) {
+	u := r.NextPage()
+	if u == nil {
+		return nil, nil
+	}
+	var results NameserverSearchResults
+	if err := c.search(ClassNameserver, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
 
 // RFC7482 3.1.5.  Entity Path Segment Specification
-//    Syntax: entity/<handle>
+//
+//	Syntax: entity/<handle>
 //
 // The <handle> parameter represents an entity (such as a contact,
 // registrant, or registrar) identifier whose syntax is specific to the
 // registration provider.  For example, for some DNRs, contact
 // identifiers are specified in [RFC5730] and [RFC5733].
-func (c *Client) Entity() {}
+func (c *Client) Entity(handle string) (*Entity, error) {
+	if handle == "" {
+		return nil, errors.New("empty entity handle provided")
+	}
+
+	bs, err := c.get(ClassEntity, "/entity/"+url.PathEscape(handle))
+	if err != nil {
+		return nil, err
+	}
+	var entity Entity
+	if err := c.decode(bs, &entity); err != nil {
+		return nil, fmt.Errorf("error parsing entity response: %v", err)
+	}
+	if entity.ObjectClassName != ClassEntity {
+		return &entity, fmt.Errorf("unknown objectClassName: %q", entity.ObjectClassName)
+	}
+	return &entity, nil
+}
 
 // RFC7482 3.2.3.  Entity Search
 // Syntax: entities?fn=<entity name search pattern>
@@ -230,15 +443,90 @@ func (c *Client) Entity() {}
 //
 // RFC7483 Section 6
 // for /entities searches, the array is "entitySearchResults"
-func (c *Client) EntitySearch() {}
+//
+// by is one of "fn" or "handle". Only the first page of results is
+// returned, see EntitySearchNext.
+func (c *Client) EntitySearch(by, pattern string) (*EntitySearchResults, error) {
+	u, err := c.searchURL("/entities", by, pattern, "fn", "handle")
+	if err != nil {
+		return nil, err
+	}
+	var results EntitySearchResults
+	if err := c.search(ClassEntity, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// EntitySearchNext returns the page of results after r, or nil once r is
+// the last page.
+func (c *Client) EntitySearchNext(r *EntitySearchResults) (*EntitySearchResults, error) {
+	u := r.NextPage()
+	if u == nil {
+		return nil, nil
+	}
+	var results EntitySearchResults
+	if err := c.search(ClassEntity, u, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// searchURL returns the URL for a search of seg (e.g. /domains) on
+// BaseAddress, where by must be one of params.
+func (c *Client) searchURL(seg, by, pattern string, params ...string) (*url.URL, error) {
+	c.init()
+
+	known := false
+	for _, p := range params {
+		known = known || p == by
+	}
+	if !known {
+		return nil, fmt.Errorf("unknown %s search parameter %q, expected one of: %s", strings.TrimPrefix(seg, "/"), by, strings.Join(params, ", "))
+	}
+	if pattern == "" {
+		return nil, errors.New("empty search pattern provided")
+	}
+
+	req, err := c.makeRequest(seg)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = url.Values{by: []string{pattern}}.Encode()
+	return req.URL, nil
+}
+
+// search reads a page of search results from u into out.
+func (c *Client) search(class string, u *url.URL, out Object) error {
+	bs, err := c.getURL(class, u)
+	if err != nil {
+		return err
+	}
+	if err := c.decode(bs, out); err != nil {
+		return fmt.Errorf("error parsing %s search response: %v", class, err)
+	}
+	return checkClasses(out)
+}
 
 // RFC7482 3.1.6.  Help Path Segment Specification
-//    Syntax: help
+//
+//	Syntax: help
+//
 // The help path segment can be used to request helpful information
 // (command syntax, terms of service, privacy policy, rate-limiting
 // policy, supported authentication methods, supported extensions,
 // technical support contact, etc.) from an RDAP server.
-func (c *Client) Help() {}
+func (c *Client) Help() (*Help, error) {
+	bs, err := c.get("help", "/help")
+	if err != nil {
+		return nil, err
+	}
+	var help Help
+	if err := c.decode(bs, &help); err != nil {
+		return nil, fmt.Errorf("error parsing help response: %v", err)
+	}
+	return &help, nil
+}
 
 // RFC7483 Section 7
 // The appropriate response to /help queries as defined by [RFC7482] is
@@ -252,14 +540,8 @@ func (c *Client) makeRequest(seg string) (*http.Request, error) {
 	return http.NewRequest("GET", fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, path.Join(u.Path, seg)), nil)
 }
 
-// do is a helper method which will initialize some internal properties of a
-// Client (if not already set) and perform some sanity checks on the request.
-//
-// If the underlying HTTP call fails do will attempt to read out an Error message
-// and close the response body.
-//
-// On a successful request do will not close or alter the response.
-func (c *Client) do(req *http.Request) (*http.Response, error) {
+// init sets defaults on a Client if they're not already set.
+func (c *Client) init() {
 	c.setup.Do(func() {
 		if c.Underlying == nil {
 			c.Underlying = DefaultHTTPClient
@@ -272,15 +554,141 @@ func (c *Client) do(req *http.Request) (*http.Response, error) {
 			// in url building.
 			c.BaseAddress = strings.TrimSuffix(c.BaseAddress, "/")
 		}
-		if req.URL.Host == "" {
-			raw := DefaultServer + req.URL.Path
-			u, err := url.Parse(raw)
-			if err != nil {
-				panic(fmt.Errorf("invalid url %q: %v", raw, err))
-			}
-			req.URL = u
+	})
+}
+
+// get performs a GET request for seg (e.g. /domain/example.com) and returns
+// the successful response body. class is the RDAP object class requested
+// and is used to label metrics.
+func (c *Client) get(class, seg string) ([]byte, error) {
+	c.init()
+
+	req, err := c.makeRequest(seg)
+	if err != nil {
+		return nil, err
+	}
+	return c.read(class, req)
+}
+
+// getURL is like get, but requests u rather than a path on BaseAddress.
+func (c *Client) getURL(class string, u *url.URL) ([]byte, error) {
+	c.init()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.read(class, req)
+}
+
+// getFrom is like get, but requests seg from server when it's set rather
+// than from BaseAddress.
+func (c *Client) getFrom(server, class, seg string) ([]byte, error) {
+	if server == "" {
+		return c.get(class, seg)
+	}
+	u, err := url.Parse(strings.TrimSuffix(server, "/") + seg)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("relative RDAP server URL: %q", server)
+	}
+	return c.getURL(class, u)
+}
+
+// ipServer returns the RDAP server for n from the Bootstrap registry, or an
+// empty string to use BaseAddress when the Client has no registry.
+func (c *Client) ipServer(n *net.IPNet) (string, error) {
+	if c.Bootstrap == nil {
+		return "", nil
+	}
+	server, err := c.Bootstrap.ForIPNetwork(n.String())
+	if err != nil {
+		return "", fmt.Errorf("bootstrapping %s: %v", n, err)
+	}
+	if server == "" {
+		return "", fmt.Errorf("no RDAP server found for %s", n)
+	}
+	return server, nil
+}
+
+// read performs req and returns the successful response body.
+//
+// Identical requests made at the same time are coalesced: only one is
+// sent and every caller gets its response (or error), see coalesceKey.
+// When the Client has a Cache, responses are served from and stored in it.
+func (c *Client) read(class string, req *http.Request) ([]byte, error) {
+	if c.ctx != nil {
+		req = req.WithContext(c.ctx)
+	}
+	key := coalesceKey(req)
+	bs, err, shared := c.flights.do(key, func() ([]byte, error) {
+		if c.Cache != nil {
+			return c.readCached(key, class, req)
 		}
+		bs, _, err := c.readOnce(class, req)
+		return bs, err
 	})
+	if shared {
+		c.Metrics.Coalesced(req.URL.Host, class)
+	}
+	return bs, err
+}
+
+// readOnce performs req and returns the successful response body along
+// with the response.
+func (c *Client) readOnce(class string, req *http.Request) ([]byte, *http.Response, error) {
+	if c.Debug {
+		fmt.Println("Using", req.URL)
+	}
+	resp, err := c.do(class, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp == nil || resp.Body == nil {
+		return nil, nil, fmt.Errorf("no body on successful response for %s", req.URL)
+	}
+	defer resp.Body.Close()
+
+	start := time.Now()
+	bs, err := ioutil.ReadAll(resp.Body)
+	trace.Emit(c.Tracer, trace.BodyRead, req.URL.String(), start, err)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read body from %s", req.URL)
+	}
+	if c.Debug {
+		fmt.Println(string(bs))
+	}
+	return bs, resp, nil
+}
+
+// decode unmarshals a response body into v.
+func (c *Client) decode(bs []byte, v interface{}) error {
+	start := time.Now()
+	err := json.Unmarshal(bs, v)
+	trace.Emit(c.Tracer, trace.Decode, fmt.Sprintf("%T", v), start, err)
+	return err
+}
+
+// do is a helper method which will initialize some internal properties of a
+// Client (if not already set) and perform some sanity checks on the request.
+//
+// If the underlying HTTP call fails do will attempt to read out an Error message
+// and close the response body.
+//
+// On a successful request do will not close or alter the response.
+func (c *Client) do(class string, req *http.Request) (*http.Response, error) {
+	c.init()
+
+	if req.URL.Host == "" {
+		raw := DefaultServer + req.URL.Path
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url %q: %v", raw, err)
+		}
+		req.URL = u
+	}
 
 	// RFC7481 Section 3.5
 	// As noted in Section 3.2, the HTTP "basic" authentication scheme can
@@ -297,32 +705,111 @@ func (c *Client) do(req *http.Request) (*http.Response, error) {
 		req.Header.Set("Accept", DefaultAcceptHeader)
 	}
 
-	// Perform the request
-	resp, err := c.Underlying.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error during request: %v", err)
-	}
+	// TODO(Adam): Clients must follow redirects // RFC7480 Section 5.2
 
-	// TODO(Adam): We should check for both of these
-	// Clients must follow redirects // RFC7480 Section 5.2
-	// 429 status, return Retry-After header // RFC7480 Section 5.5
+	// Perform the request, retrying if we're asked to slow down.
+	underlying := trace.FollowRedirects(c.Tracer, c.Underlying)
+	req = trace.WithRequest(c.Tracer, req)
+
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		r, err := underlying.Do(trace.MarkStart(req))
+		trace.Emit(c.Tracer, trace.Request, req.URL.String(), start, err)
+		if err != nil {
+			c.Metrics.ObserveRequest(req.URL.Host, class, 0, time.Since(start))
+			return nil, fmt.Errorf("error during request: %v", err)
+		}
+		c.Metrics.ObserveRequest(req.URL.Host, class, r.StatusCode, time.Since(start))
+
+		// RFC7480 Section 5.5
+		// Some servers apply rate limits to deter address scraping and other
+		// abuses.  When a server declines to answer a query due to rate
+		// limits, it returns an HTTP 429 (Too Many Requests) response code.
+		if r.StatusCode == http.StatusTooManyRequests {
+			c.Metrics.RateLimited(req.URL.Host)
+		}
+		retryable := r.StatusCode == http.StatusTooManyRequests || r.StatusCode == http.StatusServiceUnavailable
+		if !retryable || attempt >= c.MaxRetries {
+			resp = r
+			break
+		}
+		wait := retryAfter(r)
+		if r.Body != nil {
+			r.Body.Close()
+		}
+		c.Metrics.Retry(req.URL.Host, class)
+		if c.Debug {
+			fmt.Printf("Retrying %s in %v after %d\n", req.URL, wait, r.StatusCode)
+		}
+		if err := c.sleep(wait); err != nil {
+			return nil, err
+		}
+	}
 
 	if resp.StatusCode >= 400 {
+		// RFC7480 Section 5.3 states servers MAY return an error response
+		// so we will try and parse that out from the body
+		var rdapErr *Error
 		if resp.Body != nil {
-			// RFC7480 Section 5.3 states servers MAY return an error response
-			// so we will try and parse that out from the body
-			return nil, c.parseError(resp.Body)
+			rdapErr = c.parseError(resp.Body)
 		}
 		// RFC7480 Sectin 5.3:
 		// If a server wishes to inform the client that information about the
 		// query is available, but cannot include the information in the
 		// response to the client for policy reasons, the server MUST respond
 		// with an appropriate response code out of HTTP's 4xx range.
-		return nil, fmt.Errorf("%d error during request to %s", resp.StatusCode, req.URL)
+		if rdapErr == nil {
+			rdapErr = &Error{
+				Title:       http.StatusText(resp.StatusCode),
+				Description: []string{fmt.Sprintf("error during request to %s", req.URL)},
+			}
+		}
+		if rdapErr.Code == 0 {
+			rdapErr.Code = resp.StatusCode
+		}
+		return nil, rdapErr
 	}
 	return resp, nil
 }
 
+// sleep waits for d, returning early with an error if the Client's context
+// is cancelled.
+func (c *Client) sleep(d time.Duration) error {
+	if c.ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+// retryAfter returns how long to wait before retrying resp, which is read
+// from the Retry-After header (in either delay-seconds or HTTP-date form).
+// Missing or invalid headers wait one second.
+func retryAfter(resp *http.Response) time.Duration {
+	wait := time.Second
+	v := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+		wait = time.Duration(n) * time.Second
+	} else if t, err := http.ParseTime(v); err == nil {
+		wait = time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+	}
+	if wait > MaxRetryAfter {
+		wait = MaxRetryAfter
+	}
+	return wait
+}
+
 // parseError attempts to parse `bs` as an Error type
 // a nil response means no error was parsed
 //
@@ -0,0 +1,167 @@
+package rdap
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/metrics"
+)
+
+func TestClient__retry(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/verisign-google-domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests int
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write(bs)
+	}))
+	defer svc.Close()
+
+	m := metrics.New()
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+		MaxRetries:  1,
+		Metrics:     m,
+	}
+	domain, err := client.Domain("google.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.LDHName != "google.com" {
+		t.Errorf("got %q", domain.LDHName)
+	}
+
+	var buf bytes.Buffer
+	m.WriteTo(&buf)
+	out := buf.String()
+	host := strings.TrimPrefix(svc.URL, "http://")
+	expected := []string{
+		`rdap_requests_total{host="` + host + `",class="domain",code="200"} 1`,
+		`rdap_requests_total{host="` + host + `",class="domain",code="429"} 1`,
+		`rdap_retries_total{host="` + host + `",class="domain"} 1`,
+		`rdap_rate_limited_total{host="` + host + `"} 1`,
+	}
+	for i := range expected {
+		if !strings.Contains(out, expected[i]) {
+			t.Errorf("missing %q in:\n%s", expected[i], out)
+		}
+	}
+}
+
+func TestClient__idn(t *testing.T) {
+	var paths []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		if strings.HasPrefix(r.URL.Path, "/nameserver/") {
+			w.Write([]byte(`{"objectClassName":"nameserver","ldhName":"NS1.XN--BCHER-KVA.EXAMPLE"}`))
+			return
+		}
+		w.Write([]byte(`{"objectClassName":"domain","unicodeName":"bücher.example"}`))
+	}))
+	defer svc.Close()
+
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	domain, err := client.Domain("BÜCHER.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.LDHName != "xn--bcher-kva.example" || domain.UnicodeName != "bücher.example" {
+		t.Errorf("got ldhName=%q unicodeName=%q", domain.LDHName, domain.UnicodeName)
+	}
+
+	ns, err := client.Nameserver("ns1.bücher.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns.LDHName != "NS1.XN--BCHER-KVA.EXAMPLE" || ns.UnicodeName != "ns1.bücher.example" {
+		t.Errorf("got ldhName=%q unicodeName=%q", ns.LDHName, ns.UnicodeName)
+	}
+
+	expected := []string{"/domain/xn--bcher-kva.example", "/nameserver/ns1.xn--bcher-kva.example"}
+	if len(paths) != 2 || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Errorf("got %v", paths)
+	}
+
+	for _, name := range []string{"exa mple.com", "example.com/../help", "-bad.com", "xn--zzzz.com"} {
+		if _, err := client.Domain(name); err == nil {
+			t.Errorf("%q: expected error", name)
+		}
+	}
+	if len(paths) != 2 {
+		t.Errorf("invalid names were queried: %v", paths)
+	}
+}
+
+func TestClient__autnumEntityHelp(t *testing.T) {
+	files := map[string]string{
+		"/autnum/10":   "rfc-7483-section-5-5-example.json",
+		"/entity/XXXX": "rfc-7483-section-5-1-example.json",
+	}
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/help" {
+			w.Write([]byte(`{"rdapConformance":["rdap_level_0"],"notices":[{"title":"Help","description":["Try /domain/example.com"]}]}`))
+			return
+		}
+		name, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		bs, err := ioutil.ReadFile("../../testdata/" + name)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(bs)
+	}))
+	defer svc.Close()
+
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+
+	autnum, err := client.Autnum("AS10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if autnum.Handle != "XXXX-RIR" || autnum.String() != "Autnum: AS10 - AS15" {
+		t.Errorf("got %s (%s)", autnum, autnum.Handle)
+	}
+	if _, err := client.Autnum("AS1x"); err == nil {
+		t.Error("expected error")
+	}
+
+	entity, err := client.Entity("XXXX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entity.String() != "Entity: XXXX" {
+		t.Errorf("got %s", entity)
+	}
+	if _, err := client.Entity("YYYY"); !IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	help, err := client.Help()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(help.Notices) != 1 || help.Notices[0].Title != "Help" {
+		t.Errorf("got %#v", help.Notices)
+	}
+}
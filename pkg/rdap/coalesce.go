@@ -0,0 +1,88 @@
+package rdap
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// flightGroup coalesces identical requests made at the same time, so only
+// one goes out and every caller shares its response body.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	wg   sync.WaitGroup
+	bs   []byte
+	err  error
+	dups int
+}
+
+// do calls fn, unless a call for key is in flight, in which case it waits
+// for that call and returns its result. shared is true for waiters.
+func (g *flightGroup) do(key string, fn func() ([]byte, error)) (bs []byte, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	if f, ok := g.calls[key]; ok {
+		f.dups++
+		g.mu.Unlock()
+		f.wg.Wait()
+		return f.bs, f.err, true
+	}
+	// Waiters get this error if fn panics.
+	f := &flight{err: errors.New("coalesced request panicked")}
+	f.wg.Add(1)
+	g.calls[key] = f
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		f.wg.Done()
+	}()
+	f.bs, f.err = fn()
+	return f.bs, f.err, false
+}
+
+// coalesceKey identifies requests which can share a response: the same
+// normalized URL sent with the same credentials. Credentials are hashed
+// rather than kept in memory as-is.
+func coalesceKey(req *http.Request) string {
+	u := *req.URL
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+	u.Fragment = ""
+	if u.RawQuery != "" {
+		if q, err := url.ParseQuery(u.RawQuery); err == nil {
+			u.RawQuery = q.Encode() // sorted by key
+		}
+	}
+
+	u.User = nil
+
+	key := req.Method + " " + u.String()
+	var creds []string
+	for _, name := range []string{"Authorization", "Authentication", "Cookie"} {
+		creds = append(creds, req.Header[name]...)
+	}
+	if req.URL.User != nil {
+		creds = append(creds, req.URL.User.String())
+	}
+	if len(creds) > 0 {
+		sum := sha256.Sum256([]byte(strings.Join(creds, "\n")))
+		key += " " + hex.EncodeToString(sum[:])
+	}
+	return key
+}
@@ -0,0 +1,110 @@
+package rdap
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Common holds the members shared by every RDAP response object.
+//
+// Each object class (Domain, Entity, Nameserver, IPNetwork, Autnum and
+// Error) embeds Common so they can be handled generically through Object.
+//
+// rdapConformance, notices and lang are normally only found on the top
+// level object of a response. RFC7483 Section 4
+type Common struct {
+	// rdapConformance -- identifiers of the specifications used in
+	// constructing the response. RFC7483 Section 4.1
+	RDAPConformance []string `json:"rdapConformance,omitempty"`
+
+	// notices -- information about the service providing RDAP
+	// information and/or information about the entire response.
+	// RFC7483 Section 4.3
+	Notices []RemarkJSON `json:"notices,omitempty"`
+
+	// remarks -- information about the object, in the same form
+	// as notices.
+	Remarks []RemarkJSON `json:"remarks,omitempty"`
+
+	// lang -- a language identifier [RFC5646] for the object.
+	// RFC7483 Section 4.4
+	Lang string `json:"lang,omitempty"`
+
+	// port43 -- the host name or IP address of the WHOIS [RFC3912]
+	// server. RFC7483 Section 4.7
+	Port43 string `json:"port43,omitempty"`
+
+	Links Links `json:"links,omitempty"`
+
+	// events -- RFC7483 Section 4.5
+	Events []EventJSON `json:"events,omitempty"`
+
+	// status -- see Section 4.6
+	Status Statuses `json:"status,omitempty"`
+
+	// entities -- an array of entity objects as defined by Section 5.1
+	Entities []Entity `json:"entities,omitempty"`
+
+	// Raw is the JSON the object was decoded from, including any nested
+	// objects. It's empty for objects built in code.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions are the members of the object this package doesn't
+	// model. They're written back out when the object is encoded.
+	Extensions Extensions `json:"-"`
+}
+
+func (c *Common) setDecoded(bs []byte, ext Extensions) {
+	c.Raw = append(json.RawMessage(nil), bs...)
+	c.Extensions = ext
+}
+
+// Object is implemented by every RDAP object class through its embedded
+// Common.
+type Object interface {
+	Base() *Common
+}
+
+// Base returns c, which lets Common satisfy Object.
+func (c *Common) Base() *Common {
+	return c
+}
+
+// Conforms returns true if the response claims conformance with the
+// identifier (e.g. "rdap_level_0").
+func (c *Common) Conforms(identifier string) bool {
+	for i := range c.RDAPConformance {
+		if strings.EqualFold(c.RDAPConformance[i], identifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// Notice returns the first notice with the given title (compared
+// case-insensitively), or nil if there isn't one.
+func (c *Common) Notice(title string) *RemarkJSON {
+	for i := range c.Notices {
+		if strings.EqualFold(c.Notices[i].Title, title) {
+			return &c.Notices[i]
+		}
+	}
+	return nil
+}
+
+// TermsOfService returns the terms of service notice, found by its title
+// or a rel="terms-of-service" link, or nil if there isn't one.
+func (c *Common) TermsOfService() *RemarkJSON {
+	if n := c.Notice("Terms of Service"); n != nil {
+		return n
+	}
+	if n := c.Notice("Terms of Use"); n != nil {
+		return n
+	}
+	for i := range c.Notices {
+		if len(c.Notices[i].Links.Rel(RelTermsOfService)) > 0 {
+			return &c.Notices[i]
+		}
+	}
+	return nil
+}
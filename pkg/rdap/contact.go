@@ -0,0 +1,1006 @@
+package rdap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RFC7483 Section 5.1
+// vcardArray -- a jCard with the entity's contact information
+//
+// RFC9553 defines JSContact, a JSON representation of contact information
+// which RDAP servers are adopting in place of jCard (RFC7095) through the
+// rdap-jscontact extension's "jscard" member. RFC9555 describes converting
+// between the two, which Contact follows.
+
+// Contact is an entity's contact information decoded from either a jCard
+// or a JSContact card.
+//
+// Contexts use the JSContact values ("work" and "private") and phone
+// features the JSContact ones ("voice", "mobile", "fax", ...). They're
+// converted from jCard's "home" and "cell" types.
+type Contact struct {
+	UID string
+
+	// Kind is "individual", "org", "group", "location", etc.
+	Kind string
+
+	// Language is the language of the contact information itself, while
+	// PreferredLanguages are those to contact the entity in.
+	Language           string
+	PreferredLanguages []ContactLanguage
+
+	FullName string
+	Name     ContactName
+
+	Organizations []ContactOrganization
+	Addresses     []ContactAddress
+	Phones        []ContactPhone
+	Emails        []ContactEmail
+
+	// VCardProps are jCard properties without an equivalent above, such as
+	// "title" or "url". JSContact cards carry them in "vCardProps".
+	VCardProps []JCardProperty
+
+	// JSProps are JSContact members without an equivalent above. jCards
+	// carry them as "jsprop" properties.
+	JSProps map[string]json.RawMessage
+}
+
+// ContactName is a structured name, the jCard "n" property.
+//
+// Components are the JSContact name components the other fields were read
+// from, kept when the fields can't reproduce them: they're out of order
+// or have kinds (such as "surname2" or "generation") without a field of
+// their own. They're written back unless the fields have changed since.
+type ContactName struct {
+	Surnames   []string
+	Given      []string
+	Additional []string
+	Prefixes   []string
+	Suffixes   []string
+
+	Components []ContactComponent
+}
+
+// ContactComponent is a JSContact name or address component,
+// RFC9553 Sections 2.2.1.2 and 2.5.1.3.
+type ContactComponent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+func (n ContactName) empty() bool {
+	return len(n.Surnames)+len(n.Given)+len(n.Additional)+len(n.Prefixes)+len(n.Suffixes)+len(n.Components) == 0
+}
+
+func (n *ContactName) addComponent(comp ContactComponent) {
+	switch comp.Kind {
+	case "surname", "surname2":
+		n.Surnames = append(n.Surnames, comp.Value)
+	case "given":
+		n.Given = append(n.Given, comp.Value)
+	case "given2":
+		n.Additional = append(n.Additional, comp.Value)
+	case "title":
+		n.Prefixes = append(n.Prefixes, comp.Value)
+	case "credential", "generation":
+		n.Suffixes = append(n.Suffixes, comp.Value)
+	}
+}
+
+// keptComponents returns n.Components if they still match the other fields.
+func (n ContactName) keptComponents() []ContactComponent {
+	if len(n.Components) == 0 {
+		return nil
+	}
+	read := ContactName{Components: n.Components}
+	for _, comp := range n.Components {
+		read.addComponent(comp)
+	}
+	if !reflect.DeepEqual(read, n) {
+		return nil
+	}
+	return n.Components
+}
+
+// components returns the name as JSContact components.
+func (n ContactName) components() []ContactComponent {
+	if comps := n.keptComponents(); comps != nil {
+		return comps
+	}
+	var out []ContactComponent
+	parts := [][]string{n.Surnames, n.Given, n.Additional, n.Prefixes, n.Suffixes}
+	for i := range parts {
+		for _, v := range parts[i] {
+			out = append(out, ContactComponent{Kind: jsNameKinds[i], Value: v})
+		}
+	}
+	return out
+}
+
+type ContactLanguage struct {
+	ID       string
+	Tag      string
+	Contexts []string
+	Pref     int
+}
+
+type ContactOrganization struct {
+	ID       string
+	Name     string
+	Units    []string
+	Contexts []string
+}
+
+// ContactAddress is a postal address. Label is the full address as it
+// should be printed, which may be all a server sends.
+//
+// Components are the JSContact address components the other fields were
+// read from, kept as ContactName keeps its components.
+type ContactAddress struct {
+	ID       string
+	Contexts []string
+	Pref     int
+	Label    string
+
+	POBox       string
+	Extended    string
+	Street      []string
+	Locality    string
+	Region      string
+	PostalCode  string
+	Country     string
+	CountryCode string
+
+	Components []ContactComponent
+}
+
+func (a *ContactAddress) addComponent(comp ContactComponent) {
+	switch comp.Kind {
+	case "postOfficeBox":
+		a.POBox = joinComponent(a.POBox, comp.Value)
+	case "apartment", "room", "floor", "building":
+		a.Extended = joinComponent(a.Extended, comp.Value)
+	case "name", "number", "block", "direction", "landmark":
+		a.Street = append(a.Street, comp.Value)
+	case "locality", "district", "subdistrict":
+		a.Locality = joinComponent(a.Locality, comp.Value)
+	case "region":
+		a.Region = joinComponent(a.Region, comp.Value)
+	case "postcode":
+		a.PostalCode = joinComponent(a.PostalCode, comp.Value)
+	case "country":
+		a.Country = joinComponent(a.Country, comp.Value)
+	}
+}
+
+// keptComponents returns a.Components if they still match the other fields.
+func (a ContactAddress) keptComponents() []ContactComponent {
+	if len(a.Components) == 0 {
+		return nil
+	}
+	read := a
+	read.POBox, read.Extended, read.Street = "", "", nil
+	read.Locality, read.Region, read.PostalCode, read.Country = "", "", "", ""
+	for _, comp := range a.Components {
+		read.addComponent(comp)
+	}
+	if !reflect.DeepEqual(read, a) {
+		return nil
+	}
+	return a.Components
+}
+
+// components returns the address as JSContact components.
+func (a ContactAddress) components() []ContactComponent {
+	if comps := a.keptComponents(); comps != nil {
+		return comps
+	}
+	var out []ContactComponent
+	parts := [][]string{{a.POBox}, {a.Extended}, a.Street, {a.Locality}, {a.Region}, {a.PostalCode}, {a.Country}}
+	for i := range parts {
+		for _, v := range parts[i] {
+			if v != "" {
+				out = append(out, ContactComponent{Kind: jsAddressKinds[i], Value: v})
+			}
+		}
+	}
+	return out
+}
+
+// ContactPhone is a phone number, usually a tel: URI.
+type ContactPhone struct {
+	ID       string
+	Number   string
+	Features []string
+	Contexts []string
+	Pref     int
+}
+
+type ContactEmail struct {
+	ID       string
+	Address  string
+	Contexts []string
+	Pref     int
+}
+
+// Contact returns the entity's contact information from its "jscard"
+// member, or its vcardArray when there isn't one. It returns nil if the
+// entity has neither.
+func (e *Entity) Contact() (*Contact, error) {
+	if raw, ok := e.Extensions.Member("jscard"); ok {
+		return ParseJSContact(raw)
+	}
+	if len(e.VcardArray) == 0 {
+		return nil, nil
+	}
+	return ParseJCard(e.VcardArray)
+}
+
+// setContactFields fills the deprecated Title and Emails fields. Contact
+// information that doesn't parse leaves them empty.
+func (e *Entity) setContactFields() {
+	c, err := e.Contact()
+	if err != nil || c == nil {
+		return
+	}
+	for _, p := range c.VCardProps {
+		if strings.EqualFold(p.Name, "title") && len(p.Values) > 0 {
+			if s, ok := p.Values[0].(string); ok {
+				e.Title = s
+				break
+			}
+		}
+	}
+	for _, email := range c.Emails {
+		e.Emails = append(e.Emails, email.Address)
+	}
+}
+
+// JCardProperty is a single jCard property, encoded as the array
+// [name, parameters, type, value...].
+type JCardProperty struct {
+	Name       string
+	Parameters map[string]interface{}
+	Type       string
+	Values     []interface{}
+}
+
+func (p JCardProperty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.array())
+}
+
+func (p *JCardProperty) UnmarshalJSON(bs []byte) error {
+	var arr []interface{}
+	if err := json.Unmarshal(bs, &arr); err != nil {
+		return err
+	}
+	prop, err := parseJCardProperty(arr)
+	if err != nil {
+		return err
+	}
+	*p = prop
+	return nil
+}
+
+func (p JCardProperty) array() []interface{} {
+	params := p.Parameters
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	typ := p.Type
+	if typ == "" {
+		typ = "text"
+	}
+	return append([]interface{}{p.Name, params, typ}, p.Values...)
+}
+
+func (p JCardProperty) param(name string) []string {
+	return jcardValues(p.Parameters[name])
+}
+
+func (p JCardProperty) value() interface{} {
+	if len(p.Values) == 0 {
+		return ""
+	}
+	return p.Values[0]
+}
+
+func parseJCardProperty(v interface{}) (JCardProperty, error) {
+	arr, ok := v.([]interface{})
+	if !ok || len(arr) < 4 {
+		return JCardProperty{}, fmt.Errorf("invalid jCard property: %v", v)
+	}
+	name, _ := arr[0].(string)
+	params, _ := arr[1].(map[string]interface{})
+	typ, _ := arr[2].(string)
+	if name == "" || arr[1] != nil && params == nil {
+		return JCardProperty{}, fmt.Errorf("invalid jCard property: %v", v)
+	}
+	return JCardProperty{
+		Name:       strings.ToLower(name),
+		Parameters: params,
+		Type:       typ,
+		Values:     arr[3:],
+	}, nil
+}
+
+// ParseJCard reads a jCard (RFC7095), such as an entity's vcardArray.
+func ParseJCard(vcardArray []interface{}) (*Contact, error) {
+	if len(vcardArray) != 2 || vcardArray[0] != "vcard" {
+		return nil, errors.New("invalid jCard, expected [\"vcard\", [properties]]")
+	}
+	props, ok := vcardArray[1].([]interface{})
+	if !ok {
+		return nil, errors.New("invalid jCard, properties aren't an array")
+	}
+
+	c := &Contact{}
+	seen := make(map[string]bool)
+	for i := range props {
+		p, err := parseJCardProperty(props[i])
+		if err != nil {
+			return nil, err
+		}
+		first := !seen[p.Name]
+		seen[p.Name] = true
+
+		switch {
+		case p.Name == "version":
+			// always 4.0
+		case p.Name == "uid" && first:
+			c.UID = jcardText(p.value())
+		case p.Name == "kind" && first:
+			c.Kind = strings.ToLower(jcardText(p.value()))
+		case p.Name == "language" && first:
+			c.Language = jcardText(p.value())
+		case p.Name == "lang":
+			c.PreferredLanguages = append(c.PreferredLanguages, ContactLanguage{
+				ID:       jcardPropID(p),
+				Tag:      jcardText(p.value()),
+				Contexts: jcardContexts(p.param("type")),
+				Pref:     jcardPref(p),
+			})
+		case p.Name == "fn" && first:
+			c.FullName = jcardText(p.value())
+		case p.Name == "n" && first:
+			parts := jcardComponents(p.value(), 5)
+			c.Name = ContactName{
+				Surnames:   parts[0],
+				Given:      parts[1],
+				Additional: parts[2],
+				Prefixes:   parts[3],
+				Suffixes:   parts[4],
+			}
+		case p.Name == "org":
+			var names []string
+			for _, part := range jcardComponents(p.value(), 1) {
+				names = append(names, strings.Join(part, ","))
+			}
+			org := ContactOrganization{
+				ID:       jcardPropID(p),
+				Name:     names[0],
+				Contexts: jcardContexts(p.param("type")),
+			}
+			if len(names) > 1 {
+				org.Units = names[1:]
+			}
+			c.Organizations = append(c.Organizations, org)
+		case p.Name == "adr":
+			parts := jcardComponents(p.value(), 7)
+			c.Addresses = append(c.Addresses, ContactAddress{
+				ID:          jcardPropID(p),
+				Contexts:    jcardContexts(p.param("type")),
+				Pref:        jcardPref(p),
+				Label:       strings.Join(p.param("label"), ","),
+				POBox:       strings.Join(parts[0], ","),
+				Extended:    strings.Join(parts[1], ","),
+				Street:      parts[2],
+				Locality:    strings.Join(parts[3], ","),
+				Region:      strings.Join(parts[4], ","),
+				PostalCode:  strings.Join(parts[5], ","),
+				Country:     strings.Join(parts[6], ","),
+				CountryCode: strings.Join(p.param("cc"), ","),
+			})
+		case p.Name == "tel":
+			features, contexts := splitTelTypes(p.param("type"))
+			c.Phones = append(c.Phones, ContactPhone{
+				ID:       jcardPropID(p),
+				Number:   jcardText(p.value()),
+				Features: features,
+				Contexts: contexts,
+				Pref:     jcardPref(p),
+			})
+		case p.Name == "email":
+			c.Emails = append(c.Emails, ContactEmail{
+				ID:       jcardPropID(p),
+				Address:  jcardText(p.value()),
+				Contexts: jcardContexts(p.param("type")),
+				Pref:     jcardPref(p),
+			})
+		case p.Name == "jsprop":
+			ptr := strings.Join(p.param("jsptr"), "")
+			if ptr == "" {
+				return nil, errors.New("jsprop property without a jsptr parameter")
+			}
+			if c.JSProps == nil {
+				c.JSProps = make(map[string]json.RawMessage)
+			}
+			c.JSProps[ptr] = json.RawMessage(jcardText(p.value()))
+		default:
+			c.VCardProps = append(c.VCardProps, p)
+		}
+	}
+
+	// Name and address components jCard can't hold are sent as jsprops
+	// too, which belong with the n and adr properties.
+	takeComponents := func(ptr string) []ContactComponent {
+		var comps []ContactComponent
+		if raw, ok := c.JSProps[ptr]; ok && json.Unmarshal(raw, &comps) == nil {
+			delete(c.JSProps, ptr)
+		}
+		return comps
+	}
+	c.Name.Components = takeComponents("name/components")
+	for i := range c.Addresses {
+		if c.Addresses[i].ID != "" {
+			c.Addresses[i].Components = takeComponents("addresses/" + jsonPointerEscape(c.Addresses[i].ID) + "/components")
+		}
+	}
+	if len(c.JSProps) == 0 {
+		c.JSProps = nil
+	}
+	return c, nil
+}
+
+// JCard encodes the contact as a jCard, ready to be used as an entity's
+// vcardArray.
+func (c *Contact) JCard() []interface{} {
+	var props []interface{}
+	add := func(name string, params map[string]interface{}, typ string, values ...interface{}) {
+		props = append(props, JCardProperty{Name: name, Parameters: params, Type: typ, Values: values}.array())
+	}
+
+	add("version", nil, "text", "4.0")
+	if c.UID != "" {
+		add("uid", nil, "text", c.UID)
+	}
+	if c.Kind != "" {
+		add("kind", nil, "text", c.Kind)
+	}
+	if c.Language != "" {
+		add("language", nil, "language-tag", c.Language)
+	}
+	for _, l := range c.PreferredLanguages {
+		add("lang", jcardParams(l.ID, l.Contexts, nil, l.Pref), "language-tag", l.Tag)
+	}
+	if c.FullName != "" {
+		add("fn", nil, "text", c.FullName)
+	}
+	if !c.Name.empty() {
+		add("n", nil, "text", []interface{}{
+			jcardComponent(c.Name.Surnames),
+			jcardComponent(c.Name.Given),
+			jcardComponent(c.Name.Additional),
+			jcardComponent(c.Name.Prefixes),
+			jcardComponent(c.Name.Suffixes),
+		})
+	}
+	for _, o := range c.Organizations {
+		var value interface{} = o.Name
+		if len(o.Units) > 0 {
+			parts := []interface{}{o.Name}
+			for _, u := range o.Units {
+				parts = append(parts, u)
+			}
+			value = parts
+		}
+		add("org", jcardParams(o.ID, o.Contexts, nil, 0), "text", value)
+	}
+	var comps []JCardProperty
+	if kept := c.Name.keptComponents(); kept != nil {
+		comps = append(comps, jcardComponentsProp("name/components", kept))
+	}
+	for i, a := range c.Addresses {
+		id := a.ID
+		if kept := a.keptComponents(); kept != nil {
+			id = itemID(a.ID, "addr", i)
+			comps = append(comps, jcardComponentsProp("addresses/"+jsonPointerEscape(id)+"/components", kept))
+		}
+		params := jcardParams(id, a.Contexts, nil, a.Pref)
+		if a.Label != "" {
+			params["label"] = a.Label
+		}
+		if a.CountryCode != "" {
+			params["cc"] = a.CountryCode
+		}
+		add("adr", params, "text", []interface{}{
+			a.POBox, a.Extended, jcardComponent(a.Street), a.Locality, a.Region, a.PostalCode, a.Country,
+		})
+	}
+	for _, p := range c.Phones {
+		typ := "text"
+		if strings.HasPrefix(strings.ToLower(p.Number), "tel:") {
+			typ = "uri"
+		}
+		add("tel", jcardParams(p.ID, p.Contexts, p.Features, p.Pref), typ, p.Number)
+	}
+	for _, e := range c.Emails {
+		add("email", jcardParams(e.ID, e.Contexts, nil, e.Pref), "text", e.Address)
+	}
+	for _, p := range c.VCardProps {
+		props = append(props, p.array())
+	}
+	for _, p := range comps {
+		props = append(props, p.array())
+	}
+	for _, name := range sortedRawKeys(c.JSProps) {
+		add("jsprop", map[string]interface{}{"jsptr": name}, "text", string(c.JSProps[name]))
+	}
+	return []interface{}{"vcard", props}
+}
+
+// jsCard is a JSContact Card, RFC9553 Section 2.
+type jsCard struct {
+	Type               string                    `json:"@type"`
+	Version            string                    `json:"version"`
+	UID                string                    `json:"uid,omitempty"`
+	Kind               string                    `json:"kind,omitempty"`
+	Language           string                    `json:"language,omitempty"`
+	PreferredLanguages map[string]jsLanguagePref `json:"preferredLanguages,omitempty"`
+	Name               *jsName                   `json:"name,omitempty"`
+	Organizations      map[string]jsOrganization `json:"organizations,omitempty"`
+	Addresses          map[string]jsAddress      `json:"addresses,omitempty"`
+	Phones             map[string]jsPhone        `json:"phones,omitempty"`
+	Emails             map[string]jsEmail        `json:"emails,omitempty"`
+	VCardProps         []JCardProperty           `json:"vCardProps,omitempty"`
+}
+
+type jsLanguagePref struct {
+	Language string          `json:"language"`
+	Contexts map[string]bool `json:"contexts,omitempty"`
+	Pref     int             `json:"pref,omitempty"`
+}
+
+type jsName struct {
+	Components []ContactComponent `json:"components,omitempty"`
+	Full       string             `json:"full,omitempty"`
+}
+
+type jsOrganization struct {
+	Name     string          `json:"name,omitempty"`
+	Units    []jsOrgUnit     `json:"units,omitempty"`
+	Contexts map[string]bool `json:"contexts,omitempty"`
+}
+
+type jsOrgUnit struct {
+	Name string `json:"name"`
+}
+
+type jsAddress struct {
+	Components  []ContactComponent `json:"components,omitempty"`
+	CountryCode string             `json:"countryCode,omitempty"`
+	Full        string             `json:"full,omitempty"`
+	Contexts    map[string]bool    `json:"contexts,omitempty"`
+	Pref        int                `json:"pref,omitempty"`
+}
+
+type jsPhone struct {
+	Number   string          `json:"number"`
+	Features map[string]bool `json:"features,omitempty"`
+	Contexts map[string]bool `json:"contexts,omitempty"`
+	Pref     int             `json:"pref,omitempty"`
+}
+
+type jsEmail struct {
+	Address  string          `json:"address"`
+	Contexts map[string]bool `json:"contexts,omitempty"`
+	Pref     int             `json:"pref,omitempty"`
+}
+
+// RFC9553 Section 2.2.1.2 name component kinds, in jCard "n" order
+var jsNameKinds = []string{"surname", "given", "given2", "title", "credential"}
+
+// RFC9553 Section 2.5.1.3 address component kinds, in jCard "adr" order
+var jsAddressKinds = []string{"postOfficeBox", "apartment", "name", "locality", "region", "postcode", "country"}
+
+// ParseJSContact reads a JSContact Card (RFC9553), such as an entity's
+// "jscard" member.
+func ParseJSContact(bs []byte) (*Contact, error) {
+	var card jsCard
+	ext, err := unmarshalObject(bs, &card)
+	if err != nil {
+		return nil, err
+	}
+	if card.Type != "" && card.Type != "Card" {
+		return nil, fmt.Errorf("invalid JSContact card, @type is %q", card.Type)
+	}
+
+	c := &Contact{
+		UID:        card.UID,
+		Kind:       card.Kind,
+		Language:   card.Language,
+		VCardProps: card.VCardProps,
+	}
+	for prefix, group := range ext {
+		if prefix == emptyMembers {
+			continue
+		}
+		for name, raw := range group {
+			if c.JSProps == nil {
+				c.JSProps = make(map[string]json.RawMessage)
+			}
+			c.JSProps[name] = raw
+		}
+	}
+
+	for _, id := range sortedIDs(card.PreferredLanguages) {
+		l := card.PreferredLanguages[id]
+		c.PreferredLanguages = append(c.PreferredLanguages, ContactLanguage{
+			ID: id, Tag: l.Language, Contexts: jsKeys(l.Contexts), Pref: l.Pref,
+		})
+	}
+	if card.Name != nil {
+		c.FullName = card.Name.Full
+		for _, comp := range card.Name.Components {
+			c.Name.addComponent(comp)
+		}
+		if !reflect.DeepEqual(c.Name.components(), card.Name.Components) {
+			c.Name.Components = card.Name.Components
+		}
+	}
+	for _, id := range sortedIDs(card.Organizations) {
+		o := card.Organizations[id]
+		org := ContactOrganization{ID: id, Name: o.Name, Contexts: jsKeys(o.Contexts)}
+		for _, u := range o.Units {
+			org.Units = append(org.Units, u.Name)
+		}
+		c.Organizations = append(c.Organizations, org)
+	}
+	for _, id := range sortedIDs(card.Addresses) {
+		a := card.Addresses[id]
+		addr := ContactAddress{
+			ID:          id,
+			Contexts:    jsKeys(a.Contexts),
+			Pref:        a.Pref,
+			Label:       a.Full,
+			CountryCode: a.CountryCode,
+		}
+		for _, comp := range a.Components {
+			addr.addComponent(comp)
+		}
+		if !reflect.DeepEqual(addr.components(), a.Components) {
+			addr.Components = a.Components
+		}
+		c.Addresses = append(c.Addresses, addr)
+	}
+	for _, id := range sortedIDs(card.Phones) {
+		p := card.Phones[id]
+		c.Phones = append(c.Phones, ContactPhone{
+			ID: id, Number: p.Number, Features: jsKeys(p.Features), Contexts: jsKeys(p.Contexts), Pref: p.Pref,
+		})
+	}
+	for _, id := range sortedIDs(card.Emails) {
+		e := card.Emails[id]
+		c.Emails = append(c.Emails, ContactEmail{
+			ID: id, Address: e.Address, Contexts: jsKeys(e.Contexts), Pref: e.Pref,
+		})
+	}
+	return c, nil
+}
+
+// JSContact encodes the contact as a JSContact Card, ready to be used as
+// an entity's "jscard" member. Items without an ID are given one.
+func (c *Contact) JSContact() (json.RawMessage, error) {
+	card := jsCard{
+		Type:       "Card",
+		Version:    "1.0",
+		UID:        c.UID,
+		Kind:       c.Kind,
+		Language:   c.Language,
+		VCardProps: c.VCardProps,
+	}
+
+	for i, l := range c.PreferredLanguages {
+		if card.PreferredLanguages == nil {
+			card.PreferredLanguages = make(map[string]jsLanguagePref)
+		}
+		card.PreferredLanguages[itemID(l.ID, "lang", i)] = jsLanguagePref{
+			Language: l.Tag, Contexts: jsSet(l.Contexts), Pref: l.Pref,
+		}
+	}
+	if c.FullName != "" || !c.Name.empty() {
+		card.Name = &jsName{Full: c.FullName, Components: c.Name.components()}
+	}
+	for i, o := range c.Organizations {
+		if card.Organizations == nil {
+			card.Organizations = make(map[string]jsOrganization)
+		}
+		org := jsOrganization{Name: o.Name, Contexts: jsSet(o.Contexts)}
+		for _, u := range o.Units {
+			org.Units = append(org.Units, jsOrgUnit{Name: u})
+		}
+		card.Organizations[itemID(o.ID, "org", i)] = org
+	}
+	for i, a := range c.Addresses {
+		if card.Addresses == nil {
+			card.Addresses = make(map[string]jsAddress)
+		}
+		card.Addresses[itemID(a.ID, "addr", i)] = jsAddress{
+			Components:  a.components(),
+			CountryCode: a.CountryCode,
+			Full:        a.Label,
+			Contexts:    jsSet(a.Contexts),
+			Pref:        a.Pref,
+		}
+	}
+	for i, p := range c.Phones {
+		if card.Phones == nil {
+			card.Phones = make(map[string]jsPhone)
+		}
+		card.Phones[itemID(p.ID, "tel", i)] = jsPhone{
+			Number: p.Number, Features: jsSet(p.Features), Contexts: jsSet(p.Contexts), Pref: p.Pref,
+		}
+	}
+	for i, e := range c.Emails {
+		if card.Emails == nil {
+			card.Emails = make(map[string]jsEmail)
+		}
+		card.Emails[itemID(e.ID, "email", i)] = jsEmail{
+			Address: e.Address, Contexts: jsSet(e.Contexts), Pref: e.Pref,
+		}
+	}
+
+	ext := make(Extensions)
+	for name, raw := range c.JSProps {
+		ext.Set(name, raw)
+	}
+	return marshalObject(card, ext)
+}
+
+// jcardText returns a jCard value as a string, joining structured values.
+func jcardText(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case nil:
+		return ""
+	case []interface{}:
+		var parts []string
+		for i := range t {
+			parts = append(parts, jcardText(t[i]))
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(v)
+}
+
+// jcardValues reads a jCard parameter or component which is either a
+// string or an array of strings.
+func jcardValues(v interface{}) []string {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var out []string
+		for i := range t {
+			out = append(out, jcardText(t[i]))
+		}
+		return out
+	case string:
+		if t == "" {
+			return nil
+		}
+	}
+	return []string{jcardText(v)}
+}
+
+// jcardComponents splits a structured jCard value into at least n components,
+// each of which may have several values.
+func jcardComponents(v interface{}, n int) [][]string {
+	var out [][]string
+	if arr, ok := v.([]interface{}); ok {
+		for i := range arr {
+			out = append(out, jcardValues(arr[i]))
+		}
+	} else {
+		out = append(out, jcardValues(v))
+	}
+	for len(out) < n {
+		out = append(out, nil)
+	}
+	if n == 1 && len(out[0]) == 0 {
+		out[0] = []string{""}
+	}
+	return out
+}
+
+// jcardComponent encodes a structured jCard component with zero or more values.
+func jcardComponent(values []string) interface{} {
+	switch len(values) {
+	case 0:
+		return ""
+	case 1:
+		return values[0]
+	}
+	out := make([]interface{}, len(values))
+	for i := range values {
+		out[i] = values[i]
+	}
+	return out
+}
+
+func joinComponent(a, b string) string {
+	if a == "" {
+		return b
+	}
+	return a + " " + b
+}
+
+// jcardComponentsProp encodes JSContact name or address components as a
+// jsprop property.
+func jcardComponentsProp(ptr string, comps []ContactComponent) JCardProperty {
+	bs, _ := json.Marshal(comps)
+	return JCardProperty{
+		Name:       "jsprop",
+		Parameters: map[string]interface{}{"jsptr": ptr},
+		Type:       "text",
+		Values:     []interface{}{string(bs)},
+	}
+}
+
+// jsonPointerEscape escapes a JSON Pointer (RFC6901) reference token.
+func jsonPointerEscape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+// jcardPropID returns the JSContact id of a property, if it has one.
+func jcardPropID(p JCardProperty) string {
+	return strings.Join(p.param("prop-id"), "")
+}
+
+func jcardPref(p JCardProperty) int {
+	n, _ := strconv.Atoi(strings.Join(p.param("pref"), ""))
+	return n
+}
+
+// jcardContexts converts jCard type parameter values to JSContact contexts,
+// sorted as they would be read from a JSContact set.
+func jcardContexts(types []string) []string {
+	var out []string
+	for _, t := range types {
+		switch t = strings.ToLower(t); t {
+		case "home":
+			out = append(out, "private")
+		default:
+			out = append(out, t)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// splitTelTypes separates the contexts and features of a tel property,
+// which jCard lists together in its type parameter.
+func splitTelTypes(types []string) (features, contexts []string) {
+	for _, t := range jcardContexts(types) {
+		switch t {
+		case "work", "private":
+			contexts = append(contexts, t)
+		case "cell":
+			features = append(features, "mobile")
+		default:
+			features = append(features, t)
+		}
+	}
+	sort.Strings(features)
+	return features, contexts
+}
+
+// jcardParams builds the parameters of a jCard property from a JSContact
+// item's id, contexts and features. The id is kept in the prop-id
+// parameter (RFC9554), as RFC9555 converts it.
+func jcardParams(id string, contexts, features []string, pref int) map[string]interface{} {
+	var types []interface{}
+	for _, c := range contexts {
+		if c == "private" {
+			c = "home"
+		}
+		types = append(types, c)
+	}
+	for _, f := range features {
+		if f == "mobile" {
+			f = "cell"
+		}
+		types = append(types, f)
+	}
+
+	params := make(map[string]interface{})
+	switch len(types) {
+	case 0:
+	case 1:
+		params["type"] = types[0]
+	default:
+		params["type"] = types
+	}
+	if pref > 0 {
+		params["pref"] = strconv.Itoa(pref)
+	}
+	if id != "" {
+		params["prop-id"] = id
+	}
+	return params
+}
+
+// jsKeys returns the keys of a JSContact set (e.g. {"work": true}) in
+// sorted order.
+func jsKeys(m map[string]bool) []string {
+	var out []string
+	for k, v := range m {
+		if v {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func jsSet(keys []string) map[string]bool {
+	if len(keys) == 0 {
+		return nil
+	}
+	out := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		out[k] = true
+	}
+	return out
+}
+
+func itemID(id, prefix string, i int) string {
+	if id != "" {
+		return id
+	}
+	return fmt.Sprintf("%s%d", prefix, i+1)
+}
+
+// sortedIDs returns the ids of a JSContact map (e.g. "tel2") ordered by
+// their prefix and then number, which keeps items in the order JSContact
+// assigned them.
+func sortedIDs(m interface{}) []string {
+	var ids []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		ids = append(ids, k.String())
+	}
+	split := func(id string) (string, int) {
+		i := len(id)
+		for i > 0 && id[i-1] >= '0' && id[i-1] <= '9' {
+			i--
+		}
+		n, _ := strconv.Atoi(id[i:])
+		return id[:i], n
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		pi, ni := split(ids[i])
+		pj, nj := split(ids[j])
+		if pi != pj || ni == nj {
+			return ids[i] < ids[j]
+		}
+		return ni < nj
+	})
+	return ids
+}
+
+func sortedRawKeys(m map[string]json.RawMessage) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,329 @@
+package rdap
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func readEntity(t *testing.T, path string) *Entity {
+	t.Helper()
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e Entity
+	if err := json.Unmarshal(bs, &e); err != nil {
+		t.Fatal(err)
+	}
+	return &e
+}
+
+func TestContact__jcard(t *testing.T) {
+	entity := readEntity(t, "../../testdata/rfc-7483-section-5-1-example.json")
+	c, err := entity.Contact()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Kind != "individual" || c.FullName != "Joe User" {
+		t.Errorf("kind=%q fn=%q", c.Kind, c.FullName)
+	}
+	expectedName := ContactName{
+		Surnames: []string{"User"},
+		Given:    []string{"Joe"},
+		Suffixes: []string{"ing. jr", "M.Sc."},
+	}
+	if !reflect.DeepEqual(c.Name, expectedName) {
+		t.Errorf("got %#v", c.Name)
+	}
+	if len(c.PreferredLanguages) != 2 || c.PreferredLanguages[0].Tag != "fr" || c.PreferredLanguages[0].Pref != 1 {
+		t.Errorf("got %#v", c.PreferredLanguages)
+	}
+	if len(c.Organizations) != 1 || c.Organizations[0].Name != "Example" {
+		t.Errorf("got %#v", c.Organizations)
+	}
+	if len(c.Addresses) != 2 {
+		t.Fatalf("got %#v", c.Addresses)
+	}
+	if a := c.Addresses[0]; a.Locality != "Quebec" || a.Street[0] != "4321 Rue Somewhere" || a.Contexts[0] != "work" {
+		t.Errorf("got %#v", a)
+	}
+	if a := c.Addresses[1]; a.Label == "" || a.Contexts[0] != "private" {
+		t.Errorf("got %#v", a)
+	}
+	if len(c.Phones) != 2 {
+		t.Fatalf("got %#v", c.Phones)
+	}
+	if p := c.Phones[1]; !reflect.DeepEqual(p.Features, []string{"mobile", "text", "video", "voice"}) || p.Contexts[0] != "work" {
+		t.Errorf("got %#v", p)
+	}
+	if len(c.Emails) != 1 || c.Emails[0].Address != "joe.user@example.com" {
+		t.Errorf("got %#v", c.Emails)
+	}
+	if len(c.VCardProps) != 6 || c.VCardProps[0].Name != "title" {
+		t.Errorf("got %#v", c.VCardProps)
+	}
+
+	// jCard -> Contact -> jCard
+	again, err := ParseJCard(roundTripJSON(t, c.JCard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c, again) {
+		t.Errorf("jCard round trip\n got %#v\n expected %#v", again, c)
+	}
+
+	// jCard -> Contact -> JSContact -> Contact
+	card, err := c.JSContact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err = ParseJSContact(card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clearIDs(again)
+	if !reflect.DeepEqual(c, again) {
+		t.Errorf("JSContact round trip\n got %#v\n expected %#v", again, c)
+	}
+}
+
+func TestContact__jscontact(t *testing.T) {
+	bs := []byte(`{
+  "objectClassName": "entity",
+  "handle": "XXXX",
+  "roles": ["registrant"],
+  "jscard": {
+    "@type": "Card",
+    "version": "1.0",
+    "uid": "urn:uuid:22e4d7a9-7e0c-4d87-8a43-1e0b2d58d1c1",
+    "kind": "org",
+    "language": "en",
+    "name": {"full": "Example Inc."},
+    "organizations": {"org": {"name": "Example Inc.", "units": [{"name": "Legal"}]}},
+    "addresses": {
+      "addr": {
+        "components": [
+          {"kind": "number", "value": "4321"},
+          {"kind": "name", "value": "Rue Somewhere"},
+          {"kind": "locality", "value": "Quebec"},
+          {"kind": "region", "value": "QC"},
+          {"kind": "postcode", "value": "G1V 2M2"}
+        ],
+        "countryCode": "CA",
+        "contexts": {"work": true}
+      }
+    },
+    "phones": {
+      "fax": {"number": "tel:+1-555-555-5555", "features": {"fax": true}},
+      "voice": {"number": "tel:+1-555-555-1234", "features": {"voice": true}, "pref": 1}
+    },
+    "emails": {"email": {"address": "legal@example.com"}},
+    "example.com:note": {"text":"hello"}
+  }
+}`)
+	var entity Entity
+	if err := json.Unmarshal(bs, &entity); err != nil {
+		t.Fatal(err)
+	}
+	c, err := entity.Contact()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Kind != "org" || c.Language != "en" || c.FullName != "Example Inc." {
+		t.Errorf("got %#v", c)
+	}
+	if len(c.Organizations) != 1 || c.Organizations[0].Units[0] != "Legal" {
+		t.Errorf("got %#v", c.Organizations)
+	}
+	if a := c.Addresses[0]; !reflect.DeepEqual(a.Street, []string{"4321", "Rue Somewhere"}) || a.CountryCode != "CA" || a.PostalCode != "G1V 2M2" {
+		t.Errorf("got %#v", a)
+	}
+	if len(c.Phones) != 2 || c.Phones[0].ID != "fax" || c.Phones[1].Pref != 1 {
+		t.Errorf("got %#v", c.Phones)
+	}
+	if string(c.JSProps["example.com:note"]) != `{"text":"hello"}` {
+		t.Errorf("got %s", c.JSProps["example.com:note"])
+	}
+
+	// JSContact -> Contact -> jCard -> Contact keeps ids as prop-id
+	again, err := ParseJCard(roundTripJSON(t, c.JCard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c, again) {
+		t.Errorf("jCard round trip\n got %#v\n expected %#v", again, c)
+	}
+
+	// JSContact -> Contact -> JSContact keeps ids too
+	card, err := c.JSContact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err = ParseJSContact(card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c, again) {
+		t.Errorf("JSContact round trip\n got %#v\n expected %#v", again, c)
+	}
+}
+
+func TestContact__jscontactComponents(t *testing.T) {
+	in := []byte(`{
+  "@type": "Card",
+  "version": "1.0",
+  "name": {
+    "components": [
+      {"kind": "given", "value": "Juan"},
+      {"kind": "surname", "value": "García"},
+      {"kind": "surname2", "value": "López"},
+      {"kind": "generation", "value": "Jr."},
+      {"kind": "credential", "value": "PhD"}
+    ]
+  },
+  "addresses": {
+    "addr1": {
+      "components": [
+        {"kind": "number", "value": "12"},
+        {"kind": "name", "value": "Calle Mayor"},
+        {"kind": "direction", "value": "Norte"},
+        {"kind": "block", "value": "B"},
+        {"kind": "building", "value": "Torre 2"},
+        {"kind": "floor", "value": "3"},
+        {"kind": "room", "value": "301"},
+        {"kind": "apartment", "value": "A"},
+        {"kind": "landmark", "value": "frente al parque"},
+        {"kind": "locality", "value": "Madrid"},
+        {"kind": "postcode", "value": "28013"}
+      ],
+      "countryCode": "ES"
+    }
+  }
+}`)
+	c, err := ParseJSContact(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c.Name.Surnames, []string{"García", "López"}) || c.Addresses[0].Extended != "Torre 2 3 301 A" {
+		t.Errorf("got %#v", c)
+	}
+
+	// JSContact -> Contact -> JSContact
+	out, err := c.JSContact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, got := normalizeJSON(t, in), normalizeJSON(t, out); got != expected {
+		t.Errorf("JSContact round trip\n got %s\n expected %s", got, expected)
+	}
+
+	// JSContact -> Contact -> jCard -> Contact -> JSContact
+	again, err := ParseJCard(roundTripJSON(t, c.JCard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c, again) {
+		t.Errorf("jCard round trip\n got %#v\n expected %#v", again, c)
+	}
+	out, err = again.JSContact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, got := normalizeJSON(t, in), normalizeJSON(t, out); got != expected {
+		t.Errorf("jCard round trip\n got %s\n expected %s", got, expected)
+	}
+
+	// changed fields win over the components they were read from
+	c.Name.Surnames = []string{"García"}
+	out, err = c.JSContact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(out, []byte("López")) || bytes.Contains(out, []byte("surname2")) {
+		t.Errorf("got %s", out)
+	}
+}
+
+func TestContact__none(t *testing.T) {
+	c, err := (&Entity{Handle: "XXXX"}).Contact()
+	if c != nil || err != nil {
+		t.Errorf("got %#v (err=%v)", c, err)
+	}
+	if _, err := ParseJCard([]interface{}{"vcard"}); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := ParseJSContact([]byte(`{"@type": "Group"}`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+// normalizeJSON re-encodes bs with its object members sorted.
+func normalizeJSON(t *testing.T, bs []byte) string {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal(bs, &v); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+// roundTripJSON encodes and decodes v as it would be when sent by a server.
+func roundTripJSON(t *testing.T, v []interface{}) []interface{} {
+	t.Helper()
+	bs, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []interface{}
+	if err := json.Unmarshal(bs, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func clearIDs(c *Contact) {
+	c.PreferredLanguages = append([]ContactLanguage(nil), c.PreferredLanguages...)
+	for i := range c.PreferredLanguages {
+		c.PreferredLanguages[i].ID = ""
+	}
+	c.Organizations = append([]ContactOrganization(nil), c.Organizations...)
+	for i := range c.Organizations {
+		c.Organizations[i].ID = ""
+	}
+	c.Addresses = append([]ContactAddress(nil), c.Addresses...)
+	for i := range c.Addresses {
+		c.Addresses[i].ID = ""
+	}
+	c.Phones = append([]ContactPhone(nil), c.Phones...)
+	for i := range c.Phones {
+		c.Phones[i].ID = ""
+	}
+	c.Emails = append([]ContactEmail(nil), c.Emails...)
+	for i := range c.Emails {
+		c.Emails[i].ID = ""
+	}
+}
+
+func TestContact__deprecatedFields(t *testing.T) {
+	entity := readEntity(t, "../../testdata/rfc-7483-section-5-1-example.json")
+	if entity.Title != "Research Scientist" {
+		t.Errorf("got %q", entity.Title)
+	}
+	if !reflect.DeepEqual(entity.Emails, []string{"joe.user@example.com"}) {
+		t.Errorf("got %v", entity.Emails)
+	}
+
+	var old EntityJSON = *entity
+	if old.Handle != "XXXX" {
+		t.Errorf("got %q", old.Handle)
+	}
+}
@@ -0,0 +1,403 @@
+package rdap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// RFC7483 Section 4.9
+// An objectClassName is REQUIRED in all RDAP response objects so that
+// the type of the object can be interpreted.
+
+// Object class names, RFC7483 Section 5
+const (
+	ClassDomain     = "domain"
+	ClassEntity     = "entity"
+	ClassNameserver = "nameserver"
+	ClassIPNetwork  = "ip network"
+	ClassAutnum     = "autnum"
+)
+
+// RFC7483 Section 7
+// The appropriate response to /help queries as defined by [RFC7482] is
+// to use the notices structure as defined in Section 4.3.
+
+// Help is the response to a /help query.
+type Help struct {
+	Common
+}
+
+// RFC7483 Section 8
+// for /domains searches, the array is "domainSearchResults"
+type DomainSearchResults struct {
+	Results []Domain `json:"domainSearchResults"`
+
+	Common
+}
+
+// for /nameservers searches, the array is "nameserverSearchResults"
+type NameserverSearchResults struct {
+	Results []Nameserver `json:"nameserverSearchResults"`
+
+	Common
+}
+
+// for /entities searches, the array is "entitySearchResults"
+type EntitySearchResults struct {
+	Results []Entity `json:"entitySearchResults"`
+
+	Common
+}
+
+// for ARIN's arin_originas0 networks by origin AS searches, the array is
+// "arin_originas0_networkSearchResults"
+type IPNetworkSearchResults struct {
+	Results []IPNetwork `json:"arin_originas0_networkSearchResults"`
+
+	Common
+}
+
+// Decode reads any RDAP response: an object (Domain, Entity, Nameserver,
+// IPNetwork or Autnum), search results, an Error or Help. The type
+// returned is chosen by the objectClassName member or, when there isn't
+// one, the shape of the response.
+//
+// Every decoded object, including nested ones, keeps its original JSON
+// in Raw so stored responses can be re-hydrated without knowing their
+// type up front.
+func Decode(bs []byte) (Object, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &members); err != nil {
+		return nil, fmt.Errorf("invalid RDAP response: %v", err)
+	}
+
+	var obj Object
+	if raw, ok := members["objectClassName"]; ok {
+		var class string
+		if err := json.Unmarshal(raw, &class); err != nil {
+			return nil, fmt.Errorf("invalid objectClassName: %s", raw)
+		}
+		switch class {
+		case ClassDomain:
+			obj = &Domain{}
+		case ClassEntity:
+			obj = &Entity{}
+		case ClassNameserver:
+			obj = &Nameserver{}
+		case ClassIPNetwork:
+			obj = &IPNetwork{}
+		case ClassAutnum:
+			obj = &Autnum{}
+		default:
+			return nil, fmt.Errorf("unknown objectClassName: %q", class)
+		}
+	} else {
+		switch {
+		case has(members, "errorCode"):
+			obj = &Error{}
+		case has(members, "domainSearchResults"):
+			obj = &DomainSearchResults{}
+		case has(members, "nameserverSearchResults"):
+			obj = &NameserverSearchResults{}
+		case has(members, "entitySearchResults"):
+			obj = &EntitySearchResults{}
+		case has(members, "arin_originas0_networkSearchResults"):
+			obj = &IPNetworkSearchResults{}
+		case has(members, "notices"):
+			obj = &Help{}
+		default:
+			return nil, errors.New("unknown RDAP response, no objectClassName or known members")
+		}
+	}
+
+	if err := json.Unmarshal(bs, obj); err != nil {
+		return nil, err
+	}
+	if err := checkClasses(obj); err != nil {
+		return obj, err
+	}
+	return obj, nil
+}
+
+func has(members map[string]json.RawMessage, name string) bool {
+	_, ok := members[name]
+	return ok
+}
+
+// checkClasses verifies nested objects carry the objectClassName expected
+// for the member they're found in. Missing class names are allowed as
+// some servers leave them off nested objects.
+func checkClasses(obj Object) error {
+	check := func(where, got, expected string) error {
+		if got != "" && got != expected {
+			return fmt.Errorf("unexpected objectClassName %q in %s, expected %q", got, where, expected)
+		}
+		return nil
+	}
+
+	var err error
+	switch v := obj.(type) {
+	case *Domain:
+		for i := range v.Nameservers {
+			if err = check("nameservers", v.Nameservers[i].ObjectClassName, ClassNameserver); err != nil {
+				return err
+			}
+			if err = checkClasses(&v.Nameservers[i]); err != nil {
+				return err
+			}
+		}
+		if v.Network != nil {
+			if err = check("network", v.Network.ObjectClassName, ClassIPNetwork); err != nil {
+				return err
+			}
+		}
+	case *Entity:
+		for i := range v.Networks {
+			if err = check("networks", v.Networks[i].ObjectClassName, ClassIPNetwork); err != nil {
+				return err
+			}
+		}
+		for i := range v.Autnums {
+			if err = check("autnums", v.Autnums[i].ObjectClassName, ClassAutnum); err != nil {
+				return err
+			}
+		}
+	case *DomainSearchResults:
+		for i := range v.Results {
+			if err = check("domainSearchResults", v.Results[i].ObjectClassName, ClassDomain); err != nil {
+				return err
+			}
+			if err = checkClasses(&v.Results[i]); err != nil {
+				return err
+			}
+		}
+	case *IPNetworkSearchResults:
+		for i := range v.Results {
+			if err = check("arin_originas0_networkSearchResults", v.Results[i].ObjectClassName, ClassIPNetwork); err != nil {
+				return err
+			}
+		}
+	case *NameserverSearchResults:
+		for i := range v.Results {
+			if err = check("nameserverSearchResults", v.Results[i].ObjectClassName, ClassNameserver); err != nil {
+				return err
+			}
+			if err = checkClasses(&v.Results[i]); err != nil {
+				return err
+			}
+		}
+	case *EntitySearchResults:
+		for i := range v.Results {
+			if err = check("entitySearchResults", v.Results[i].ObjectClassName, ClassEntity); err != nil {
+				return err
+			}
+			if err = checkClasses(&v.Results[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, e := range obj.Base().Entities {
+		if err = check("entities", e.ObjectClassName, ClassEntity); err != nil {
+			return err
+		}
+		if err = checkClasses(&e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The methods below decode and encode each type as normal, while keeping
+// a copy of the original JSON in Common.Raw and any unrecognized members
+// in Common.Extensions. The local types drop the methods so encoding/json
+// doesn't recurse.
+
+func (d *Domain) UnmarshalJSON(bs []byte) error {
+	type domain Domain
+	var v domain
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*d = Domain(v)
+	d.setDecoded(bs, ext)
+	return nil
+}
+
+func (d Domain) MarshalJSON() ([]byte, error) {
+	type domain Domain
+	return marshalObject(domain(d), d.Extensions)
+}
+
+func (e *Entity) UnmarshalJSON(bs []byte) error {
+	type entity Entity
+	var v entity
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*e = Entity(v)
+	e.setDecoded(bs, ext)
+	e.setContactFields()
+	return nil
+}
+
+func (e Entity) MarshalJSON() ([]byte, error) {
+	type entity Entity
+	return marshalObject(entity(e), e.Extensions)
+}
+
+func (n *Nameserver) UnmarshalJSON(bs []byte) error {
+	type nameserver Nameserver
+	var v nameserver
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*n = Nameserver(v)
+	n.setDecoded(bs, ext)
+	return nil
+}
+
+func (n Nameserver) MarshalJSON() ([]byte, error) {
+	type nameserver Nameserver
+	return marshalObject(nameserver(n), n.Extensions)
+}
+
+func (n *IPNetwork) UnmarshalJSON(bs []byte) error {
+	type ipNetwork IPNetwork
+	var v ipNetwork
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*n = IPNetwork(v)
+	n.setDecoded(bs, ext)
+	return nil
+}
+
+func (n IPNetwork) MarshalJSON() ([]byte, error) {
+	type ipNetwork IPNetwork
+	return marshalObject(ipNetwork(n), n.Extensions)
+}
+
+func (a *Autnum) UnmarshalJSON(bs []byte) error {
+	type autnum Autnum
+	var v autnum
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*a = Autnum(v)
+	a.setDecoded(bs, ext)
+	return nil
+}
+
+func (a Autnum) MarshalJSON() ([]byte, error) {
+	type autnum Autnum
+	return marshalObject(autnum(a), a.Extensions)
+}
+
+func (e *Error) UnmarshalJSON(bs []byte) error {
+	type rdapError Error
+	var v rdapError
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*e = Error(v)
+	e.setDecoded(bs, ext)
+	return nil
+}
+
+func (e Error) MarshalJSON() ([]byte, error) {
+	type rdapError Error
+	return marshalObject(rdapError(e), e.Extensions)
+}
+
+func (h *Help) UnmarshalJSON(bs []byte) error {
+	type help Help
+	var v help
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*h = Help(v)
+	h.setDecoded(bs, ext)
+	return nil
+}
+
+func (h Help) MarshalJSON() ([]byte, error) {
+	type help Help
+	return marshalObject(help(h), h.Extensions)
+}
+
+func (r *DomainSearchResults) UnmarshalJSON(bs []byte) error {
+	type domainSearchResults DomainSearchResults
+	var v domainSearchResults
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*r = DomainSearchResults(v)
+	r.setDecoded(bs, ext)
+	return nil
+}
+
+func (r DomainSearchResults) MarshalJSON() ([]byte, error) {
+	type domainSearchResults DomainSearchResults
+	return marshalObject(domainSearchResults(r), r.Extensions)
+}
+
+func (r *NameserverSearchResults) UnmarshalJSON(bs []byte) error {
+	type nameserverSearchResults NameserverSearchResults
+	var v nameserverSearchResults
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*r = NameserverSearchResults(v)
+	r.setDecoded(bs, ext)
+	return nil
+}
+
+func (r NameserverSearchResults) MarshalJSON() ([]byte, error) {
+	type nameserverSearchResults NameserverSearchResults
+	return marshalObject(nameserverSearchResults(r), r.Extensions)
+}
+
+func (r *EntitySearchResults) UnmarshalJSON(bs []byte) error {
+	type entitySearchResults EntitySearchResults
+	var v entitySearchResults
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*r = EntitySearchResults(v)
+	r.setDecoded(bs, ext)
+	return nil
+}
+
+func (r EntitySearchResults) MarshalJSON() ([]byte, error) {
+	type entitySearchResults EntitySearchResults
+	return marshalObject(entitySearchResults(r), r.Extensions)
+}
+
+func (r *IPNetworkSearchResults) UnmarshalJSON(bs []byte) error {
+	type ipNetworkSearchResults IPNetworkSearchResults
+	var v ipNetworkSearchResults
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*r = IPNetworkSearchResults(v)
+	r.setDecoded(bs, ext)
+	return nil
+}
+
+func (r IPNetworkSearchResults) MarshalJSON() ([]byte, error) {
+	type ipNetworkSearchResults IPNetworkSearchResults
+	return marshalObject(ipNetworkSearchResults(r), r.Extensions)
+}
@@ -0,0 +1,114 @@
+package rdap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDecode__fixtures(t *testing.T) {
+	cases := map[string]string{
+		"rfc-7483-section-4-10-example.json": "*rdap.IPNetwork",
+		"rfc-7483-section-5-1-example.json":  "*rdap.Entity",
+		"rfc-7483-section-5-2-example.json":  "*rdap.Nameserver",
+		"rfc-7483-section-5-2-simple.json":   "*rdap.Nameserver",
+		"rfc-7483-section-5-3-example.json":  "*rdap.Domain",
+		"rfc-7483-section-5-4-example.json":  "*rdap.IPNetwork",
+		"rfc-7483-section-5-5-example.json":  "*rdap.Autnum",
+		"rfc-7483-section-6-example.json":    "*rdap.Error",
+		"verisign-google-domain.json":        "*rdap.Domain",
+		"markmonitor-google-domain.json":     "*rdap.Domain",
+	}
+	for path, expected := range cases {
+		bs, err := ioutil.ReadFile("../../testdata/" + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj, err := Decode(bs)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if v := typeName(obj); v != expected {
+			t.Errorf("%s: got %s", path, v)
+		}
+		if string(obj.Base().Raw) != strings.TrimSpace(string(bs)) {
+			t.Errorf("%s: Raw doesn't match input", path)
+		}
+	}
+}
+
+func TestDecode__nestedRaw(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/markmonitor-google-domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := Decode(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := obj.(*Domain)
+	abuse := domain.Entities[0].Entities[0]
+	if !strings.Contains(string(abuse.Raw), "abusecomplaints@markmonitor.com") || strings.Contains(string(abuse.Raw), "Google LLC") {
+		t.Errorf("got %s", abuse.Raw)
+	}
+
+	// re-hydrate the nested object on its own
+	again, err := Decode(abuse.Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, ok := again.(*Entity); !ok || e.Roles[0] != "abuse" {
+		t.Errorf("got %#v", again)
+	}
+}
+
+func TestDecode__shapes(t *testing.T) {
+	cases := map[string]string{
+		`{"rdapConformance":["rdap_level_0"],"domainSearchResults":[{"objectClassName":"domain","ldhName":"example.com"}]}`: "*rdap.DomainSearchResults",
+		`{"nameserverSearchResults":[{"objectClassName":"nameserver","ldhName":"ns1.example.com"}]}`:                        "*rdap.NameserverSearchResults",
+		`{"entitySearchResults":[]}`: "*rdap.EntitySearchResults",
+		`{"rdapConformance":["rdap_level_0"],"notices":[{"title":"Help","description":["no help"]}]}`: "*rdap.Help",
+		`{"errorCode":404,"title":"Not Found"}`:                                                       "*rdap.Error",
+	}
+	for in, expected := range cases {
+		obj, err := Decode([]byte(in))
+		if err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if v := typeName(obj); v != expected {
+			t.Errorf("%s: got %s", in, v)
+		}
+	}
+
+	results, _ := Decode([]byte(`{"domainSearchResults":[{"objectClassName":"domain","ldhName":"example.com"}]}`))
+	if r := results.(*DomainSearchResults); len(r.Results) != 1 || string(r.Results[0].Raw) != `{"objectClassName":"domain","ldhName":"example.com"}` {
+		t.Errorf("got %#v", r)
+	}
+}
+
+func TestDecode__errors(t *testing.T) {
+	failures := []string{
+		``,
+		`[]`,
+		`{}`,
+		`{"objectClassName":"widget"}`,
+		`{"objectClassName":1}`,
+		`{"objectClassName":"domain","nameservers":[{"objectClassName":"entity"}]}`,
+		`{"objectClassName":"domain","entities":[{"objectClassName":"entity","entities":[{"objectClassName":"domain"}]}]}`,
+	}
+	for i := range failures {
+		if _, err := Decode([]byte(failures[i])); err == nil {
+			t.Errorf("expected error for %q", failures[i])
+		}
+	}
+
+	// nested objects may leave off objectClassName
+	if _, err := Decode([]byte(`{"objectClassName":"domain","nameservers":[{"ldhName":"ns1.example.com"}]}`)); err != nil {
+		t.Error(err)
+	}
+}
+
+func typeName(v interface{}) string {
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,196 @@
+package rdap
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RFC7483 Section 4.5
+// This data structure represents events that have occurred on an
+// instance of an object class (see Section 10.2.3 for a list of event
+// actions).
+
+// EventAction is a value from the RDAP JSON Values registry of type
+// "event action". Values which aren't registered are kept as sent.
+type EventAction string
+
+// RFC7483 Section 10.2.3
+const (
+	EventRegistration    EventAction = "registration"
+	EventReregistration  EventAction = "reregistration"
+	EventLastChanged     EventAction = "last changed"
+	EventExpiration      EventAction = "expiration"
+	EventDeletion        EventAction = "deletion"
+	EventReinstantiation EventAction = "reinstantiation"
+	EventTransfer        EventAction = "transfer"
+	EventLocked          EventAction = "locked"
+	EventUnlocked        EventAction = "unlocked"
+)
+
+// Registered later in the RDAP JSON Values registry
+const (
+	// EventLastUpdateOfRDAPDatabase is when the server's data was last
+	// refreshed, required by the ICANN gTLD RDAP profile.
+	EventLastUpdateOfRDAPDatabase EventAction = "last update of RDAP database"
+
+	// EventRegistrarExpiration is the expiration date set by the registrar,
+	// which may differ from the registry's.
+	EventRegistrarExpiration EventAction = "registrar expiration"
+
+	EventEnumValidationExpiration EventAction = "enum validation expiration"
+)
+
+// Is compares actions ignoring case and surrounding whitespace.
+func (a EventAction) Is(other EventAction) bool {
+	return strings.EqualFold(strings.TrimSpace(string(a)), strings.TrimSpace(string(other)))
+}
+
+type EventJSON struct {
+	EventAction EventAction `json:"eventAction,omitempty"`
+	EventActor  string      `json:"eventActor,omitempty"`
+
+	// EventDate is the zero time if the server's date couldn't be parsed,
+	// see ParseEventDate.
+	EventDate time.Time `json:"eventDate,omitempty"`
+
+	// RawEventDate is the eventDate as sent by the server. It's written
+	// back out as long as EventDate hasn't been changed.
+	RawEventDate string `json:"-"`
+
+	Extensions Extensions `json:"-"`
+}
+
+// UnmarshalJSON reads the eventDate with ParseEventDate rather than
+// failing the whole response over one malformed date.
+func (e *EventJSON) UnmarshalJSON(bs []byte) error {
+	type event EventJSON
+	var v struct {
+		event
+		EventDate string `json:"eventDate,omitempty"`
+	}
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*e = EventJSON(v.event)
+	e.EventDate, _ = ParseEventDate(v.EventDate)
+	e.RawEventDate = v.EventDate
+	e.Extensions = ext
+	return nil
+}
+
+func (e EventJSON) MarshalJSON() ([]byte, error) {
+	type event EventJSON
+	v := struct {
+		event
+		EventDate string `json:"eventDate,omitempty"`
+	}{
+		event:     event(e),
+		EventDate: e.date(),
+	}
+	return marshalObject(v, e.Extensions)
+}
+
+func (e EventJSON) date() string {
+	if e.RawEventDate != "" {
+		t, err := ParseEventDate(e.RawEventDate)
+		if (err == nil && t.Equal(e.EventDate)) || (err != nil && e.EventDate.IsZero()) {
+			return e.RawEventDate
+		}
+	}
+	if e.EventDate.IsZero() {
+		return ""
+	}
+	return e.EventDate.Format(time.RFC3339Nano)
+}
+
+// eventDateLayouts are the formats servers have been seen sending. Those
+// without a zone are read as UTC.
+var eventDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02 15:04:05.999999999 MST",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02Z07:00",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// ParseEventDate reads an eventDate. RFC7483 requires RFC3339, but
+// servers also send dates without a zone, with a space in place of the
+// T, with numeric zones lacking a colon or as only a date.
+func ParseEventDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty eventDate")
+	}
+	if len(s) > 10 && (s[10] == 't' || s[10] == 'T') {
+		s = s[:10] + "T" + s[11:]
+	}
+	if strings.HasSuffix(s, "z") {
+		s = s[:len(s)-1] + "Z"
+	}
+	for _, layout := range eventDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown eventDate format: %q", s)
+}
+
+// Event returns the latest event with the given action, or nil if there
+// isn't one.
+func (c *Common) Event(action EventAction) *EventJSON {
+	var out *EventJSON
+	for i := range c.Events {
+		e := &c.Events[i]
+		if !e.EventAction.Is(action) {
+			continue
+		}
+		if out == nil || e.EventDate.After(out.EventDate) {
+			out = e
+		}
+	}
+	return out
+}
+
+func (c *Common) eventDate(action EventAction) (time.Time, bool) {
+	e := c.Event(action)
+	if e == nil || e.EventDate.IsZero() {
+		return time.Time{}, false
+	}
+	return e.EventDate, true
+}
+
+// Registered returns when the object was registered, and false if the
+// server didn't say or sent a date which couldn't be parsed.
+func (c *Common) Registered() (time.Time, bool) {
+	return c.eventDate(EventRegistration)
+}
+
+// Expires returns when the object's registration expires, and false if
+// the server didn't say or sent a date which couldn't be parsed.
+func (c *Common) Expires() (time.Time, bool) {
+	return c.eventDate(EventExpiration)
+}
+
+// LastChanged returns when the object was last changed, and false if the
+// server didn't say or sent a date which couldn't be parsed.
+func (c *Common) LastChanged() (time.Time, bool) {
+	return c.eventDate(EventLastChanged)
+}
+
+// LastUpdated returns when the server's copy of the object was last
+// updated, and false if the server didn't say or sent a date which
+// couldn't be parsed.
+func (c *Common) LastUpdated() (time.Time, bool) {
+	return c.eventDate(EventLastUpdateOfRDAPDatabase)
+}
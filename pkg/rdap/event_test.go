@@ -0,0 +1,99 @@
+package rdap
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEvent__accessors(t *testing.T) {
+	domain := readDomain(t, "../../testdata/verisign-google-domain.json")
+
+	expires, ok := domain.Expires()
+	if !ok || !expires.Equal(time.Date(2020, time.September, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expires: %v", expires)
+	}
+	registered, ok := domain.Registered()
+	if !ok || registered.Year() != 1997 {
+		t.Errorf("registered: %v", registered)
+	}
+	if _, ok := domain.LastChanged(); !ok {
+		t.Error("expected last changed")
+	}
+	if _, ok := domain.LastUpdated(); ok {
+		t.Error("unexpected last update of RDAP database")
+	}
+	if e := domain.Event("Last Changed"); e == nil || e.EventAction != EventLastChanged {
+		t.Errorf("got %#v", e)
+	}
+	if e := domain.Event(EventDeletion); e != nil {
+		t.Errorf("got %#v", e)
+	}
+
+	registrar := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+	if _, ok := registrar.LastUpdated(); !ok {
+		t.Error("expected last update of RDAP database")
+	}
+}
+
+func TestEvent__dates(t *testing.T) {
+	utc := time.Date(2019, time.March, 5, 17, 30, 0, 0, time.UTC)
+	cases := map[string]time.Time{
+		"2019-03-05T17:30:00Z":          utc,
+		"2019-03-05t17:30:00z":          utc,
+		"2019-03-05T17:30:00.000Z":      utc,
+		"2019-03-05T12:30:00-05:00":     utc,
+		"2019-03-05T12:30:00-0500":      utc,
+		"2019-03-05T17:30:00":           utc,
+		"2019-03-05T17:30":              utc,
+		"2019-03-05 17:30:00":           utc,
+		"2019-03-05 17:30:00Z":          utc,
+		"2019-03-05 12:30:00 -0500":     utc,
+		"2019-03-05":                    time.Date(2019, time.March, 5, 0, 0, 0, 0, time.UTC),
+		"Tue, 05 Mar 2019 17:30:00 UTC": utc,
+	}
+	for in, expected := range cases {
+		got, err := ParseEventDate(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if !got.Equal(expected) {
+			t.Errorf("%s: got %v", in, got)
+		}
+	}
+	for _, in := range []string{"", "yesterday", "YYYY-MM-DDTHH:MM:SSZ"} {
+		if _, err := ParseEventDate(in); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestEvent__roundTrip(t *testing.T) {
+	in := `[{"eventAction":"expiration","eventDate":"2019-03-05 17:30:00"},{"eventAction":"registration","eventDate":"unknown"}]`
+	var events []EventJSON
+	if err := json.Unmarshal([]byte(in), &events); err != nil {
+		t.Fatal(err)
+	}
+	if events[0].EventDate.IsZero() || !events[1].EventDate.IsZero() {
+		t.Errorf("got %#v", events)
+	}
+
+	bs, err := json.Marshal(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != in {
+		t.Errorf("got %s", bs)
+	}
+
+	// changed dates are written as RFC3339
+	events[0].EventDate = events[0].EventDate.AddDate(1, 0, 0)
+	bs, err = json.Marshal(events[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != `{"eventAction":"expiration","eventDate":"2020-03-05T17:30:00Z"}` {
+		t.Errorf("got %s", bs)
+	}
+}
@@ -0,0 +1,229 @@
+package rdap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RFC7480 Section 6
+// Extension identifiers are listed in the rdapConformance member of a
+// response and registered with IANA in the RDAP Extensions registry. An
+// extension's members are prefixed with its identifier.
+
+// Extension decodes the members of an RDAP extension into a typed value.
+//
+// Register an Extension with RegisterExtension and read the decoded value
+// from an object with Common.Extension.
+type Extension struct {
+	// Identifier is the rdapConformance value of the extension, such
+	// as "cidr0".
+	Identifier string
+
+	// Prefix is the member name prefix used by the extension (without
+	// the trailing underscore). Every member starting with it is passed to
+	// Decode, however many underscores follow. It defaults to Identifier.
+	Prefix string
+
+	// Decode is given the object being read and its members under Prefix,
+	// which may be empty for extensions that only signal conformance.
+	Decode func(c *Common, members map[string]json.RawMessage) (interface{}, error)
+}
+
+var registeredExtensions = struct {
+	sync.RWMutex
+	m map[string]Extension
+}{
+	m: make(map[string]Extension),
+}
+
+// RegisterExtension makes ext available to Common.Extension. Registering
+// an identifier again replaces the previous Extension.
+func RegisterExtension(ext Extension) error {
+	if ext.Identifier == "" {
+		return errors.New("extension has no identifier")
+	}
+	if ext.Decode == nil {
+		return fmt.Errorf("extension %s has no Decode func", ext.Identifier)
+	}
+	if ext.Prefix == "" {
+		ext.Prefix = ext.Identifier
+	}
+	ext.Prefix = strings.TrimSuffix(ext.Prefix, "_")
+
+	registeredExtensions.Lock()
+	registeredExtensions.m[ext.Identifier] = ext
+	registeredExtensions.Unlock()
+	return nil
+}
+
+// RegisteredExtensions returns the identifier of every registered
+// Extension in sorted order.
+func RegisteredExtensions() []string {
+	registeredExtensions.RLock()
+	defer registeredExtensions.RUnlock()
+
+	out := make([]string, 0, len(registeredExtensions.m))
+	for k := range registeredExtensions.m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Extension returns the typed value of the registered extension with the
+// given identifier, as decoded from this object's members.
+//
+// A nil value (and nil error) is returned if the object has no members for
+// the extension and doesn't list it in rdapConformance. Only top level
+// objects carry rdapConformance, so nested objects are matched on their
+// members alone.
+func (c *Common) Extension(identifier string) (interface{}, error) {
+	registeredExtensions.RLock()
+	ext, ok := registeredExtensions.m[identifier]
+	registeredExtensions.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown extension %q", identifier)
+	}
+
+	members := c.Extensions.Prefix(ext.Prefix)
+	if len(members) == 0 && !c.Conforms(identifier) {
+		return nil, nil
+	}
+	v, err := ext.Decode(c, members)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s extension: %v", identifier, err)
+	}
+	return v, nil
+}
+
+func init() {
+	RegisterExtension(Extension{
+		Identifier: "cidr0",
+		Decode:     decodeCIDR0,
+	})
+	RegisterExtension(Extension{
+		Identifier: "arin_originas0",
+		Decode:     decodeOriginAS0,
+	})
+	RegisterExtension(Extension{
+		Identifier: "nro_rdap_profile_0",
+		Prefix:     "nro_rdap_profile",
+		Decode:     decodeNROProfile,
+	})
+	RegisterExtension(Extension{
+		Identifier: "paging",
+		Decode:     decodePaging,
+	})
+}
+
+// CIDR0 is the cidr0 extension, which lists the CIDR blocks covering an IP
+// network's start and end addresses.
+// https://bitbucket.org/arin-specs/cidr0
+type CIDR0 struct {
+	CIDRs []*net.IPNet
+}
+
+func decodeCIDR0(_ *Common, members map[string]json.RawMessage) (interface{}, error) {
+	var cidrs []struct {
+		V4Prefix string `json:"v4prefix"`
+		V6Prefix string `json:"v6prefix"`
+		Length   int    `json:"length"`
+	}
+	if raw, ok := members["cidr0_cidrs"]; ok {
+		if err := json.Unmarshal(raw, &cidrs); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &CIDR0{}
+	for i := range cidrs {
+		prefix := cidrs[i].V4Prefix
+		if prefix == "" {
+			prefix = cidrs[i].V6Prefix
+		}
+		_, n, err := net.ParseCIDR(fmt.Sprintf("%s/%d", prefix, cidrs[i].Length))
+		if err != nil {
+			return nil, err
+		}
+		out.CIDRs = append(out.CIDRs, n)
+	}
+	return out, nil
+}
+
+// OriginAS0 is ARIN's arin_originas0 extension which lists the autonomous
+// systems originating routes for an IP network.
+type OriginAS0 struct {
+	OriginAutnums []uint32
+}
+
+func decodeOriginAS0(_ *Common, members map[string]json.RawMessage) (interface{}, error) {
+	out := &OriginAS0{}
+	if raw, ok := members["arin_originas0_originautnums"]; ok {
+		if err := json.Unmarshal(raw, &out.OriginAutnums); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// NROProfile is the NRO RDAP Profile, which the RIRs conform to. It has no
+// members of its own, instead it signals which profile options a server
+// follows through rdapConformance.
+type NROProfile struct {
+	// Identifiers are every nro_rdap_profile_ value in rdapConformance.
+	Identifiers []string
+
+	// ASNFlat and ASNHierarchical signal how the server models autnum
+	// registrations.
+	ASNFlat         bool
+	ASNHierarchical bool
+}
+
+func decodeNROProfile(c *Common, _ map[string]json.RawMessage) (interface{}, error) {
+	out := &NROProfile{}
+	for _, id := range c.RDAPConformance {
+		if !strings.HasPrefix(id, "nro_rdap_profile_") {
+			continue
+		}
+		out.Identifiers = append(out.Identifiers, id)
+		switch id {
+		case "nro_rdap_profile_asn_flat_0":
+			out.ASNFlat = true
+		case "nro_rdap_profile_asn_hierarchical_0":
+			out.ASNHierarchical = true
+		}
+	}
+	return out, nil
+}
+
+// CIDR0 returns the network's cidr0 extension, or nil if it wasn't sent.
+func (n *IPNetwork) CIDR0() (*CIDR0, error) {
+	v, err := n.Extension("cidr0")
+	if v == nil || err != nil {
+		return nil, err
+	}
+	out, ok := v.(*CIDR0)
+	if !ok {
+		return nil, fmt.Errorf("cidr0 extension decoded as %T", v)
+	}
+	return out, nil
+}
+
+// OriginAS0 returns the network's arin_originas0 extension, or nil if it
+// wasn't sent.
+func (n *IPNetwork) OriginAS0() (*OriginAS0, error) {
+	v, err := n.Extension("arin_originas0")
+	if v == nil || err != nil {
+		return nil, err
+	}
+	out, ok := v.(*OriginAS0)
+	if !ok {
+		return nil, fmt.Errorf("arin_originas0 extension decoded as %T", v)
+	}
+	return out, nil
+}
@@ -0,0 +1,129 @@
+package rdap
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+)
+
+func TestExtension__builtin(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/arin-ip-network.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var network IPNetwork
+	if err := json.Unmarshal(bs, &network); err != nil {
+		t.Fatal(err)
+	}
+
+	cidr0, err := network.CIDR0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cidr0.CIDRs) != 1 || cidr0.CIDRs[0].String() != "198.51.100.0/24" {
+		t.Errorf("got %v", cidr0.CIDRs)
+	}
+
+	origin, err := network.OriginAS0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(origin.OriginAutnums) != 2 || origin.OriginAutnums[0] != 64496 {
+		t.Errorf("got %v", origin.OriginAutnums)
+	}
+
+	v, err := network.Extension("nro_rdap_profile_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := v.(*NROProfile); len(p.Identifiers) != 1 || p.ASNFlat {
+		t.Errorf("got %#v", p)
+	}
+
+	// Objects without the extension
+	var other IPNetwork
+	json.Unmarshal([]byte(`{"objectClassName":"ip network"}`), &other)
+	if v, err := other.CIDR0(); v != nil || err != nil {
+		t.Errorf("got %v, %v", v, err)
+	}
+	if _, err := other.Extension("not_registered"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestExtension__register(t *testing.T) {
+	type example struct {
+		Color string
+	}
+	err := RegisterExtension(Extension{
+		Identifier: "example_colors_0",
+		Prefix:     "example_",
+		Decode: func(c *Common, members map[string]json.RawMessage) (interface{}, error) {
+			var out example
+			if err := json.Unmarshal(members["example_color"], &out.Color); err != nil {
+				return nil, err
+			}
+			if out.Color == "" {
+				return nil, errors.New("no color")
+			}
+			return out, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var domain Domain
+	json.Unmarshal([]byte(`{"objectClassName":"domain","example_color":"blue"}`), &domain)
+	v, err := domain.Extension("example_colors_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.(example).Color != "blue" {
+		t.Errorf("got %#v", v)
+	}
+
+	json.Unmarshal([]byte(`{"objectClassName":"domain","example_color":""}`), &domain)
+	if _, err := domain.Extension("example_colors_0"); err == nil {
+		t.Error("expected error")
+	}
+
+	if err := RegisterExtension(Extension{Identifier: "bad"}); err == nil {
+		t.Error("expected error without Decode")
+	}
+}
+
+func TestExtension__prefix(t *testing.T) {
+	err := RegisterExtension(Extension{
+		Identifier: "acme_0",
+		Prefix:     "acme",
+		Decode: func(c *Common, members map[string]json.RawMessage) (interface{}, error) {
+			return members, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// acme_contact_info is grouped under acme_contact, and acmex_level
+	// belongs to another extension.
+	var domain Domain
+	err = json.Unmarshal([]byte(`{
+  "objectClassName": "domain",
+  "acme_contact_info": {"email": "noc@acme.example"},
+  "acme_level": 3,
+  "acmex_level": 4
+}`), &domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := domain.Extension("acme_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := v.(map[string]json.RawMessage)
+	if len(members) != 2 || string(members["acme_contact_info"]) != `{"email": "noc@acme.example"}` || string(members["acme_level"]) != "3" {
+		t.Errorf("got %s", members)
+	}
+}
@@ -0,0 +1,221 @@
+package rdap
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RFC7483 Section 2.1
+// Insertion of unrecognized members ignored by clients may also be used
+// for future revisions to this specification.
+//
+// RFC7480 Section 6 and RFC7483 Section 2.1 describe extensions as members
+// named with a registered prefix, followed by an underscore, such as
+// "arin_originas0_originautnums".
+
+// Extensions holds the members of an object (or a link, event, notice or
+// remark) which this package doesn't model, so they aren't lost when a
+// decoded object is encoded again.
+//
+// Members are grouped by their extension prefix, which is everything
+// before the last underscore (e.g. "arin_originas0" or "cidr0"). Members
+// without an underscore (e.g. "redacted" or "secureDNS") are their own
+// prefix.
+//
+// Modeled members which were sent empty (e.g. "handle": "") are also
+// kept, out of sight of the methods below, as omitempty would otherwise
+// drop them when encoding.
+type Extensions map[string]map[string]json.RawMessage
+
+// emptyMembers is the group holding modeled members which were sent empty.
+// A NUL can't start a member's prefix in any real response.
+const emptyMembers = "\x00empty"
+
+// ExtensionPrefix returns the prefix a member is grouped under in Extensions.
+func ExtensionPrefix(member string) string {
+	if idx := strings.LastIndex(member, "_"); idx > 0 {
+		return member[:idx]
+	}
+	return member
+}
+
+// Member returns the raw JSON of an unrecognized member.
+func (e Extensions) Member(name string) (json.RawMessage, bool) {
+	raw, ok := e[ExtensionPrefix(name)][name]
+	return raw, ok
+}
+
+// Prefix returns every member named prefix or starting with prefix and an
+// underscore. Members are matched across groups, so the "acme" prefix
+// finds "acme_contact_info" although it's grouped under "acme_contact".
+func (e Extensions) Prefix(prefix string) map[string]json.RawMessage {
+	var out map[string]json.RawMessage
+	for group, members := range e {
+		if group == emptyMembers || !strings.HasPrefix(group, prefix) {
+			continue
+		}
+		for name, raw := range members {
+			if name == prefix || strings.HasPrefix(name, prefix+"_") {
+				if out == nil {
+					out = make(map[string]json.RawMessage)
+				}
+				out[name] = raw
+			}
+		}
+	}
+	return out
+}
+
+// Prefixes returns each extension prefix in sorted order.
+func (e Extensions) Prefixes() []string {
+	out := make([]string, 0, len(e))
+	for k := range e {
+		if k != emptyMembers {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Set stores raw as the member name, replacing any previous value.
+func (e Extensions) Set(name string, raw json.RawMessage) {
+	prefix := ExtensionPrefix(name)
+	if e[prefix] == nil {
+		e[prefix] = make(map[string]json.RawMessage)
+	}
+	e[prefix][name] = raw
+}
+
+// unmarshalObject decodes bs into v, which must be a pointer to a struct
+// without its own UnmarshalJSON method, and returns every member of bs
+// that v has no field for.
+func unmarshalObject(bs []byte, v interface{}) (Extensions, error) {
+	if err := json.Unmarshal(bs, v); err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &members); err != nil {
+		return nil, err
+	}
+
+	known := knownMembers(reflect.TypeOf(v).Elem())
+	var ext Extensions
+	for name, raw := range members {
+		isKnown := knownMember(known, name)
+		if isKnown && !isEmptyJSON(raw) {
+			continue
+		}
+		if ext == nil {
+			ext = make(Extensions)
+		}
+		if isKnown {
+			if ext[emptyMembers] == nil {
+				ext[emptyMembers] = make(map[string]json.RawMessage)
+			}
+			ext[emptyMembers][name] = raw
+			continue
+		}
+		ext.Set(name, raw)
+	}
+	return ext, nil
+}
+
+// isEmptyJSON returns true for values omitempty leaves out: null, false,
+// 0, "" and empty arrays or objects.
+func isEmptyJSON(raw json.RawMessage) bool {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return false
+	}
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// marshalObject encodes v, which must not have its own MarshalJSON method,
+// with each member in ext added to it. Members which were sent empty are
+// only added back when v's field is still empty.
+func marshalObject(v interface{}, ext Extensions) ([]byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil || len(ext) == 0 {
+		return bs, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &members); err != nil {
+		return nil, err
+	}
+	for _, group := range ext {
+		for name, raw := range group {
+			if _, exists := members[name]; !exists {
+				members[name] = raw
+			}
+		}
+	}
+	return json.Marshal(members)
+}
+
+var knownMembersCache sync.Map // reflect.Type -> map[string]bool
+
+// knownMembers returns the JSON member names of struct type t, including
+// those of embedded structs.
+func knownMembers(t reflect.Type) map[string]bool {
+	if v, ok := knownMembersCache.Load(t); ok {
+		return v.(map[string]bool)
+	}
+
+	out := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for k := range knownMembers(f.Type) {
+				out[k] = true
+			}
+			continue
+		}
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		out[name] = true
+	}
+
+	knownMembersCache.Store(t, out)
+	return out
+}
+
+// knownMember returns true if encoding/json decodes the member name into
+// one of the known fields, which it matches without case.
+func knownMember(known map[string]bool, name string) bool {
+	if known[name] {
+		return true
+	}
+	for k := range known {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
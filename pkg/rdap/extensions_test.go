@@ -0,0 +1,177 @@
+package rdap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtensions__roundTrip(t *testing.T) {
+	paths, err := filepath.Glob("../../testdata/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tested int
+	for _, path := range paths {
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(filepath.Base(path), "rfc-7484-") || strings.HasPrefix(filepath.Base(path), "rfc-8521-") {
+			continue // bootstrap files
+		}
+		tested++
+
+		// decode, encode and decode again
+		obj, err := Decode(bs)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		out, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		again, err := Decode(out)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		out2, err := json.Marshal(again)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+
+		var expected, got, got2 interface{}
+		json.Unmarshal(bs, &expected)
+		json.Unmarshal(out, &got)
+		json.Unmarshal(out2, &got2)
+		if !reflect.DeepEqual(expected, got) {
+			t.Errorf("%s: round trip differs\n%s", path, out)
+		}
+		if !reflect.DeepEqual(expected, got2) {
+			t.Errorf("%s: second round trip differs\n%s", path, out2)
+		}
+	}
+	if tested < 10 {
+		t.Errorf("only %d fixtures tested", tested)
+	}
+}
+
+func TestExtensions__members(t *testing.T) {
+	in := []byte(`{
+  "objectClassName": "ip network",
+  "rdapConformance": ["rdap_level_0", "cidr0", "arin_originas0"],
+  "handle": "NET-192-0-2-0-1",
+  "startAddress": "192.0.2.0",
+  "endAddress": "192.0.2.255",
+  "cidr0_cidrs": [{"v4prefix": "192.0.2.0", "length": 24}],
+  "arin_originas0_originautnums": [64496],
+  "entities": [
+    {
+      "objectClassName": "entity",
+      "handle": "EXAMPLE-ARIN",
+      "redacted": [{"name": {"type": "Registrant Name"}}]
+    }
+  ]
+}`)
+	var network IPNetwork
+	if err := json.Unmarshal(in, &network); err != nil {
+		t.Fatal(err)
+	}
+	if v := network.Extensions.Prefixes(); !reflect.DeepEqual(v, []string{"arin_originas0", "cidr0"}) {
+		t.Errorf("got %v", v)
+	}
+	if raw, ok := network.Extensions.Member("arin_originas0_originautnums"); !ok || string(raw) != "[64496]" {
+		t.Errorf("got %s", raw)
+	}
+	if len(network.Extensions.Prefix("cidr0")) != 1 {
+		t.Errorf("got %v", network.Extensions.Prefix("cidr0"))
+	}
+	if _, ok := network.Entities[0].Extensions.Member("redacted"); !ok {
+		t.Errorf("nested entity lost redacted: %v", network.Entities[0].Extensions)
+	}
+
+	// Extensions set in code are encoded too
+	network.Extensions.Set("arin_originas0_originautnums", json.RawMessage(`[64497]`))
+	bs, err := json.Marshal(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again IPNetwork
+	if err := json.Unmarshal(bs, &again); err != nil {
+		t.Fatal(err)
+	}
+	if raw, _ := again.Extensions.Member("arin_originas0_originautnums"); string(raw) != "[64497]" {
+		t.Errorf("got %s", raw)
+	}
+}
+
+func TestExtensions__memberCase(t *testing.T) {
+	in := []byte(`{"objectClassName":"entity","Handle":"XXXX","Port43":""}`)
+	var e Entity
+	if err := json.Unmarshal(in, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Handle != "XXXX" {
+		t.Errorf("got %q", e.Handle)
+	}
+	if _, ok := e.Extensions.Member("Handle"); ok {
+		t.Errorf("Handle kept as an extension: %v", e.Extensions)
+	}
+
+	bs, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(strings.ToLower(string(bs)), `"handle"`) != 1 {
+		t.Errorf("got %s", bs)
+	}
+}
+
+func TestExtensionPrefix(t *testing.T) {
+	cases := map[string]string{
+		"arin_originas0_originautnums": "arin_originas0",
+		"cidr0_cidrs":                  "cidr0",
+		"redacted":                     "redacted",
+		"_private":                     "_private",
+	}
+	for member, expected := range cases {
+		if v := ExtensionPrefix(member); v != expected {
+			t.Errorf("%s: got %q", member, v)
+		}
+	}
+}
+
+func TestExtensions__nestedStructures(t *testing.T) {
+	in := []byte(`{"objectClassName":"domain","ldhName":"example.com",
+"events":[{"eventAction":"registration","eventDate":"1990-12-31T23:59:59Z","example_note":"x"}],
+"links":[{"href":"https://example.com","example_weight":1}],
+"notices":[{"title":"Terms","description":["..."],"example_lang":"en"}]}`)
+	obj, err := Decode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := obj.(*Domain)
+	if _, ok := domain.Events[0].Extensions.Member("example_note"); !ok {
+		t.Error("event lost example_note")
+	}
+	if _, ok := domain.Links[0].Extensions.Member("example_weight"); !ok {
+		t.Error("link lost example_weight")
+	}
+	if _, ok := domain.Notices[0].Extensions.Member("example_lang"); !ok {
+		t.Error("notice lost example_lang")
+	}
+
+	out, err := json.Marshal(domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected, got interface{}
+	json.Unmarshal(in, &expected)
+	json.Unmarshal(out, &got)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("round trip differs\n%s", out)
+	}
+}
@@ -0,0 +1,113 @@
+package rdap
+
+import (
+	"fmt"
+)
+
+// RFC9083 Section 5.4
+// ... the "up" link relation type to reference the parent network of
+// an IP network, and the "down" link relation type ... to reference
+// child networks.
+
+// NetworkChain is the allocation chain of an address, from the block the
+// RIR holds down to the most specific reassignment.
+type NetworkChain []*IPNetwork
+
+// Allocation returns the least specific network, the block the address was
+// allocated from, or nil for an empty chain.
+func (ch NetworkChain) Allocation() *IPNetwork {
+	if len(ch) == 0 {
+		return nil
+	}
+	return ch[0]
+}
+
+// MostSpecific returns the most specific network, which usually belongs to
+// whoever operates the address, or nil for an empty chain.
+func (ch NetworkChain) MostSpecific() *IPNetwork {
+	if len(ch) == 0 {
+		return nil
+	}
+	return ch[len(ch)-1]
+}
+
+// NetworkHierarchy returns the chain of networks containing an IP address
+// or CIDR prefix, least specific first.
+//
+// The network returned for addr is walked down through its "down" links to
+// the most specific network containing addr, and up through its parents
+// (see ParentNetwork) to the top level block. The server is found from the
+// Client's Bootstrap registry when it has one, otherwise BaseAddress is
+// used.
+//
+// Walking stops with an error when a network links back to one already in
+// the chain or the Client's MaxNetworkDepth is reached. The chain found so
+// far is returned along with the error.
+func (c *Client) NetworkHierarchy(addr string) (NetworkChain, error) {
+	p, err := parsePrefix(addr)
+	if err != nil {
+		return nil, err
+	}
+	server, err := c.ipServer(p)
+	if err != nil {
+		return nil, err
+	}
+	queried, err := c.ipNetwork(server, p)
+	if err != nil {
+		return nil, err
+	}
+	seen := networkSet{queried.key(): true}
+
+	children, err := c.childNetworks(queried, p.String(), seen)
+	if err != nil {
+		return chain(nil, queried, children), err
+	}
+	parents, err := c.parentNetworks(server, queried, seen)
+	return chain(parents, queried, children), err
+}
+
+// childNetworks follows "down" links from n to the most specific network
+// containing prefix, returning them least specific first.
+func (c *Client) childNetworks(n *IPNetwork, prefix string, seen networkSet) ([]*IPNetwork, error) {
+	var out []*IPNetwork
+	for {
+		var next *IPNetwork
+		for _, link := range n.Links.Rel(RelDown) {
+			child, err := c.followNetworkLink(link)
+			if err != nil {
+				return out, err
+			}
+			if child == nil || !child.MoreSpecificThan(n) {
+				continue
+			}
+			if ok, _ := child.Contains(prefix); !ok {
+				continue
+			}
+			if next == nil || child.MoreSpecificThan(next) {
+				next = child
+			}
+		}
+		if next == nil {
+			return out, nil
+		}
+		if len(seen) >= c.maxNetworkDepth() {
+			return out, fmt.Errorf("more than %d ip networks in hierarchy", c.maxNetworkDepth())
+		}
+		if err := seen.add(next); err != nil {
+			return out, err
+		}
+		out = append(out, next)
+		n = next
+	}
+}
+
+// chain joins parents (nearest first), n and children (least specific
+// first) into a NetworkChain.
+func chain(parents []*IPNetwork, n *IPNetwork, children []*IPNetwork) NetworkChain {
+	out := make(NetworkChain, 0, len(parents)+1+len(children))
+	for i := len(parents) - 1; i >= 0; i-- {
+		out = append(out, parents[i])
+	}
+	out = append(out, n)
+	return append(out, children...)
+}
@@ -0,0 +1,88 @@
+package rdap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient__networkHierarchy(t *testing.T) {
+	var svc *httptest.Server
+	network := func(handle, start, end, parent string, links ...string) string {
+		var ls []string
+		for _, link := range links {
+			parts := strings.SplitN(link, " ", 2)
+			ls = append(ls, `{"rel":"`+parts[0]+`","type":"application/rdap+json","href":"`+svc.URL+parts[1]+`"}`)
+		}
+		return `{"objectClassName":"ip network","handle":"` + handle + `","startAddress":"` + start + `","endAddress":"` + end +
+			`","parentHandle":"` + parent + `","links":[` + strings.Join(ls, ",") + `]}`
+	}
+	var paths []string
+	svc = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/ip/198.51.100.7":
+			w.Write([]byte(network("NET-198-51-100-0-1", "198.51.100.0", "198.51.100.255", "NET-198-51-0-0-1",
+				"up /ip/198.51.0.0/16", "down /ip/198.51.100.0/28", "down /ip/198.51.100.16/28")))
+		case "/ip/198.51.0.0/16":
+			w.Write([]byte(network("NET-198-51-0-0-1", "198.51.0.0", "198.51.255.255", "NET-198-0-0-0-0", "up /ip/198.0.0.0/8")))
+		case "/ip/198.0.0.0/8":
+			w.Write([]byte(network("NET-198-0-0-0-0", "198.0.0.0", "198.255.255.255", "")))
+		case "/ip/198.51.100.0/28":
+			w.Write([]byte(network("NET-198-51-100-0-2", "198.51.100.0", "198.51.100.15", "NET-198-51-100-0-1", "down /ip/198.51.100.4/30")))
+		case "/ip/198.51.100.16/28":
+			w.Write([]byte(network("NET-198-51-100-16-1", "198.51.100.16", "198.51.100.31", "NET-198-51-100-0-1")))
+		case "/ip/198.51.100.4/30":
+			w.Write([]byte(network("NET-198-51-100-4-1", "198.51.100.4", "198.51.100.7", "NET-198-51-100-0-2")))
+
+		// 203.0.113.0/24 links back to itself
+		case "/ip/203.0.113.1":
+			w.Write([]byte(network("NET-203-0-113-0-1", "203.0.113.0", "203.0.113.255", "NET-203-0-0-0-0", "up /ip/203.0.0.0/16")))
+		case "/ip/203.0.0.0/16":
+			w.Write([]byte(network("NET-203-0-0-0-0", "203.0.0.0", "203.0.255.255", "NET-203-0-113-0-1", "up /ip/203.0.113.1")))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer svc.Close()
+
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	chain, err := client.NetworkHierarchy("198.51.100.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var handles []string
+	for _, n := range chain {
+		handles = append(handles, n.Handle)
+	}
+	expected := "NET-198-0-0-0-0 NET-198-51-0-0-1 NET-198-51-100-0-1 NET-198-51-100-0-2 NET-198-51-100-4-1"
+	if got := strings.Join(handles, " "); got != expected {
+		t.Errorf("got %s", got)
+	}
+	if chain.Allocation().Handle != "NET-198-0-0-0-0" || chain.MostSpecific().Handle != "NET-198-51-100-4-1" {
+		t.Errorf("got allocation %s, most specific %s", chain.Allocation().Handle, chain.MostSpecific().Handle)
+	}
+
+	chain, err = client.NetworkHierarchy("203.0.113.1")
+	if err == nil || !strings.Contains(err.Error(), "links back") {
+		t.Errorf("expected loop error, got %v", err)
+	}
+	if len(chain) != 2 || chain.MostSpecific().Handle != "NET-203-0-113-0-1" {
+		t.Errorf("got %v", chain)
+	}
+
+	// Depth limit
+	client.MaxNetworkDepth = 3
+	chain, err = client.NetworkHierarchy("198.51.100.7")
+	if err == nil || len(chain) != 3 {
+		t.Errorf("got %d networks: %v", len(chain), err)
+	}
+
+	if _, err := client.NetworkHierarchy("192.0.2.1"); !IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,364 @@
+package rdap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+)
+
+// RFC7483 Section 5.4
+// o  startAddress -- a string representing the starting IP address of
+//    the network, either IPv4 or IPv6
+// o  endAddress -- a string representing the ending IP address of the
+//    network, either IPv4 or IPv6
+//
+// RIRs register networks as ranges, which are often not a single CIDR
+// block (e.g. 192.0.2.0 - 192.0.3.127). The helpers below convert between
+// the two.
+
+// Range returns the network's start and end addresses. IPv4 addresses are
+// 4 bytes long.
+func (n *IPNetwork) Range() (net.IP, net.IP, error) {
+	start, end := parseIP(n.StartAddress), parseIP(n.EndAddress)
+	if start == nil || end == nil {
+		// Fall back to the cidr0 extension for servers which only send it.
+		if cidr0, _ := n.CIDR0(); cidr0 != nil && len(cidr0.CIDRs) > 0 {
+			first := cidr0.CIDRs[0]
+			last := cidr0.CIDRs[len(cidr0.CIDRs)-1]
+			return normalizeIP(first.IP), lastIP(last), nil
+		}
+		return nil, nil, fmt.Errorf("invalid ip network range %q - %q", n.StartAddress, n.EndAddress)
+	}
+	if len(start) != len(end) {
+		return nil, nil, fmt.Errorf("ip network range %s - %s mixes IPv4 and IPv6", start, end)
+	}
+	if bytes.Compare(start, end) > 0 {
+		return nil, nil, fmt.Errorf("ip network range %s - %s ends before it starts", start, end)
+	}
+	return start, end, nil
+}
+
+// CIDRs returns the minimal set of CIDR blocks covering the network's
+// range, in address order.
+func (n *IPNetwork) CIDRs() ([]*net.IPNet, error) {
+	start, end, err := n.Range()
+	if err != nil {
+		return nil, err
+	}
+	return RangeToCIDRs(start, end)
+}
+
+// Size returns how many addresses are in the network.
+func (n *IPNetwork) Size() (*big.Int, error) {
+	start, end, err := n.Range()
+	if err != nil {
+		return nil, err
+	}
+	size := new(big.Int).Sub(ipToInt(end), ipToInt(start))
+	return size.Add(size, big.NewInt(1)), nil
+}
+
+// Contains returns true if an IP address or every address of a CIDR prefix
+// is within the network.
+func (n *IPNetwork) Contains(prefix string) (bool, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return false, err
+	}
+	start, end, err := n.Range()
+	if err != nil {
+		return false, err
+	}
+	first, last := normalizeIP(p.IP.Mask(p.Mask)), lastIP(p)
+	if len(first) != len(start) {
+		return false, nil
+	}
+	return bytes.Compare(start, first) <= 0 && bytes.Compare(last, end) <= 0, nil
+}
+
+// MoreSpecificThan returns true if the network is within other and
+// smaller than it, as a reassignment is within its allocation. Networks
+// with invalid ranges are never more specific.
+func (n *IPNetwork) MoreSpecificThan(other *IPNetwork) bool {
+	start, end, err := n.Range()
+	if err != nil || other == nil {
+		return false
+	}
+	otherStart, otherEnd, err := other.Range()
+	if err != nil || len(start) != len(otherStart) {
+		return false
+	}
+	if bytes.Compare(otherStart, start) > 0 || bytes.Compare(end, otherEnd) > 0 {
+		return false
+	}
+	return !start.Equal(otherStart) || !end.Equal(otherEnd)
+}
+
+// SortBySpecificity orders networks from the most specific (smallest) to
+// the least specific. Networks with invalid ranges are sorted last.
+func SortBySpecificity(networks []*IPNetwork) {
+	sizes := make(map[*IPNetwork]*big.Int, len(networks))
+	for _, n := range networks {
+		if size, err := n.Size(); err == nil {
+			sizes[n] = size
+		}
+	}
+	sort.SliceStable(networks, func(i, j int) bool {
+		a, b := sizes[networks[i]], sizes[networks[j]]
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Cmp(b) < 0
+	})
+}
+
+// RangeToCIDRs returns the minimal set of CIDR blocks covering start
+// through end (inclusive), in address order.
+func RangeToCIDRs(start, end net.IP) ([]*net.IPNet, error) {
+	start, end = normalizeIP(start), normalizeIP(end)
+	if start == nil || end == nil {
+		return nil, errors.New("invalid ip range")
+	}
+	if len(start) != len(end) {
+		return nil, fmt.Errorf("ip range %s - %s mixes IPv4 and IPv6", start, end)
+	}
+	if bytes.Compare(start, end) > 0 {
+		return nil, fmt.Errorf("ip range %s - %s ends before it starts", start, end)
+	}
+
+	bits := len(start) * 8
+	first, last := ipToInt(start), ipToInt(end)
+	one := big.NewInt(1)
+
+	var out []*net.IPNet
+	for first.Cmp(last) <= 0 {
+		// Take the largest block aligned at first which doesn't pass last.
+		size := 0
+		for size < bits && first.Bit(size) == 0 {
+			blockEnd := new(big.Int).Lsh(one, uint(size+1))
+			blockEnd.Add(blockEnd, first).Sub(blockEnd, one)
+			if blockEnd.Cmp(last) > 0 {
+				break
+			}
+			size++
+		}
+		out = append(out, &net.IPNet{
+			IP:   intToIP(first, len(start)),
+			Mask: net.CIDRMask(bits-size, bits),
+		})
+		first.Add(first, new(big.Int).Lsh(one, uint(size)))
+	}
+	return out, nil
+}
+
+// parseIP reads an address, returning IPv4 addresses as 4 bytes.
+func parseIP(s string) net.IP {
+	return normalizeIP(net.ParseIP(strings.TrimSpace(s)))
+}
+
+func normalizeIP(ip net.IP) net.IP {
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	if len(ip) != net.IPv6len {
+		return nil
+	}
+	return ip
+}
+
+// lastIP returns the last address of n.
+func lastIP(n *net.IPNet) net.IP {
+	ip := normalizeIP(n.IP)
+	mask := n.Mask
+	if len(mask) != len(ip) {
+		ones, _ := mask.Size()
+		mask = net.CIDRMask(ones, len(ip)*8)
+	}
+	out := make(net.IP, len(ip))
+	for i := range ip {
+		out[i] = ip[i] | ^mask[i]
+	}
+	return out
+}
+
+// commonPrefixLen returns how many leading bits a and b share.
+func commonPrefixLen(a, b net.IP) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			n := i * 8
+			for x&0x80 == 0 {
+				x <<= 1
+				n++
+			}
+			return n
+		}
+	}
+	return len(a) * 8
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+func intToIP(i *big.Int, length int) net.IP {
+	bs := i.Bytes()
+	out := make(net.IP, length)
+	copy(out[length-len(bs):], bs)
+	return out
+}
+
+// ParentNetwork returns the network n was allocated or assigned from, or
+// nil if it's a top level block.
+//
+// The parent is found by following n's rel="up" link when it has one.
+// Otherwise, when the server gave a parentHandle, the smallest network
+// strictly containing n is looked up on BaseAddress by querying ever
+// shorter prefixes covering it.
+func (c *Client) ParentNetwork(n *IPNetwork) (*IPNetwork, error) {
+	return c.parentNetwork("", n)
+}
+
+// parentNetwork is ParentNetwork, querying server rather than BaseAddress
+// when it's set.
+func (c *Client) parentNetwork(server string, n *IPNetwork) (*IPNetwork, error) {
+	if n == nil {
+		return nil, errors.New("nil IPNetwork")
+	}
+	for _, link := range n.Links.Rel(RelUp) {
+		if parent, err := c.followNetworkLink(link); parent != nil || err != nil {
+			return parent, err
+		}
+	}
+
+	if n.ParentHandle == "" {
+		return nil, nil
+	}
+	start, end, err := n.Range()
+	if err != nil {
+		return nil, err
+	}
+	// Start from the smallest prefix covering all of n.
+	bits := len(start) * 8
+	for ones := commonPrefixLen(start, end); ones >= 0; ones-- {
+		prefix := &net.IPNet{IP: start.Mask(net.CIDRMask(ones, bits)), Mask: net.CIDRMask(ones, bits)}
+		parent, err := c.ipNetwork(server, prefix)
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n.MoreSpecificThan(parent) {
+			return parent, nil
+		}
+	}
+	return nil, nil
+}
+
+// ipNetwork queries server (or BaseAddress) for the most specific network
+// containing prefix. Single addresses are queried without a prefix length.
+func (c *Client) ipNetwork(server string, prefix *net.IPNet) (*IPNetwork, error) {
+	seg := prefix.String()
+	if ones, bits := prefix.Mask.Size(); ones == bits {
+		seg = prefix.IP.String()
+	}
+	bs, err := c.getFrom(server, ClassIPNetwork, "/ip/"+seg)
+	if err != nil {
+		return nil, err
+	}
+	var network IPNetwork
+	if err := c.decode(bs, &network); err != nil {
+		return nil, fmt.Errorf("error parsing ip network response: %v", err)
+	}
+	if network.ObjectClassName != ClassIPNetwork {
+		return &network, fmt.Errorf("unknown objectClassName: %q", network.ObjectClassName)
+	}
+	return &network, nil
+}
+
+// followNetworkLink requests the IP network link points at, returning nil
+// if the link isn't to an RDAP IP network.
+func (c *Client) followNetworkLink(link Link) (*IPNetwork, error) {
+	target := link.Target()
+	if target == nil || !target.IsAbs() {
+		return nil, nil
+	}
+	// Some servers leave off the type, so fall back to the path.
+	if !link.IsRDAP() && (link.Type != "" || !strings.Contains(target.Path, "/ip/")) {
+		return nil, nil
+	}
+	bs, err := c.getURL(ClassIPNetwork, target)
+	if err != nil {
+		return nil, fmt.Errorf("following %s link %s: %v", link.Rel, target, err)
+	}
+	var network IPNetwork
+	if err := c.decode(bs, &network); err != nil {
+		return nil, fmt.Errorf("error parsing ip network response from %s: %v", target, err)
+	}
+	if network.ObjectClassName != ClassIPNetwork {
+		return &network, fmt.Errorf("unknown objectClassName from %s: %q", target, network.ObjectClassName)
+	}
+	return &network, nil
+}
+
+// DefaultMaxNetworkDepth is the MaxNetworkDepth of a Client which doesn't
+// set one.
+const DefaultMaxNetworkDepth = 32
+
+// ParentNetworks returns every network above n, nearest first, by calling
+// ParentNetwork until the top level block is reached. Walking stops with
+// an error if a network is seen twice or the Client's MaxNetworkDepth is
+// reached.
+func (c *Client) ParentNetworks(n *IPNetwork) ([]*IPNetwork, error) {
+	if n == nil {
+		return nil, errors.New("nil IPNetwork")
+	}
+	return c.parentNetworks("", n, networkSet{n.key(): true})
+}
+
+func (c *Client) parentNetworks(server string, n *IPNetwork, seen networkSet) ([]*IPNetwork, error) {
+	var out []*IPNetwork
+	for len(seen) < c.maxNetworkDepth() {
+		parent, err := c.parentNetwork(server, n)
+		if err != nil || parent == nil {
+			return out, err
+		}
+		if err := seen.add(parent); err != nil {
+			return out, err
+		}
+		out = append(out, parent)
+		n = parent
+	}
+	return out, fmt.Errorf("more than %d ip networks in hierarchy", c.maxNetworkDepth())
+}
+
+func (c *Client) maxNetworkDepth() int {
+	if c.MaxNetworkDepth > 0 {
+		return c.MaxNetworkDepth
+	}
+	return DefaultMaxNetworkDepth
+}
+
+// networkSet tracks the networks walked through to catch loops.
+type networkSet map[string]bool
+
+func (s networkSet) add(n *IPNetwork) error {
+	key := n.key()
+	if s[key] {
+		return fmt.Errorf("ip network %s links back to itself", key)
+	}
+	s[key] = true
+	return nil
+}
+
+// key identifies the network by handle, or by range when it has none.
+func (n *IPNetwork) key() string {
+	if n.Handle != "" {
+		return n.Handle
+	}
+	return n.StartAddress + " - " + n.EndAddress
+}
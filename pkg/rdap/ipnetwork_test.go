@@ -0,0 +1,172 @@
+package rdap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRangeToCIDRs(t *testing.T) {
+	cases := []struct {
+		start, end string
+		expected   string
+	}{
+		{"192.0.2.0", "192.0.2.255", "192.0.2.0/24"},
+		{"192.0.2.7", "192.0.2.7", "192.0.2.7/32"},
+		{"192.0.2.0", "192.0.3.127", "192.0.2.0/24 192.0.3.0/25"},
+		{"192.0.2.1", "192.0.2.6", "192.0.2.1/32 192.0.2.2/31 192.0.2.4/31 192.0.2.6/32"},
+		{"0.0.0.0", "255.255.255.255", "0.0.0.0/0"},
+		{"::ffff:10.0.0.0", "10.0.1.255", "10.0.0.0/23"},
+		{"2001:db8::", "2001:db8:1:ffff:ffff:ffff:ffff:ffff", "2001:db8::/47"},
+		{"2001:db8::1", "2001:db8::2", "2001:db8::1/128 2001:db8::2/128"},
+	}
+	for _, tc := range cases {
+		cidrs, err := RangeToCIDRs(net.ParseIP(tc.start), net.ParseIP(tc.end))
+		if err != nil {
+			t.Errorf("%s - %s: %v", tc.start, tc.end, err)
+			continue
+		}
+		var got []string
+		for i := range cidrs {
+			got = append(got, cidrs[i].String())
+		}
+		if strings.Join(got, " ") != tc.expected {
+			t.Errorf("%s - %s: got %v, expected %s", tc.start, tc.end, got, tc.expected)
+		}
+	}
+
+	failures := [][2]string{
+		{"192.0.2.9", "192.0.2.1"},
+		{"192.0.2.1", "2001:db8::"},
+		{"", "192.0.2.1"},
+	}
+	for _, tc := range failures {
+		if _, err := RangeToCIDRs(net.ParseIP(tc[0]), net.ParseIP(tc[1])); err == nil {
+			t.Errorf("%s - %s: expected error", tc[0], tc[1])
+		}
+	}
+}
+
+func TestIPNetwork__math(t *testing.T) {
+	n := &IPNetwork{StartAddress: "192.0.2.0", EndAddress: "192.0.3.127"}
+	size, err := n.Size()
+	if err != nil || size.Int64() != 384 {
+		t.Errorf("got size %v: %v", size, err)
+	}
+
+	contains := map[string]bool{
+		"192.0.2.1":      true,
+		"192.0.3.0/25":   true,
+		"192.0.2.0/24":   true,
+		"192.0.2.0/23":   false,
+		"192.0.3.128":    false,
+		"2001:db8::1":    false,
+		"::ffff:192.0.2": false,
+	}
+	for prefix, expected := range contains {
+		ok, err := n.Contains(prefix)
+		if err != nil && prefix != "::ffff:192.0.2" {
+			t.Errorf("%s: %v", prefix, err)
+		}
+		if ok != expected {
+			t.Errorf("%s: got %v", prefix, ok)
+		}
+	}
+
+	parent := &IPNetwork{StartAddress: "192.0.0.0", EndAddress: "192.0.255.255"}
+	other := &IPNetwork{StartAddress: "198.51.100.0", EndAddress: "198.51.100.255"}
+	bad := &IPNetwork{StartAddress: "junk"}
+	if !n.MoreSpecificThan(parent) || parent.MoreSpecificThan(n) || n.MoreSpecificThan(n) || n.MoreSpecificThan(other) || bad.MoreSpecificThan(parent) {
+		t.Error("unexpected specificity")
+	}
+
+	networks := []*IPNetwork{bad, parent, other, n}
+	SortBySpecificity(networks)
+	if networks[0] != other || networks[1] != n || networks[2] != parent || networks[3] != bad {
+		t.Errorf("got order %v", networks)
+	}
+}
+
+func TestIPNetwork__cidrs(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/arin-ip-network.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n IPNetwork
+	if err := json.Unmarshal(bs, &n); err != nil {
+		t.Fatal(err)
+	}
+	cidrs, err := n.CIDRs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cidrs) != 1 || cidrs[0].String() != "198.51.100.0/24" {
+		t.Errorf("got %v", cidrs)
+	}
+
+	// Only cidr0 sent
+	n.StartAddress, n.EndAddress = "", ""
+	start, end, err := n.Range()
+	if err != nil || start.String() != "198.51.100.0" || end.String() != "198.51.100.255" {
+		t.Errorf("got %s - %s: %v", start, end, err)
+	}
+}
+
+func TestClient__parentNetworks(t *testing.T) {
+	var paths []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/ip/198.51.0.0/16":
+			// Linked by the child's rel="up" link, but has no link itself
+			w.Write([]byte(`{"objectClassName":"ip network","handle":"NET-198-51-0-0-1","startAddress":"198.51.0.0","endAddress":"198.51.255.255","parentHandle":"NET-198-0-0-0-0"}`))
+		case "/ip/198.51.0.0/15", "/ip/198.50.0.0/15":
+			w.Write([]byte(`{"objectClassName":"ip network","handle":"NET-198-51-0-0-1","startAddress":"198.51.0.0","endAddress":"198.51.255.255","parentHandle":"NET-198-0-0-0-0"}`))
+		case "/ip/198.48.0.0/14":
+			w.Write([]byte(`{"objectClassName":"ip network","handle":"NET-198-0-0-0-0","startAddress":"198.0.0.0","endAddress":"198.255.255.255"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer svc.Close()
+
+	up, _ := url.Parse(svc.URL + "/ip/198.51.0.0/16")
+	child := &IPNetwork{
+		Handle:       "NET-198-51-100-0-1",
+		StartAddress: "198.51.100.0",
+		EndAddress:   "198.51.100.255",
+		ParentHandle: "NET-198-51-0-0-1",
+		Common: Common{
+			Links: Links{{Rel: RelUp, Type: RDAPContentType, Href: up}},
+		},
+	}
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	parents, err := client.ParentNetworks(child)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parents) != 2 || parents[0].Handle != "NET-198-51-0-0-1" || parents[1].Handle != "NET-198-0-0-0-0" {
+		t.Errorf("got %v", parents)
+	}
+	expected := "/ip/198.51.0.0/16 /ip/198.51.0.0/16 /ip/198.50.0.0/15 /ip/198.48.0.0/14"
+	if got := strings.Join(paths, " "); got != expected {
+		t.Errorf("got requests %s", got)
+	}
+
+	// No parentHandle or up link is a top level network
+	parent, err := client.ParentNetwork(&IPNetwork{StartAddress: "198.0.0.0", EndAddress: "198.255.255.255"})
+	if parent != nil || err != nil {
+		t.Errorf("got %v: %v", parent, err)
+	}
+	if parents, err := client.ParentNetworks(nil); parents != nil || err == nil {
+		t.Errorf("got %v: %v", parents, err)
+	}
+}
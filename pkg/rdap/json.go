@@ -1,53 +1,54 @@
 package rdap
 
-import (
-	"time"
-)
+// EntityJSON is the former name of Entity.
+//
+// Deprecated: Use Entity.
+type EntityJSON = Entity
+
+// IPNetworkJSON is the former name of IPNetwork.
+//
+// Deprecated: Use IPNetwork.
+type IPNetworkJSON = IPNetwork
+
+// NameserverJSON is the former name of Nameserver.
+//
+// Deprecated: Use Nameserver.
+type NameserverJSON = Nameserver
 
 type LinkJSON struct {
-	Value string `json:"value"`
-	Rel   string `json:"rel"`
-	Href  string `json:"href"`
-	Type  string `json:"type"`
+	Value    string   `json:"value,omitempty"`
+	Rel      string   `json:"rel,omitempty"`
+	Href     string   `json:"href"`
+	HrefLang []string `json:"hreflang,omitempty"`
+	Title    string   `json:"title,omitempty"`
+	Media    string   `json:"media,omitempty"`
+	Type     string   `json:"type,omitempty"`
 }
 
+// RemarkJSON is the structure of both notices and remarks.
+// RFC7483 Section 4.3
 type RemarkJSON struct {
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
 	Description []string `json:"description,omitempty"`
-}
+	Links       Links    `json:"links,omitempty"`
 
-type EventJSON struct {
-	EventAction string    `json:"eventAction,omitempty"`
-	EventDate   time.Time `json:"eventDate,omitempty"`
+	Extensions Extensions `json:"-"`
 }
 
-type EntityJSON struct {
-	ObjectClassName string        `json:"objectClassName"`
-	Handle          string        `json:"handle"`
-	VcardArray      []interface{} `json:"vcardArray"`
-	Roles           []string      `json:"roles"`
-	Remarks         []struct {
-		Description []string `json:"description"`
-	} `json:"remarks"`
-	Links  []LinkJSON `json:"links"`
-	Events []struct {
-		EventAction string    `json:"eventAction"`
-		EventDate   time.Time `json:"eventDate"`
-	} `json:"events"`
+func (r *RemarkJSON) UnmarshalJSON(bs []byte) error {
+	type remark RemarkJSON
+	var v remark
+	ext, err := unmarshalObject(bs, &v)
+	if err != nil {
+		return err
+	}
+	*r = RemarkJSON(v)
+	r.Extensions = ext
+	return nil
 }
 
-type IPNetworkJSON struct {
-	ObjectClassName string       `json:"objectClassName"`
-	Handle          string       `json:"handle,omitempty"`
-	StartAddress    string       `json:"startAddress,omitempty"`
-	EndAddress      string       `json:"endAddress,omitempty"`
-	IPVersion       string       `json:"ipVersion,omitempty"`
-	Name            string       `json:"name,omitempty"`
-	Type            string       `json:"type,omitempty"`
-	Country         string       `json:"country,omitempty"`
-	ParentHandle    string       `json:"parentHandle,omitempty"`
-	Status          []string     `json:"status,omitempty"`
-	Remarks         []RemarkJSON `json:"remarks,omitempty"`
-	Links           []LinkJSON   `json:"links,omitempty"`
-	Events          []EventJSON  `json:"events,omitempty"`
-	Entities        []EntityJSON `json:"entities,omitempty"`
+func (r RemarkJSON) MarshalJSON() ([]byte, error) {
+	type remark RemarkJSON
+	return marshalObject(remark(r), r.Extensions)
 }
@@ -0,0 +1,524 @@
+package rdap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RFC9537 Section 4.2
+// JSONPath [RFC9535] expressions identify the redacted members of a
+// response. The expressions seen in practice use a small part of
+// JSONPath: member names, array indexes and slices, wildcards and filters comparing
+// a member of the current node to a literal, e.g.
+//
+//    $.entities[?(@.roles[0]=='registrant')].vcardArray[1][?(@[0]=='fn')][3]
+//
+// jsonPath implements that subset, accepting filters both with and without
+// the parentheses required by earlier drafts. Descendant segments (..) and
+// function extensions aren't supported.
+
+// jsonPath is a parsed JSONPath query.
+type jsonPath []pathSegment
+
+type pathSegment struct {
+	kind   segmentKind
+	name   string
+	index  int
+	filter filterExpr
+
+	// slice bounds, nil when omitted
+	start, end *int
+}
+
+type segmentKind int
+
+const (
+	segName segmentKind = iota
+	segIndex
+	segWildcard
+	segFilter
+	segSlice
+)
+
+// pathMatch is a node selected by a jsonPath along with its location,
+// made up of member names (string) and array indexes (int).
+type pathMatch struct {
+	location []interface{}
+	value    interface{}
+}
+
+func parseJSONPath(expr string) (jsonPath, error) {
+	p := &pathParser{in: strings.TrimSpace(expr)}
+	if !p.consume("$") {
+		return nil, fmt.Errorf("jsonpath %q must start with $", expr)
+	}
+	path, err := p.segments()
+	if err != nil {
+		return nil, fmt.Errorf("jsonpath %q: %v", expr, err)
+	}
+	if p.pos != len(p.in) {
+		return nil, fmt.Errorf("jsonpath %q: unexpected %q", expr, p.in[p.pos:])
+	}
+	return path, nil
+}
+
+// eval returns every node of doc (decoded with encoding/json into
+// interface{} values) selected by the path.
+func (path jsonPath) eval(doc interface{}) []pathMatch {
+	matches := []pathMatch{{value: doc}}
+	for _, seg := range path {
+		var next []pathMatch
+		for _, m := range matches {
+			next = append(next, seg.apply(doc, m)...)
+		}
+		matches = next
+	}
+	return matches
+}
+
+func (seg pathSegment) apply(root interface{}, m pathMatch) []pathMatch {
+	child := func(key interface{}, v interface{}) pathMatch {
+		loc := make([]interface{}, len(m.location), len(m.location)+1)
+		copy(loc, m.location)
+		return pathMatch{location: append(loc, key), value: v}
+	}
+
+	switch seg.kind {
+	case segName:
+		if obj, ok := m.value.(map[string]interface{}); ok {
+			if v, exists := obj[seg.name]; exists {
+				return []pathMatch{child(seg.name, v)}
+			}
+		}
+	case segIndex:
+		if arr, ok := m.value.([]interface{}); ok {
+			idx := seg.index
+			if idx < 0 {
+				idx += len(arr)
+			}
+			if idx >= 0 && idx < len(arr) {
+				return []pathMatch{child(idx, arr[idx])}
+			}
+		}
+	case segSlice:
+		var out []pathMatch
+		if arr, ok := m.value.([]interface{}); ok {
+			start, end := sliceBound(seg.start, 0, len(arr)), sliceBound(seg.end, len(arr), len(arr))
+			for i := start; i < end; i++ {
+				out = append(out, child(i, arr[i]))
+			}
+		}
+		return out
+	case segWildcard, segFilter:
+		var out []pathMatch
+		switch v := m.value.(type) {
+		case []interface{}:
+			for i := range v {
+				if seg.kind == segWildcard || truthy(seg.filter.eval(root, v[i])) {
+					out = append(out, child(i, v[i]))
+				}
+			}
+		case map[string]interface{}:
+			for _, k := range sortedKeys(v) {
+				if seg.kind == segWildcard || truthy(seg.filter.eval(root, v[k])) {
+					out = append(out, child(k, v[k]))
+				}
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// sliceBound normalizes a slice index (which may be negative) to [0, n].
+func sliceBound(idx *int, def, n int) int {
+	if idx == nil {
+		return def
+	}
+	i := *idx
+	if i < 0 {
+		i += n
+	}
+	if i < 0 {
+		return 0
+	}
+	if i > n {
+		return n
+	}
+	return i
+}
+
+// filterExpr is a node of a filter expression.
+type filterExpr interface {
+	eval(root, current interface{}) interface{}
+}
+
+type (
+	literalExpr struct{ v interface{} }
+	queryExpr   struct {
+		relative bool
+		path     jsonPath
+	}
+	notExpr     struct{ x filterExpr }
+	logicalExpr struct {
+		op   string
+		l, r filterExpr
+	}
+	compareExpr struct {
+		op   string
+		l, r filterExpr
+	}
+)
+
+// nothing is the result of a query which selects no nodes.
+type nothing struct{}
+
+func (e literalExpr) eval(_, _ interface{}) interface{} { return e.v }
+
+func (e queryExpr) eval(root, current interface{}) interface{} {
+	start := root
+	if e.relative {
+		start = current
+	}
+	matches := e.path.eval(start)
+	if len(matches) == 0 {
+		return nothing{}
+	}
+	return matches[0].value
+}
+
+func (e notExpr) eval(root, current interface{}) interface{} {
+	return !truthy(e.x.eval(root, current))
+}
+
+func (e logicalExpr) eval(root, current interface{}) interface{} {
+	if e.op == "&&" {
+		return truthy(e.l.eval(root, current)) && truthy(e.r.eval(root, current))
+	}
+	return truthy(e.l.eval(root, current)) || truthy(e.r.eval(root, current))
+}
+
+func (e compareExpr) eval(root, current interface{}) interface{} {
+	l, r := e.l.eval(root, current), e.r.eval(root, current)
+	if _, missing := l.(nothing); missing {
+		return e.op == "!=" && !isNothing(r)
+	}
+	if _, missing := r.(nothing); missing {
+		return e.op == "!="
+	}
+	switch e.op {
+	case "==":
+		return equalJSON(l, r)
+	case "!=":
+		return !equalJSON(l, r)
+	}
+	// ordering only applies to numbers and strings
+	switch lv := l.(type) {
+	case float64:
+		rv, ok := r.(float64)
+		return ok && compareOrder(e.op, lv < rv, lv == rv)
+	case string:
+		rv, ok := r.(string)
+		return ok && compareOrder(e.op, lv < rv, lv == rv)
+	}
+	return false
+}
+
+func compareOrder(op string, less, equal bool) bool {
+	switch op {
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	case ">":
+		return !less && !equal
+	case ">=":
+		return !less
+	}
+	return false
+}
+
+func isNothing(v interface{}) bool {
+	_, ok := v.(nothing)
+	return ok
+}
+
+func truthy(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case nothing:
+		return false
+	}
+	// An existence test, e.g. [?@.handle], selects nodes with the member
+	// whatever its value.
+	return true
+}
+
+func equalJSON(a, b interface{}) bool {
+	switch av := a.(type) {
+	case []interface{}, map[string]interface{}:
+		return fmt.Sprint(av) == fmt.Sprint(b)
+	}
+	return a == b
+}
+
+// pathParser is a recursive descent parser for jsonPath.
+type pathParser struct {
+	in  string
+	pos int
+}
+
+func (p *pathParser) skipSpace() {
+	for p.pos < len(p.in) && (p.in[p.pos] == ' ' || p.in[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *pathParser) peek(s string) bool {
+	return strings.HasPrefix(p.in[p.pos:], s)
+}
+
+func (p *pathParser) consume(s string) bool {
+	if p.peek(s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+// segments reads every segment following $ or @.
+func (p *pathParser) segments() (jsonPath, error) {
+	var path jsonPath
+	for p.pos < len(p.in) {
+		switch {
+		case p.peek(".."):
+			return nil, errors.New("descendant segments (..) are not supported")
+		case p.consume(".*"):
+			path = append(path, pathSegment{kind: segWildcard})
+		case p.consume("."):
+			name := p.name()
+			if name == "" {
+				return nil, fmt.Errorf("expected member name at %d", p.pos)
+			}
+			path = append(path, pathSegment{kind: segName, name: name})
+		case p.consume("["):
+			seg, err := p.bracket()
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, seg)
+		default:
+			return path, nil
+		}
+	}
+	return path, nil
+}
+
+func (p *pathParser) name() string {
+	start := p.pos
+	for p.pos < len(p.in) {
+		c := p.in[p.pos]
+		if c == '_' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80 {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.in[start:p.pos]
+}
+
+func (p *pathParser) bracket() (pathSegment, error) {
+	p.skipSpace()
+	var seg pathSegment
+	switch {
+	case p.consume("*"):
+		seg.kind = segWildcard
+	case p.peek("'") || p.peek(`"`):
+		s, err := p.str()
+		if err != nil {
+			return seg, err
+		}
+		seg.kind, seg.name = segName, s
+	case p.consume("?"):
+		p.skipSpace()
+		expr, err := p.or()
+		if err != nil {
+			return seg, err
+		}
+		seg.kind, seg.filter = segFilter, expr
+	default:
+		start, err := p.index()
+		if err != nil {
+			return seg, err
+		}
+		p.skipSpace()
+		if !p.consume(":") {
+			if start == nil {
+				return seg, fmt.Errorf("expected array index at %d", p.pos)
+			}
+			seg.kind, seg.index = segIndex, *start
+			break
+		}
+		end, err := p.index()
+		if err != nil {
+			return seg, err
+		}
+		seg.kind, seg.start, seg.end = segSlice, start, end
+	}
+	p.skipSpace()
+	if !p.consume("]") {
+		return seg, fmt.Errorf("expected ] at %d", p.pos)
+	}
+	return seg, nil
+}
+
+// index reads an integer, or nil if it was omitted (as slice bounds may be).
+func (p *pathParser) index() (*int, error) {
+	p.skipSpace()
+	if p.peek(":") || p.peek("]") {
+		return nil, nil
+	}
+	n, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	if n != float64(int(n)) {
+		return nil, fmt.Errorf("invalid array index %v", n)
+	}
+	i := int(n)
+	return &i, nil
+}
+
+func (p *pathParser) str() (string, error) {
+	quote := p.in[p.pos]
+	p.pos++
+	var buf strings.Builder
+	for p.pos < len(p.in) {
+		c := p.in[p.pos]
+		p.pos++
+		switch {
+		case c == '\\' && p.pos < len(p.in):
+			buf.WriteByte(p.in[p.pos])
+			p.pos++
+		case c == quote:
+			return buf.String(), nil
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated string")
+}
+
+func (p *pathParser) number() (float64, error) {
+	start := p.pos
+	for p.pos < len(p.in) && strings.IndexByte("+-.eE0123456789", p.in[p.pos]) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(p.in[start:p.pos], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number at %d", start)
+	}
+	return n, nil
+}
+
+func (p *pathParser) or() (filterExpr, error) {
+	l, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("||") {
+			return l, nil
+		}
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l = logicalExpr{op: "||", l: l, r: r}
+	}
+}
+
+func (p *pathParser) and() (filterExpr, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("&&") {
+			return l, nil
+		}
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = logicalExpr{op: "&&", l: l, r: r}
+	}
+}
+
+func (p *pathParser) unary() (filterExpr, error) {
+	p.skipSpace()
+	if p.peek("!") && !p.peek("!=") {
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{x}, nil
+	}
+	if p.consume("(") {
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, fmt.Errorf("expected ) at %d", p.pos)
+		}
+		return x, nil
+	}
+
+	l, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			p.skipSpace()
+			r, err := p.operand()
+			if err != nil {
+				return nil, err
+			}
+			return compareExpr{op: op, l: l, r: r}, nil
+		}
+	}
+	return l, nil
+}
+
+func (p *pathParser) operand() (filterExpr, error) {
+	p.skipSpace()
+	switch {
+	case p.consume("@"):
+		path, err := p.segments()
+		return queryExpr{relative: true, path: path}, err
+	case p.consume("$"):
+		path, err := p.segments()
+		return queryExpr{path: path}, err
+	case p.peek("'") || p.peek(`"`):
+		s, err := p.str()
+		return literalExpr{s}, err
+	case p.consume("true"):
+		return literalExpr{true}, nil
+	case p.consume("false"):
+		return literalExpr{false}, nil
+	case p.consume("null"):
+		return literalExpr{nil}, nil
+	}
+	n, err := p.number()
+	return literalExpr{n}, err
+}
@@ -0,0 +1,228 @@
+package rdap
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RFC7483 Section 4.2
+// The "links" array is found in data structures to signify links to
+// other resources on the Internet.  The relationship of these links is
+// defined by the IANA registry described by [RFC5988].
+//
+// RFC5988 has since been obsoleted by RFC8288 which keeps the same registry.
+
+// Relation is the "rel" of a Link. Values are compared case-insensitively.
+type Relation string
+
+// Link relations from the IANA Link Relations registry which commonly
+// appear in RDAP responses.
+const (
+	RelAbout          Relation = "about"
+	RelAlternate      Relation = "alternate"
+	RelCanonical      Relation = "canonical"
+	RelCopyright      Relation = "copyright"
+	RelDescribedBy    Relation = "describedby"
+	RelFirst          Relation = "first"
+	RelHelp           Relation = "help"
+	RelLast           Relation = "last"
+	RelLicense        Relation = "license"
+	RelNext           Relation = "next"
+	RelPrev           Relation = "prev"
+	RelPrivacyPolicy  Relation = "privacy-policy"
+	RelRelated        Relation = "related"
+	RelSelf           Relation = "self"
+	RelTermsOfService Relation = "terms-of-service"
+	RelUp             Relation = "up"
+
+	// RelDown is the inverse of "up" and is sent by some RIRs to point
+	// at more specific networks. It isn't in the IANA registry.
+	RelDown Relation = "down"
+)
+
+// registeredRelations is the IANA Link Relations registry.
+// https://www.iana.org/assignments/link-relations/link-relations.xhtml
+var registeredRelations = map[Relation]bool{
+	"about": true, "acl": true, "alternate": true, "amphtml": true,
+	"appendix": true, "apple-touch-icon": true, "apple-touch-startup-image": true,
+	"archives": true, "author": true, "blocked-by": true, "bookmark": true,
+	"canonical": true, "chapter": true, "cite-as": true,
+	"collection": true, "contents": true,
+	"convertedfrom": true, "copyright": true, "create-form": true,
+	"current": true, "deprecation": true, "describedby": true,
+	"describes": true, "disclosure": true, "dns-prefetch": true,
+	"duplicate": true, "edit": true, "edit-form": true, "edit-media": true,
+	"enclosure": true, "external": true, "first": true, "glossary": true,
+	"help": true, "hosts": true, "hub": true, "ice-server": true,
+	"icon": true, "index": true, "intervalafter": true,
+	"intervalbefore": true, "intervalcontains": true,
+	"intervaldisjoint": true, "intervalduring": true,
+	"intervalequals": true, "intervalfinishedby": true,
+	"intervalfinishes": true, "intervalin": true, "intervalmeets": true,
+	"intervalmetby": true, "intervaloverlappedby": true,
+	"intervaloverlaps": true, "intervalstartedby": true,
+	"intervalstarts": true, "item": true, "last": true,
+	"latest-version": true, "license": true, "linkset": true, "lrdd": true,
+	"manifest": true, "mask-icon": true, "me": true, "media-feed": true,
+	"memento": true, "micropub": true, "modulepreload": true,
+	"monitor": true, "monitor-group": true, "next": true,
+	"next-archive": true, "nofollow": true, "noopener": true,
+	"noreferrer": true, "opener": true, "openid2.local_id": true,
+	"openid2.provider": true, "original": true, "p3pv1": true,
+	"payment": true, "pingback": true, "preconnect": true,
+	"predecessor-version": true, "prefetch": true, "preload": true,
+	"prerender": true, "prev": true, "prev-archive": true, "preview": true,
+	"previous": true, "privacy-policy": true, "profile": true,
+	"publication": true, "related": true, "replies": true, "restconf": true,
+	"search": true, "section": true, "self": true,
+	"service": true, "service-desc": true, "service-doc": true,
+	"service-meta": true, "sip-trunking-capability": true,
+	"sponsored": true, "start": true, "stylesheet": true,
+	"subsection": true, "successor-version": true, "sunset": true,
+	"tag": true, "terms-of-service": true, "timegate": true,
+	"timemap": true, "type": true, "ugc": true, "up": true,
+	"version-history": true, "via": true, "webmention": true,
+	"working-copy": true, "working-copy-of": true,
+}
+
+// Registered returns true if r is in the IANA Link Relations registry.
+// Extension relations (which RFC8288 requires to be URIs) return false.
+func (r Relation) Registered() bool {
+	return registeredRelations[Relation(strings.ToLower(string(r)))]
+}
+
+// Link is a parsed RDAP link.
+//
+// See rfc-7483-section-4-10-example.json
+type Link struct {
+	// Value is the context URI, typically the URL of the object the
+	// link was found on.
+	Value *url.URL
+
+	Rel Relation
+
+	// Href is the target of the link.
+	Href *url.URL
+
+	HrefLang []string
+	Title    string
+	Media    string
+
+	// Type is the media type of the target, application/rdap+json for
+	// links to other RDAP objects.
+	Type string
+
+	// Extensions are members of the link this package doesn't model.
+	Extensions Extensions
+}
+
+// RDAPContentType is the media type of RDAP responses, RFC7483 Section 10.1
+const RDAPContentType = "application/rdap+json"
+
+// Target returns the link's Href resolved against its Value, as relative
+// references are allowed.
+func (l Link) Target() *url.URL {
+	if l.Href == nil {
+		return nil
+	}
+	if l.Value != nil && !l.Href.IsAbs() {
+		return l.Value.ResolveReference(l.Href)
+	}
+	return l.Href
+}
+
+// IsRDAP returns true if the link's target is another RDAP object.
+func (l Link) IsRDAP() bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(l.Type, ";")[0]))
+	return mediaType == RDAPContentType
+}
+
+func (l Link) String() string {
+	return fmt.Sprintf("%s: %s", l.Rel, l.Target())
+}
+
+func (l *Link) UnmarshalJSON(bs []byte) error {
+	var wrapper struct {
+		LinkJSON
+		// hreflang is an array in RFC7483 but some servers send a string
+		HrefLang json.RawMessage `json:"hreflang"`
+	}
+	ext, err := unmarshalObject(bs, &wrapper)
+	if err != nil {
+		return err
+	}
+	raw := wrapper.LinkJSON
+
+	link := Link{
+		Extensions: ext,
+	}
+	if raw.Value != "" {
+		if link.Value, err = url.Parse(raw.Value); err != nil {
+			return fmt.Errorf("invalid link value %q: %v", raw.Value, err)
+		}
+	}
+	if raw.Href != "" {
+		if link.Href, err = url.Parse(raw.Href); err != nil {
+			return fmt.Errorf("invalid link href %q: %v", raw.Href, err)
+		}
+	}
+	if len(wrapper.HrefLang) > 0 && string(wrapper.HrefLang) != "null" {
+		if err := json.Unmarshal(wrapper.HrefLang, &link.HrefLang); err != nil {
+			var lang string
+			if err := json.Unmarshal(wrapper.HrefLang, &lang); err != nil {
+				return fmt.Errorf("invalid link hreflang: %s", wrapper.HrefLang)
+			}
+			link.HrefLang = []string{lang}
+		}
+	}
+	link.Rel = Relation(raw.Rel)
+	link.Title = raw.Title
+	link.Media = raw.Media
+	link.Type = raw.Type
+
+	*l = link
+	return nil
+}
+
+func (l Link) MarshalJSON() ([]byte, error) {
+	raw := LinkJSON{
+		Rel:      string(l.Rel),
+		HrefLang: l.HrefLang,
+		Title:    l.Title,
+		Media:    l.Media,
+		Type:     l.Type,
+	}
+	if l.Value != nil {
+		raw.Value = l.Value.String()
+	}
+	if l.Href != nil {
+		raw.Href = l.Href.String()
+	}
+	return marshalObject(raw, l.Extensions)
+}
+
+// Links is a list of Link values with helpers to pick out relations.
+type Links []Link
+
+// Rel returns each link with the given relation.
+func (ls Links) Rel(rel Relation) Links {
+	var out Links
+	for i := range ls {
+		if strings.EqualFold(string(ls[i].Rel), string(rel)) {
+			out = append(out, ls[i])
+		}
+	}
+	return out
+}
+
+// Self returns the "self" link, or nil if there isn't one.
+func (ls Links) Self() *Link {
+	for i := range ls {
+		if strings.EqualFold(string(ls[i].Rel), string(RelSelf)) {
+			return &ls[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package rdap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLink__unmarshal(t *testing.T) {
+	in := []byte(`{
+  "value" : "http://example.com/context_uri",
+  "rel" : "self",
+  "href" : "http://example.com/target_uri",
+  "hreflang" : [ "en", "ch" ],
+  "title" : "title",
+  "media" : "screen",
+  "type" : "application/json"
+}`)
+	var link Link
+	if err := json.Unmarshal(in, &link); err != nil {
+		t.Fatal(err)
+	}
+	if link.Value.Host != "example.com" || link.Href.Path != "/target_uri" {
+		t.Errorf("value=%v href=%v", link.Value, link.Href)
+	}
+	if link.Rel != RelSelf || !link.Rel.Registered() {
+		t.Errorf("rel=%q", link.Rel)
+	}
+	if len(link.HrefLang) != 2 || link.Title != "title" || link.Media != "screen" || link.Type != "application/json" {
+		t.Errorf("got %#v", link)
+	}
+	if link.IsRDAP() {
+		t.Error("application/json isn't an RDAP link")
+	}
+
+	// round trip
+	bs, err := json.Marshal(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again Link
+	if err := json.Unmarshal(bs, &again); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != link.String() || len(again.HrefLang) != 2 {
+		t.Errorf("got %#v", again)
+	}
+}
+
+func TestLink__relative(t *testing.T) {
+	in := []byte(`{"value":"https://rdap.example.net/ip/192.0.2.0/24","rel":"UP","href":"../198.51.100.0/16","hreflang":"en","type":"application/rdap+json"}`)
+	var link Link
+	if err := json.Unmarshal(in, &link); err != nil {
+		t.Fatal(err)
+	}
+	if v := link.Target().String(); v != "https://rdap.example.net/ip/198.51.100.0/16" {
+		t.Errorf("got %q", v)
+	}
+	if len(link.HrefLang) != 1 || link.HrefLang[0] != "en" {
+		t.Errorf("got %v", link.HrefLang)
+	}
+	if !link.IsRDAP() {
+		t.Error("expected RDAP link")
+	}
+	if links := (Links{link}).Rel(RelUp); len(links) != 1 {
+		t.Errorf("got %v", links)
+	}
+	if Relation("https://example.com/custom").Registered() {
+		t.Error("extension relation isn't registered")
+	}
+}
+
+func TestClient__RelatedDomains(t *testing.T) {
+	registrar, err := ioutil.ReadFile("../../testdata/markmonitor-google-domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var svc *httptest.Server
+	svc = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/domain/google.com":
+			fmt.Fprintf(w, `{"objectClassName":"domain","ldhName":"google.com","links":[
+{"value":"%[1]s/domain/google.com","rel":"self","href":"%[1]s/domain/google.com","type":"application/rdap+json"},
+{"value":"%[1]s/domain/google.com","rel":"related","href":"%[1]s/registrar/domain/google.com","type":"application/rdap+json"},
+{"value":"%[1]s/domain/google.com","rel":"related","href":"%[1]s/about.html","type":"text/html"}]}`, svc.URL)
+		case "/registrar/domain/google.com":
+			w.Write(registrar)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer svc.Close()
+
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+	domain, err := client.Domain("google.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.Source != SourceRegistry {
+		t.Errorf("got %q", domain.Source)
+	}
+
+	related, err := client.RelatedDomains(domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(related) != 1 {
+		t.Fatalf("got %d related domains", len(related))
+	}
+	if related[0].Source != SourceRegistrar || related[0].Handle != "2138514_DOMAIN_COM-VRSN" {
+		t.Errorf("got %#v", related[0])
+	}
+}
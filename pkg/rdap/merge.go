@@ -0,0 +1,263 @@
+package rdap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MergedDomain is a single view of a domain built from the registry's
+// response and the sponsoring registrar's response.
+//
+// Registrar contacts (entities), abuse information and events the
+// registry doesn't publish are layered over the registry's data. The
+// registry stays authoritative for everything else.
+type MergedDomain struct {
+	// Domain is the merged result.
+	Domain *Domain
+
+	// Registry and Registrar are the responses Domain was built from.
+	// Registrar is nil if the registry didn't link to one.
+	Registry  *Domain
+	Registrar *Domain
+
+	// Provenance maps each merged field to where its value came from.
+	// Keys are JSON member names, with the role or event action in
+	// brackets for entities and events (e.g. "entities[abuse]" or
+	// "events[expiration]"). Entities nested in a merged entity are keyed
+	// under it (e.g. "entities[registrar].entities[abuse]").
+	Provenance map[string]Source
+
+	// Conflicts are values both servers returned which disagree.
+	Conflicts []Conflict
+}
+
+// Conflict is a field where the registry and registrar disagree.
+// The registry's value is used in the merged Domain.
+type Conflict struct {
+	Field     string
+	Registry  string
+	Registrar string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: registry=%q registrar=%q", c.Field, c.Registry, c.Registrar)
+}
+
+func (m MergedDomain) String() string {
+	var buf strings.Builder
+	buf.WriteString(m.Domain.String())
+	for i := range m.Conflicts {
+		buf.WriteString("\nConflict: " + m.Conflicts[i].String())
+	}
+	return buf.String()
+}
+
+// MergeDomains combines a registry's domain response with the registrar's.
+// registrar can be nil, in which case the merged Domain is a copy of registry.
+func MergeDomains(registry, registrar *Domain) *MergedDomain {
+	merged := *registry
+	merged.Source = SourceRegistry
+
+	m := &MergedDomain{
+		Domain:     &merged,
+		Registry:   registry,
+		Registrar:  registrar,
+		Provenance: make(map[string]Source),
+	}
+	for _, field := range []string{"handle", "ldhName", "nameservers", "status", "port43"} {
+		m.Provenance[field] = SourceRegistry
+	}
+	if registrar == nil {
+		for _, e := range registry.Entities {
+			for _, role := range e.Roles {
+				m.Provenance["entities["+string(role)+"]"] = SourceRegistry
+			}
+		}
+		for _, e := range registry.Events {
+			m.Provenance["events["+string(e.EventAction)+"]"] = SourceRegistry
+		}
+		return m
+	}
+
+	if !strings.EqualFold(registry.LDHName, registrar.LDHName) {
+		m.conflict("ldhName", registry.LDHName, registrar.LDHName)
+	}
+	if a, b := registry.Status.String(), registrar.Status.String(); a != b {
+		m.conflict("status", a, b)
+	}
+	if a, b := nameserverNames(registry.Nameservers), nameserverNames(registrar.Nameservers); b != "" && a != b {
+		m.conflict("nameservers", a, b)
+	}
+	if registry.Port43 == "" && registrar.Port43 != "" {
+		merged.Port43 = registrar.Port43
+		m.Provenance["port43"] = SourceRegistrar
+	}
+
+	merged.Entities = m.mergeEntities(registry.Entities, registrar.Entities)
+	merged.Events = m.mergeEvents(registry.Events, registrar.Events)
+
+	// Keep every link, the registrar's self link is useful to have.
+	merged.Links = append(append(Links{}, registry.Links...), registrar.Links...)
+
+	return m
+}
+
+// mergeEntities layers the registrar's entities over the registry's for
+// every role the registrar returned. Registries of thin gTLDs often only
+// return the registrar entity, so this fills in registrant, technical,
+// abuse, etc. A registry entity with the same role is merged into the
+// registrar's copy rather than dropped, see mergeEntity.
+func (m *MergedDomain) mergeEntities(registry, registrar []Entity) []Entity {
+	return m.mergeEntitiesAt("entities", registry, registrar)
+}
+
+// mergeEntitiesAt merges entities found under the provenance key path,
+// e.g. "entities" or "entities[registrar].entities".
+func (m *MergedDomain) mergeEntitiesAt(path string, registry, registrar []Entity) []Entity {
+	fromRegistrar := func(role Role) bool {
+		for i := range registrar {
+			if registrar[i].HasRole(role) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var out []Entity
+	for _, e := range registry {
+		var keep []Role
+		for _, role := range e.Roles {
+			if !fromRegistrar(role) {
+				keep = append(keep, role)
+			}
+		}
+		if len(keep) == 0 && len(e.Roles) > 0 {
+			continue
+		}
+		for _, role := range keep {
+			m.Provenance[path+"["+string(role)+"]"] = SourceRegistry
+		}
+		out = append(out, e)
+	}
+	for _, e := range registrar {
+		var same []Entity
+		for _, r := range registry {
+			if sharesRole(e, r) {
+				same = append(same, r)
+			}
+		}
+		for _, role := range e.Roles {
+			m.Provenance[path+"["+string(role)+"]"] = SourceRegistrar
+		}
+		out = append(out, m.mergeEntity(path, e, same))
+	}
+	return out
+}
+
+// mergeEntity fills in what the registrar's entity is missing from the
+// registry's entities with the same role. Registries often hold data the
+// registrar leaves out, such as the IANA Registrar ID or an abuse contact
+// nested under the registrar, so public IDs are combined by type and
+// nested entities are merged by role.
+func (m *MergedDomain) mergeEntity(path string, e Entity, registry []Entity) Entity {
+	if len(registry) == 0 {
+		return e
+	}
+	if len(e.Roles) > 0 {
+		path += "[" + string(e.Roles[0]) + "]"
+	}
+
+	var nested []Entity
+	ids := append(PublicIDs{}, e.PublicIDs...)
+	for _, r := range registry {
+		if e.Handle == "" {
+			e.Handle = r.Handle
+		}
+		if len(e.VcardArray) == 0 {
+			e.VcardArray = r.VcardArray
+		}
+		for _, id := range r.PublicIDs {
+			if ids.Get(id.Type) == "" {
+				ids = append(ids, id)
+			}
+		}
+		nested = append(nested, r.Entities...)
+	}
+	if len(ids) > 0 {
+		e.PublicIDs = ids
+	}
+	if len(nested) > 0 {
+		e.Entities = m.mergeEntitiesAt(path+".entities", nested, e.Entities)
+	}
+	return e
+}
+
+func sharesRole(a, b Entity) bool {
+	for _, x := range a.Roles {
+		for _, y := range b.Roles {
+			if x.Is(y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeEvents keeps every registry event and adds registrar events with
+// actions the registry didn't return. Events with the same action but a
+// different date are reported as conflicts.
+func (m *MergedDomain) mergeEvents(registry, registrar []EventJSON) []EventJSON {
+	out := append([]EventJSON{}, registry...)
+
+	for _, e := range registry {
+		m.Provenance["events["+string(e.EventAction)+"]"] = SourceRegistry
+	}
+	for _, e := range registrar {
+		if prev := findEvent(out, e.EventAction); prev != nil {
+			if !prev.EventDate.Equal(e.EventDate) {
+				m.conflict("events["+string(prev.EventAction)+"]", prev.EventDate.String(), e.EventDate.String())
+			}
+			continue
+		}
+		m.Provenance["events["+string(e.EventAction)+"]"] = SourceRegistrar
+		out = append(out, e)
+	}
+	return out
+}
+
+// findEvent returns the first event with the action, compared without
+// case, or nil.
+func findEvent(events []EventJSON, action EventAction) *EventJSON {
+	for i := range events {
+		if events[i].EventAction.Is(action) {
+			return &events[i]
+		}
+	}
+	return nil
+}
+
+func (m *MergedDomain) conflict(field, registry, registrar string) {
+	m.Conflicts = append(m.Conflicts, Conflict{
+		Field:     field,
+		Registry:  registry,
+		Registrar: registrar,
+	})
+}
+
+func sortedStrings(in []string) string {
+	out := make([]string, len(in))
+	for i := range in {
+		out[i] = strings.ToLower(in[i])
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
+
+func nameserverNames(ns []Nameserver) string {
+	names := make([]string, len(ns))
+	for i := range ns {
+		names[i] = strings.TrimSuffix(ns[i].LDHName, ".")
+	}
+	return sortedStrings(names)
+}
@@ -0,0 +1,159 @@
+package rdap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func readDomain(t *testing.T, path string) *Domain {
+	t.Helper()
+
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var domain Domain
+	if err := json.Unmarshal(bs, &domain); err != nil {
+		t.Fatal(err)
+	}
+	return &domain
+}
+
+func TestMergeDomains(t *testing.T) {
+	registry := readDomain(t, "../../testdata/verisign-google-domain.json")
+	registrar := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+
+	m := MergeDomains(registry, registrar)
+	if m.Domain.LDHName != "google.com" || m.Domain.Handle != "2138514-VRSN" {
+		t.Errorf("got %s (%s)", m.Domain.LDHName, m.Domain.Handle)
+	}
+	if len(m.Domain.Nameservers) != len(registry.Nameservers) {
+		t.Errorf("got %d nameservers", len(m.Domain.Nameservers))
+	}
+
+	expected := map[string]Source{
+		"ldhName":                              SourceRegistry,
+		"nameservers":                          SourceRegistry,
+		"entities[registrar]":                  SourceRegistrar,
+		"entities[registrant]":                 SourceRegistrar,
+		"entities[technical]":                  SourceRegistrar,
+		"events[registration]":                 SourceRegistry,
+		"events[expiration]":                   SourceRegistry,
+		"events[last update of RDAP database]": SourceRegistrar,
+	}
+	for k, v := range expected {
+		if m.Provenance[k] != v {
+			t.Errorf("%s: got %q", k, m.Provenance[k])
+		}
+	}
+
+	// registrar entities replaced the registry's (thinner) registrar entity
+	if len(m.Domain.Entities) != 3 {
+		t.Errorf("got %d entities", len(m.Domain.Entities))
+	}
+	if len(m.Domain.Events) != 4 {
+		t.Errorf("got %d events", len(m.Domain.Events))
+	}
+
+	conflicts := make(map[string]Conflict)
+	for _, c := range m.Conflicts {
+		conflicts[c.Field] = c
+	}
+	if len(conflicts) != 4 {
+		t.Errorf("got conflicts: %v", m.Conflicts)
+	}
+	if c, ok := conflicts["events[expiration]"]; !ok || c.Registry != "2020-09-14 00:00:00 +0000 UTC" {
+		t.Errorf("got %v", c)
+	}
+	if _, ok := conflicts["nameservers"]; !ok {
+		t.Error("expected nameservers conflict")
+	}
+}
+
+func TestMergeDomains__noRegistrar(t *testing.T) {
+	registry := readDomain(t, "../../testdata/verisign-google-domain.json")
+
+	m := MergeDomains(registry, nil)
+	if m.Registrar != nil || len(m.Conflicts) != 0 {
+		t.Errorf("got %#v", m)
+	}
+	if m.Provenance["entities[registrar]"] != SourceRegistry {
+		t.Errorf("got %q", m.Provenance["entities[registrar]"])
+	}
+}
+
+func TestMergeDomains__entityFields(t *testing.T) {
+	registry := readDomain(t, "../../testdata/example-registry-domain.json")
+	registrar := readDomain(t, "../../testdata/example-registrar-domain.json")
+
+	m := MergeDomains(registry, registrar)
+	if len(m.Domain.Entities) != 2 {
+		t.Fatalf("got %d entities", len(m.Domain.Entities))
+	}
+
+	// the registrar's copy is used, with what only the registry had
+	e := m.Domain.Entities[0]
+	if len(e.Roles) != 1 || e.Roles[0] != "registrar" {
+		t.Fatalf("got %v", e.Roles)
+	}
+	if e.Handle != "9999-REG" {
+		t.Errorf("got handle %q", e.Handle)
+	}
+	if id := e.PublicIDs.Get(PublicIDTypeIANARegistrar); id != "9999" {
+		t.Errorf("got IANA ID %q", id)
+	}
+	if len(e.VcardArray) != 2 || len(e.VcardArray[1].([]interface{})) != 3 {
+		t.Errorf("expected the registrar's vCard, got %v", e.VcardArray)
+	}
+
+	// nested entities from both are kept
+	roles := make(map[Role]bool)
+	for _, n := range e.Entities {
+		for _, role := range n.Roles {
+			roles[role] = true
+		}
+	}
+	if len(e.Entities) != 2 || !roles["abuse"] || !roles["technical"] {
+		t.Errorf("got nested entities %v", e.Entities)
+	}
+
+	expected := map[string]Source{
+		"entities[registrar]":                     SourceRegistrar,
+		"entities[registrant]":                    SourceRegistrar,
+		"entities[registrar].entities[abuse]":     SourceRegistry,
+		"entities[registrar].entities[technical]": SourceRegistrar,
+	}
+	for k, v := range expected {
+		if m.Provenance[k] != v {
+			t.Errorf("%s: got %q", k, m.Provenance[k])
+		}
+	}
+
+	// the responses merged from aren't changed
+	if len(registrar.Entities[0].PublicIDs) != 0 || len(registrar.Entities[0].Entities) != 1 {
+		t.Errorf("registrar response was modified: %v", registrar.Entities[0])
+	}
+}
+
+func TestMergeDomains__case(t *testing.T) {
+	var registry, registrar Domain
+	if err := json.Unmarshal([]byte(`{"objectClassName":"domain","ldhName":"example.com",
+  "entities":[{"objectClassName":"entity","handle":"1","roles":["abuse"]}],
+  "events":[{"eventAction":"expiration","eventDate":"2030-01-01T00:00:00Z"}]}`), &registry); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{"objectClassName":"domain","ldhName":"example.com",
+  "entities":[{"objectClassName":"entity","roles":["Abuse"]}],
+  "events":[{"eventAction":"Expiration","eventDate":"2031-01-01T00:00:00Z"}]}`), &registrar); err != nil {
+		t.Fatal(err)
+	}
+
+	m := MergeDomains(&registry, &registrar)
+	if len(m.Domain.Entities) != 1 || m.Domain.Entities[0].Handle != "1" {
+		t.Errorf("got entities %v", m.Domain.Entities)
+	}
+	if len(m.Domain.Events) != 1 || len(m.Conflicts) != 1 || m.Conflicts[0].Field != "events[expiration]" {
+		t.Errorf("got events %v and conflicts %v", m.Domain.Events, m.Conflicts)
+	}
+}
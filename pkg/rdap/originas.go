@@ -0,0 +1,105 @@
+package rdap
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+// ARIN's arin_originas0 extension links IP networks with the autonomous
+// systems originating routes for them. IP network responses carry an
+// "arin_originas0_originautnums" member and networks can be searched by
+// origin AS with:
+//
+//	Syntax: arin_originas0_networksbyoriginas/<autonomous system number>
+//
+// https://bitbucket.org/arin-specs/arin-rdap-originas
+
+// ARINServer is ARIN's RDAP server, the only one implementing the
+// arin_originas0 network search.
+const ARINServer = "https://rdap.arin.net/registry"
+
+// OriginASNs returns the autonomous systems originating routes for the
+// network, or nil if the server didn't send the arin_originas0 extension.
+func (n *IPNetwork) OriginASNs() []uint32 {
+	origin, err := n.OriginAS0()
+	if err != nil || origin == nil {
+		return nil
+	}
+	return origin.OriginAutnums
+}
+
+// OriginASNs returns the autonomous systems originating an IP address or
+// CIDR prefix, taken from the most specific network containing it. The
+// server is found from the Client's Bootstrap registry when it has one,
+// otherwise BaseAddress is used.
+//
+// An empty result means the server either doesn't support arin_originas0
+// or has no origin AS for the network.
+func (c *Client) OriginASNs(prefix string) ([]uint32, *IPNetwork, error) {
+	p, err := parsePrefix(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	server, err := c.ipServer(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	network, err := c.ipNetwork(server, p)
+	if err != nil {
+		return nil, network, err
+	}
+	return network.OriginASNs(), network, nil
+}
+
+// NetworksByOriginAS returns the IP networks originated by an autonomous
+// system, given in asplain form with or without an "AS" prefix.
+//
+// Only ARIN implements the search, so it isn't bootstrapped: the RIR an AS
+// is registered with often doesn't support it. It's sent to ARINServer, or
+// to BaseAddress when the Client has one other than DefaultServer, and
+// only finds networks registered with ARIN.
+func (c *Client) NetworksByOriginAS(asn string) (*IPNetworkSearchResults, error) {
+	n, err := bootstrap.ParseASN(asn)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := c.getFrom(c.originASServer(), ClassIPNetwork, "/arin_originas0_networksbyoriginas/"+strconv.FormatUint(uint64(n), 10))
+	if err != nil {
+		return nil, err
+	}
+	var results IPNetworkSearchResults
+	if err := c.decode(bs, &results); err != nil {
+		return nil, fmt.Errorf("error parsing ip network search response: %v", err)
+	}
+	if err := checkClasses(&results); err != nil {
+		return &results, err
+	}
+	return &results, nil
+}
+
+// originASServer returns the server for arin_originas0 searches, or an
+// empty string to use BaseAddress.
+func (c *Client) originASServer() string {
+	if base := strings.TrimSuffix(c.BaseAddress, "/"); base == "" || base == DefaultServer {
+		return ARINServer
+	}
+	return ""
+}
+
+// CIDRs returns the CIDR blocks of every network in the results, in the
+// order the server returned them.
+func (r *IPNetworkSearchResults) CIDRs() ([]*net.IPNet, error) {
+	var out []*net.IPNet
+	for i := range r.Results {
+		cidrs, err := r.Results[i].CIDRs()
+		if err != nil {
+			return out, fmt.Errorf("ip network %s: %v", r.Results[i].key(), err)
+		}
+		out = append(out, cidrs...)
+	}
+	return out, nil
+}
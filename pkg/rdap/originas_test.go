@@ -0,0 +1,124 @@
+package rdap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+func TestClient__originASNs(t *testing.T) {
+	network, err := ioutil.ReadFile("../../testdata/arin-ip-network.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	search, err := ioutil.ReadFile("../../testdata/arin-originas0-network-search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	arin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/registry/ip/198.51.100.7":
+			w.Write(network)
+		case "/registry/arin_originas0_networksbyoriginas/64496":
+			w.Write(search)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer arin.Close()
+
+	boot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"1.0","services":[[["198.0.0.0/8"],["` + arin.URL + `/registry/"]]]}`))
+	}))
+	defer boot.Close()
+
+	client := Client{
+		Underlying: arin.Client(),
+		Bootstrap: &bootstrap.Registry{
+			IPv4Endpoint: boot.URL + "/ipv4",
+			Underlying:   boot.Client(),
+		},
+	}
+
+	asns, n, err := client.OriginASNs("198.51.100.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(asns, []uint32{64496, 64511}) || n.Handle != "NET-198-51-100-0-1" {
+		t.Errorf("got %v from %s", asns, n.Handle)
+	}
+
+	// Searches by origin AS go to ARIN, not the bootstrapped server
+	if server := client.originASServer(); server != ARINServer {
+		t.Errorf("got server %q", server)
+	}
+	client.BaseAddress = arin.URL + "/registry/"
+	results, err := client.NetworksByOriginAS("AS64496")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Results) != 3 {
+		t.Fatalf("got %d networks", len(results.Results))
+	}
+	if asns := results.Results[1].OriginASNs(); !reflect.DeepEqual(asns, []uint32{64496, 64511}) {
+		t.Errorf("got %v", asns)
+	}
+	cidrs, err := results.CIDRs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for i := range cidrs {
+		got = append(got, cidrs[i].String())
+	}
+	if strings.Join(got, " ") != "198.51.100.0/24 203.0.112.0/24 203.0.113.0/25 2001:db8::/32" {
+		t.Errorf("got %v", got)
+	}
+
+	expected := "/registry/ip/198.51.100.7 /registry/arin_originas0_networksbyoriginas/64496"
+	if got := strings.Join(paths, " "); got != expected {
+		t.Errorf("got requests %s", got)
+	}
+
+	if _, err := client.NetworksByOriginAS("AS1"); !IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err := client.NetworksByOriginAS("ASX"); err == nil {
+		t.Error("expected error for invalid AS number")
+	}
+}
+
+func TestIPNetworkSearchResults__decode(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/arin-originas0-network-search.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, err := Decode(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, ok := obj.(*IPNetworkSearchResults)
+	if !ok {
+		t.Fatalf("got %T", obj)
+	}
+	if len(results.Results) != 3 || len(results.Extensions) != 0 {
+		t.Errorf("got %d results, extensions %v", len(results.Results), results.Extensions)
+	}
+
+	// Networks without the extension have no origin ASNs
+	var n IPNetwork
+	if err := json.Unmarshal([]byte(`{"objectClassName":"ip network","startAddress":"192.0.2.0","endAddress":"192.0.2.255"}`), &n); err != nil {
+		t.Fatal(err)
+	}
+	if asns := n.OriginASNs(); asns != nil {
+		t.Errorf("got %v", asns)
+	}
+}
@@ -0,0 +1,60 @@
+package rdap
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// RFC8977 Section 2.2
+// The "paging_metadata" element contains the following fields:
+// totalCount, pageSize, pageNumber and links, where links are used to
+// request the previous, next, first or last page of results.
+
+// PagingMetadata is the RFC8977 "paging" extension, which servers add to
+// search results split across pages.
+type PagingMetadata struct {
+	TotalCount int   `json:"totalCount,omitempty"`
+	PageSize   int   `json:"pageSize,omitempty"`
+	PageNumber int   `json:"pageNumber,omitempty"`
+	Links      Links `json:"links,omitempty"`
+}
+
+func decodePaging(_ *Common, members map[string]json.RawMessage) (interface{}, error) {
+	out := &PagingMetadata{}
+	if raw, ok := members["paging_metadata"]; ok {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Paging returns the response's paging extension, or nil if it wasn't sent.
+func (c *Common) Paging() (*PagingMetadata, error) {
+	v, err := c.Extension("paging")
+	if v == nil || err != nil {
+		return nil, err
+	}
+	out, ok := v.(*PagingMetadata)
+	if !ok {
+		return nil, fmt.Errorf("paging extension decoded as %T", v)
+	}
+	return out, nil
+}
+
+// NextPage returns the URL of the next page of search results, or nil on
+// the last page. The paging extension's links are preferred, but a "next"
+// link on the response itself is also followed.
+func (c *Common) NextPage() *url.URL {
+	links := c.Links
+	if paging, err := c.Paging(); err == nil && paging != nil {
+		links = append(paging.Links, links...)
+	}
+	for _, link := range links.Rel(RelNext) {
+		if target := link.Target(); target != nil && target.IsAbs() {
+			return target
+		}
+	}
+	return nil
+}
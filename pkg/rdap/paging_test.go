@@ -0,0 +1,101 @@
+package rdap
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient__searchPaging(t *testing.T) {
+	var queries []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		base := "http://" + r.Host
+		switch r.URL.Path + "?" + r.URL.RawQuery {
+		case "/domains?name=exam%2A.com":
+			fmt.Fprintf(w, `{"rdapConformance":["rdap_level_0","paging"],
+"domainSearchResults":[{"objectClassName":"domain","ldhName":"example.com"},{"objectClassName":"domain","ldhName":"examine.com"}],
+"paging_metadata":{"totalCount":3,"pageSize":2,"pageNumber":1,"links":[{"value":"%[1]s/domains?name=exam*.com","rel":"next","href":"%[1]s/domains?name=exam*.com&cursor=abc","type":"application/rdap+json"}]}}`, base)
+		case "/domains?name=exam*.com&cursor=abc":
+			w.Write([]byte(`{"rdapConformance":["rdap_level_0","paging"],
+"domainSearchResults":[{"objectClassName":"domain","ldhName":"examples.com"}],
+"paging_metadata":{"totalCount":3,"pageSize":2,"pageNumber":2}}`))
+		case "/nameservers?ip=192.0.2.1":
+			fmt.Fprintf(w, `{"nameserverSearchResults":[{"objectClassName":"nameserver","ldhName":"ns1.example.com"}],
+"links":[{"rel":"next","href":"%s/nameservers?ip=192.0.2.1&page=2"}]}`, base)
+		case "/nameservers?ip=192.0.2.1&page=2":
+			w.Write([]byte(`{"nameserverSearchResults":[]}`))
+		case "/entities?fn=Joe%2A":
+			w.Write([]byte(`{"entitySearchResults":[{"objectClassName":"entity","handle":"XXXX"},{"objectClassName":"domain","ldhName":"example.com"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer svc.Close()
+
+	client := Client{
+		Underlying:  svc.Client(),
+		BaseAddress: svc.URL,
+	}
+
+	var names []string
+	page, err := client.DomainSearch("name", "exam*.com")
+	for ; page != nil && err == nil; page, err = client.DomainSearchNext(page) {
+		for i := range page.Results {
+			names = append(names, page.Results[i].LDHName)
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 3 || names[0] != "example.com" || names[2] != "examples.com" {
+		t.Errorf("got %v", names)
+	}
+
+	first, err := client.DomainSearch("name", "exam*.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paging, err := first.Paging()
+	if err != nil || paging == nil {
+		t.Fatalf("got %v: %v", paging, err)
+	}
+	if paging.TotalCount != 3 || paging.PageSize != 2 || paging.PageNumber != 1 || len(paging.Links) != 1 {
+		t.Errorf("got %#v", paging)
+	}
+
+	// A next link on the response itself is followed without the paging
+	// extension.
+	ns, err := client.NameserverSearch("ip", "192.0.2.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ns.Results) != 1 || ns.Results[0].LDHName != "ns1.example.com" {
+		t.Errorf("got %v", ns.Results)
+	}
+	next, err := client.NameserverSearchNext(ns)
+	if err != nil || next == nil || len(next.Results) != 0 {
+		t.Fatalf("got %v: %v", next, err)
+	}
+	if last, err := client.NameserverSearchNext(next); last != nil || err != nil {
+		t.Errorf("expected no more pages, got %v: %v", last, err)
+	}
+
+	if _, err := client.EntitySearch("fn", "Joe*"); err == nil {
+		t.Error("expected objectClassName error")
+	}
+
+	before := len(queries)
+	for _, by := range []string{"", "ldhName", "nsIP"} {
+		if _, err := client.DomainSearch(by, "example.com"); err == nil {
+			t.Errorf("%q: expected error", by)
+		}
+	}
+	if _, err := client.EntitySearch("handle", ""); err == nil {
+		t.Error("expected error for empty pattern")
+	}
+	if len(queries) != before {
+		t.Errorf("invalid searches were sent: %v", queries[before:])
+	}
+}
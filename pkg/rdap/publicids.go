@@ -0,0 +1,197 @@
+package rdap
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RFC7483 Section 4.8
+// This data structure maps a public identifier to an object class. It
+// is named "publicIds" and is an array of objects with the following
+// members:
+//   o  type -- a string denoting the type of public identifier
+//   o  identifier -- a string denoting a public identifier of the type
+//      denoted by 'type'
+
+type PublicID struct {
+	Type       string `json:"type"`
+	Identifier string `json:"identifier"`
+}
+
+// PublicIDTypeIANARegistrar is the type of public identifier gTLD registries
+// and registrars give registrar entities, holding the registrar's IANA ID.
+const PublicIDTypeIANARegistrar = "IANA Registrar ID"
+
+type PublicIDs []PublicID
+
+// Get returns the first identifier of the given type, compared without
+// case, or an empty string.
+func (p PublicIDs) Get(typ string) string {
+	for i := range p {
+		if strings.EqualFold(strings.TrimSpace(p[i].Type), typ) {
+			return p[i].Identifier
+		}
+	}
+	return ""
+}
+
+// IANARegistrarID returns the entity's IANA Registrar ID, or 0 if it doesn't
+// have a valid one.
+func (e *Entity) IANARegistrarID() int {
+	id, err := strconv.Atoi(strings.TrimSpace(e.PublicIDs.Get(PublicIDTypeIANARegistrar)))
+	if err != nil || id <= 0 {
+		return 0
+	}
+	return id
+}
+
+// RegistrarInfo is the sponsoring registrar of a domain.
+type RegistrarInfo struct {
+	// IANAID is the registrar's IANA Registrar ID, or 0 if the registry
+	// didn't include one.
+	IANAID int
+
+	Name string
+
+	AbuseEmail string
+	AbusePhone string
+
+	// Entity is the registrar entity the above came from.
+	Entity *Entity
+
+	// Listed is the registrar's entry in the IANA registrar list, set by
+	// Verify.
+	Listed *IANARegistrar
+}
+
+// RegistrarInfo returns the domain's registrar, including its abuse
+// contact, or nil if the domain has no registrar entity.
+func (d *Domain) RegistrarInfo() (*RegistrarInfo, error) {
+	e := d.Registrar()
+	if e == nil {
+		return nil, nil
+	}
+	info := &RegistrarInfo{
+		IANAID: e.IANARegistrarID(),
+		Entity: e,
+	}
+
+	contact, err := e.Contact()
+	if err != nil {
+		return info, fmt.Errorf("registrar %s: %v", e.Handle, err)
+	}
+	if contact != nil {
+		info.Name = contact.FullName
+		if info.Name == "" && len(contact.Organizations) > 0 {
+			info.Name = contact.Organizations[0].Name
+		}
+	}
+
+	// Abuse contacts are nested in the registrar entity under the ICANN
+	// gTLD RDAP profile.
+	if found := e.FindEntities(RoleAbuse); len(found) > 0 {
+		abuse, err := found[0].Contact()
+		if err != nil {
+			return info, fmt.Errorf("registrar %s abuse contact: %v", e.Handle, err)
+		}
+		if abuse != nil {
+			if len(abuse.Emails) > 0 {
+				info.AbuseEmail = abuse.Emails[0].Address
+			}
+			if len(abuse.Phones) > 0 {
+				info.AbusePhone = abuse.Phones[0].Number
+			}
+		}
+	}
+	return info, nil
+}
+
+// Verify checks the registrar against IANA's registrar list, setting
+// Listed. An error is returned if the registrar has no IANA ID or it isn't
+// on the list.
+func (r *RegistrarInfo) Verify(registrars IANARegistrars) error {
+	if r.IANAID == 0 {
+		return errors.New("registrar has no IANA Registrar ID")
+	}
+	listed, ok := registrars[r.IANAID]
+	if !ok {
+		return fmt.Errorf("IANA Registrar ID %d (%s) isn't on the IANA registrar list", r.IANAID, r.Name)
+	}
+	r.Listed = &listed
+	return nil
+}
+
+// IANARegistrar is an entry of IANA's registrar list.
+// https://www.iana.org/assignments/registrar-ids/registrar-ids.xhtml
+type IANARegistrar struct {
+	ID     int
+	Name   string
+	Status string
+
+	// RDAPBaseURL is the registrar's RDAP server, if it has one.
+	RDAPBaseURL string
+}
+
+// Accredited returns true if the registrar's status is "Accredited".
+func (r IANARegistrar) Accredited() bool {
+	return strings.EqualFold(r.Status, "Accredited")
+}
+
+// IANARegistrars is IANA's registrar list keyed by IANA Registrar ID.
+type IANARegistrars map[int]IANARegistrar
+
+// ParseIANARegistrars reads a local copy of the IANA registrar list in
+// the CSV format IANA publishes, with the columns "ID", "Registrar Name",
+// "Status" and "RDAP Base URL". Columns are found by name, so their order
+// doesn't matter.
+func ParseIANARegistrars(r io.Reader) (IANARegistrars, error) {
+	rdr := csv.NewReader(r)
+	rdr.FieldsPerRecord = -1
+
+	header, err := rdr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading IANA registrar list header: %v", err)
+	}
+	cols := make(map[string]int)
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	idCol, ok := cols["id"]
+	if !ok {
+		return nil, errors.New("IANA registrar list has no ID column")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := cols[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	out := make(IANARegistrars)
+	for line := 2; ; line++ {
+		record, err := rdr.Read()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if idCol >= len(record) {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(record[idCol]))
+		if err != nil {
+			return nil, fmt.Errorf("IANA registrar list line %d: invalid ID %q", line, record[idCol])
+		}
+		out[id] = IANARegistrar{
+			ID:          id,
+			Name:        field(record, "registrar name"),
+			Status:      field(record, "status"),
+			RDAPBaseURL: field(record, "rdap base url"),
+		}
+	}
+}
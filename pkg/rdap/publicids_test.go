@@ -0,0 +1,84 @@
+package rdap
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPublicIDs__registrar(t *testing.T) {
+	for _, path := range []string{"../../testdata/verisign-google-domain.json", "../../testdata/markmonitor-google-domain.json"} {
+		domain := readDomain(t, path)
+		info, err := domain.RegistrarInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info == nil || info.IANAID != 292 || !strings.HasPrefix(info.Name, "MarkMonitor") {
+			t.Errorf("%s: got %#v", path, info)
+		}
+	}
+
+	domain := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+	info, err := domain.RegistrarInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.AbuseEmail != "abusecomplaints@markmonitor.com" || info.AbusePhone == "" {
+		t.Errorf("got %#v", info)
+	}
+}
+
+func TestPublicIDs__verify(t *testing.T) {
+	fd, err := os.Open("../../testdata/iana-registrar-ids.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	registrars, err := ParseIANARegistrars(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registrars) != 4 || registrars[9].Name != "Register.com, Inc." || registrars[1].Accredited() {
+		t.Errorf("got %#v", registrars)
+	}
+
+	domain := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+	info, err := domain.RegistrarInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := info.Verify(registrars); err != nil {
+		t.Fatal(err)
+	}
+	if info.Listed == nil || !info.Listed.Accredited() || info.Listed.RDAPBaseURL == "" {
+		t.Errorf("got %#v", info.Listed)
+	}
+
+	info.IANAID = 12345
+	if err := info.Verify(registrars); err == nil {
+		t.Error("expected error")
+	}
+	info.IANAID = 0
+	if err := info.Verify(registrars); err == nil {
+		t.Error("expected error")
+	}
+
+	if _, err := ParseIANARegistrars(strings.NewReader("Name\nfoo\n")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPublicIDs__get(t *testing.T) {
+	domain := readDomain(t, "../../testdata/rfc-7483-section-5-3-example.json")
+	if domain.Registrar() != nil {
+		info, _ := domain.RegistrarInfo()
+		t.Errorf("got %#v", info)
+	}
+	e := Entity{PublicIDs: PublicIDs{{Type: "iana registrar id", Identifier: " 1 "}}}
+	if e.IANARegistrarID() != 1 {
+		t.Errorf("got %d", e.IANARegistrarID())
+	}
+	if (&Entity{PublicIDs: PublicIDs{{Type: PublicIDTypeIANARegistrar, Identifier: "n/a"}}}).IANARegistrarID() != 0 {
+		t.Error("expected 0")
+	}
+}
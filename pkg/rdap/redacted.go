@@ -0,0 +1,388 @@
+package rdap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RFC9537 Section 4.2
+// The "redacted" member contains an array of objects, one per redacted
+// field. Each identifies the field by name, the JSONPath expression of
+// the field before and/or after redaction, the method used and why.
+
+// RedactionMethod is how a field was redacted, RFC9537 Section 3.
+type RedactionMethod string
+
+const (
+	// RedactionRemoval removed the field from the response. It's the
+	// default when a server doesn't send a method.
+	RedactionRemoval RedactionMethod = "removal"
+
+	// RedactionEmptyValue kept the field with an empty value.
+	RedactionEmptyValue RedactionMethod = "emptyValue"
+
+	// RedactionPartialValue kept part of the field's value.
+	RedactionPartialValue RedactionMethod = "partialValue"
+
+	// RedactionReplacementValue replaced the field's value, e.g. with an
+	// anonymized email address or a web form.
+	RedactionReplacementValue RedactionMethod = "replacementValue"
+)
+
+// Redacted is one entry of the "redacted" member.
+type Redacted struct {
+	Name   RedactedDescription  `json:"name"`
+	Reason *RedactedDescription `json:"reason,omitempty"`
+
+	PrePath         string `json:"prePath,omitempty"`
+	PostPath        string `json:"postPath,omitempty"`
+	ReplacementPath string `json:"replacementPath,omitempty"`
+
+	// PathLang is the language of the path expressions, "jsonpath"
+	// when empty.
+	PathLang string `json:"pathLang,omitempty"`
+
+	Method RedactionMethod `json:"method,omitempty"`
+}
+
+// RedactedDescription names a redacted field or reason with either a
+// registered type or a free form description.
+type RedactedDescription struct {
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (d *RedactedDescription) String() string {
+	if d == nil {
+		return ""
+	}
+	if d.Type != "" {
+		return d.Type
+	}
+	return d.Description
+}
+
+func init() {
+	RegisterExtension(Extension{
+		Identifier: "redacted",
+		Decode: func(_ *Common, members map[string]json.RawMessage) (interface{}, error) {
+			var out []Redacted
+			if raw, ok := members["redacted"]; ok {
+				if err := json.Unmarshal(raw, &out); err != nil {
+					return nil, err
+				}
+			}
+			for i := range out {
+				if out[i].Method == "" {
+					out[i].Method = RedactionRemoval
+				}
+			}
+			return out, nil
+		},
+	})
+}
+
+// Redactions returns the entries of the object's "redacted" member.
+func (c *Common) Redactions() ([]Redacted, error) {
+	v, err := c.Extension("redacted")
+	if v == nil || err != nil {
+		return nil, err
+	}
+	out, ok := v.([]Redacted)
+	if !ok {
+		return nil, fmt.Errorf("redacted extension decoded as %T", v)
+	}
+	return out, nil
+}
+
+// RedactedField is a field of a response which the server redacted.
+type RedactedField struct {
+	Redacted
+
+	// Entity is the entity holding the field, or nil if the field belongs
+	// to the object itself (e.g. a domain's handle).
+	Entity *Entity
+
+	// Member is the JSON member (e.g. "handle") or jCard property (e.g.
+	// "email") which was redacted. It's empty if the path couldn't be
+	// resolved against the response.
+	Member string
+
+	// Values are what remains at the field's path in the response. They're
+	// empty for removed fields and hold the empty, partial or replacement
+	// value otherwise.
+	Values []interface{}
+}
+
+func (f RedactedField) String() string {
+	who := "object"
+	if f.Entity != nil {
+		var roles []string
+		for _, r := range f.Entity.Roles {
+			roles = append(roles, string(r))
+		}
+		who = strings.Join(roles, ",")
+		if who == "" {
+			who = f.Entity.Handle
+		}
+	}
+	return fmt.Sprintf("%s %s (%s) %s", who, f.Member, f.Name.String(), f.Method)
+}
+
+// RedactedFields evaluates the path expressions of each "redacted" entry
+// against obj and reports the entity and field which was redacted along
+// with how.
+//
+// obj must be a top level object as the paths start at the response root.
+func RedactedFields(obj Object) ([]RedactedField, error) {
+	c := obj.Base()
+	redactions, err := c.Redactions()
+	if err != nil || len(redactions) == 0 {
+		return nil, err
+	}
+
+	raw := c.Raw
+	if len(raw) == 0 {
+		if raw, err = json.Marshal(obj); err != nil {
+			return nil, err
+		}
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	var out []RedactedField
+	for _, r := range redactions {
+		if r.PathLang != "" && !strings.EqualFold(r.PathLang, "jsonpath") {
+			return out, fmt.Errorf("unsupported pathLang %q for %s", r.PathLang, r.Name.String())
+		}
+		fields, err := redactedFields(c, doc, r)
+		if err != nil {
+			return out, fmt.Errorf("redacted %s: %v", r.Name.String(), err)
+		}
+		out = append(out, fields...)
+	}
+	return out, nil
+}
+
+// Redaction returns the redacted field of an entity with the given role
+// (e.g. "registrant") and member or jCard property (e.g. "email"), or nil
+// if the field wasn't redacted. An empty role matches fields of the
+// object itself.
+//
+// This separates data a server redacted from data that's simply absent.
+func Redaction(obj Object, role Role, member string) (*RedactedField, error) {
+	fields, err := RedactedFields(obj)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		f := fields[i]
+		if !strings.EqualFold(f.Member, member) {
+			continue
+		}
+		if role == "" && f.Entity == nil {
+			return &f, nil
+		}
+		if f.Entity != nil && f.Entity.HasRole(role) {
+			return &f, nil
+		}
+	}
+	return nil, nil
+}
+
+func redactedFields(c *Common, doc interface{}, r Redacted) ([]RedactedField, error) {
+	// The path to the field as it is in the response, or as it was before
+	// removal or replacement.
+	expr := r.PostPath
+	if r.Method == RedactionRemoval || expr == "" {
+		expr = r.PrePath
+	}
+	if r.Method == RedactionReplacementValue && expr == "" {
+		expr = r.ReplacementPath
+	}
+	if expr == "" {
+		// Nothing to evaluate, report the name alone.
+		return []RedactedField{{Redacted: r}}, nil
+	}
+	fields, err := locateFields(c, doc, r, expr)
+	if err != nil || r.Method != RedactionReplacementValue || r.ReplacementPath == "" || r.ReplacementPath == expr {
+		return fields, err
+	}
+
+	// Replacements are found at their own path, e.g. a contact-uri
+	// property in place of an email property.
+	path, err := parseJSONPath(r.ReplacementPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range path.eval(doc) {
+		entity, _ := entityAt(c, m.location)
+		for i := range fields {
+			if fields[i].Entity == entity {
+				fields[i].Values = append(fields[i].Values, m.value)
+			}
+		}
+	}
+	return fields, nil
+}
+
+func locateFields(c *Common, doc interface{}, r Redacted, expr string) ([]RedactedField, error) {
+	path, err := parseJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := path.eval(doc)
+	if len(matches) > 0 && r.Method != RedactionRemoval {
+		var out []RedactedField
+		for _, m := range matches {
+			entity, rest := entityAt(c, m.location)
+			out = append(out, RedactedField{
+				Redacted: r,
+				Entity:   entity,
+				Member:   memberName(doc, m.location, rest),
+				Values:   []interface{}{m.value},
+			})
+		}
+		return out, nil
+	}
+
+	// The field is gone (or never matched), so find where it was by
+	// evaluating the longest prefix of the path which still matches.
+	for n := len(path) - 1; n >= 0; n-- {
+		parents := path[:n].eval(doc)
+		if len(parents) == 0 {
+			continue
+		}
+		var out []RedactedField
+		for _, m := range parents {
+			entity, rest := entityAt(c, m.location)
+			out = append(out, RedactedField{
+				Redacted: r,
+				Entity:   entity,
+				Member:   removedMemberName(doc, m.location, rest, path[n:]),
+			})
+		}
+		return out, nil
+	}
+	return []RedactedField{{Redacted: r}}, nil
+}
+
+// entityAt follows a match location through nested entities and returns
+// the innermost entity along with the index of the rest of the location.
+func entityAt(c *Common, location []interface{}) (*Entity, int) {
+	var entity *Entity
+	entities := c.Entities
+	i := 0
+	for i+1 < len(location) {
+		name, ok := location[i].(string)
+		idx, isIndex := location[i+1].(int)
+		if !ok || name != "entities" || !isIndex || idx >= len(entities) {
+			break
+		}
+		entity = &entities[idx]
+		entities = entity.Entities
+		i += 2
+	}
+	return entity, i
+}
+
+// memberName describes the field at location[rest:] within its entity or
+// object: the jCard property name for vcardArray entries, otherwise the
+// first member name.
+func memberName(doc interface{}, location []interface{}, rest int) string {
+	tail := location[rest:]
+	if idx, ok := vcardProperty(tail); ok {
+		// vcardArray[1][n] is a property, its name is at [0]
+		prop := (jsonPath{
+			{kind: segName, name: "vcardArray"},
+			{kind: segIndex, index: 1},
+			{kind: segIndex, index: idx},
+			{kind: segIndex, index: 0},
+		}).eval(valueAt(doc, location[:rest]))
+		if len(prop) == 1 {
+			if name, ok := prop[0].value.(string); ok {
+				return name
+			}
+		}
+	}
+	for i := range tail {
+		if name, ok := tail[i].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// removedMemberName describes a field which no longer exists from the
+// location of its closest remaining parent and the unmatched segments of
+// the path.
+func removedMemberName(doc interface{}, location []interface{}, rest int, missing jsonPath) string {
+	if name := memberName(doc, location, rest); name != "" && name != "vcardArray" {
+		return name
+	}
+	for _, seg := range missing {
+		switch seg.kind {
+		case segName:
+			return seg.name
+		case segFilter:
+			// e.g. [?(@[0]=='email')] on the vcard properties
+			cmp, ok := seg.filter.(compareExpr)
+			if !ok || cmp.op != "==" {
+				continue
+			}
+			q, ok := cmp.l.(queryExpr)
+			if !ok || !q.relative || len(q.path) != 1 || q.path[0].kind != segIndex || q.path[0].index != 0 {
+				continue
+			}
+			if lit, ok := cmp.r.(literalExpr); ok {
+				if s, ok := lit.v.(string); ok {
+					return s
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// vcardProperty returns the index of the jCard property a location (within
+// an entity) points into, e.g. vcardArray[1][4][3] is property 4.
+func vcardProperty(tail []interface{}) (int, bool) {
+	if len(tail) < 3 || tail[0] != "vcardArray" || tail[1] != 1 {
+		return 0, false
+	}
+	idx, ok := tail[2].(int)
+	return idx, ok
+}
+
+func valueAt(doc interface{}, location []interface{}) interface{} {
+	v := doc
+	for _, key := range location {
+		switch k := key.(type) {
+		case string:
+			obj, _ := v.(map[string]interface{})
+			v = obj[k]
+		case int:
+			arr, _ := v.([]interface{})
+			if k < len(arr) {
+				v = arr[k]
+			} else {
+				v = nil
+			}
+		}
+	}
+	return v
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
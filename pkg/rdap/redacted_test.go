@@ -0,0 +1,149 @@
+package rdap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestRedacted__fields(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/rfc-9537-redacted.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var domain Domain
+	if err := json.Unmarshal(bs, &domain); err != nil {
+		t.Fatal(err)
+	}
+
+	redactions, err := domain.Redactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(redactions) != 9 {
+		t.Fatalf("got %d redactions", len(redactions))
+	}
+	if r := redactions[0]; r.Method != RedactionRemoval || r.Name.String() != "Registry Domain ID" || r.Reason.String() != "Server policy" {
+		t.Errorf("got %#v", r)
+	}
+
+	cases := []struct {
+		role   Role
+		member string
+		method RedactionMethod
+		values int
+	}{
+		{"", "handle", RedactionRemoval, 0},
+		{"registrant", "handle", RedactionEmptyValue, 1},
+		{"registrant", "fn", RedactionEmptyValue, 1},
+		{"registrant", "org", RedactionRemoval, 0},
+		{"registrant", "adr", RedactionEmptyValue, 1},
+		{"registrant", "tel", RedactionRemoval, 0},
+		{"registrant", "email", RedactionReplacementValue, 1},
+		{"technical", "fn", RedactionEmptyValue, 1},
+	}
+	// A decode and encode round trip keeps empty values, which are checked
+	// both from the re-decoded response and by encoding it again.
+	out, err := json.Marshal(domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again Domain
+	if err := json.Unmarshal(out, &again); err != nil {
+		t.Fatal(err)
+	}
+	inCode := again
+	inCode.Raw = nil
+
+	for name, d := range map[string]*Domain{"decoded": &domain, "round trip": &again, "encoded": &inCode} {
+		for _, tc := range cases {
+			f, err := Redaction(d, tc.role, tc.member)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f == nil {
+				t.Errorf("%s: %s %s: not redacted", name, tc.role, tc.member)
+				continue
+			}
+			if f.Method != tc.method || len(f.Values) != tc.values {
+				t.Errorf("%s: %s %s: got %s with %v", name, tc.role, tc.member, f.Method, f.Values)
+			}
+		}
+	}
+
+	// present and absent fields which weren't redacted
+	for _, member := range []string{"email", "org"} {
+		f, err := Redaction(&domain, "registrar", member)
+		if err != nil || f != nil {
+			t.Errorf("registrar %s: got %v (err=%v)", member, f, err)
+		}
+	}
+}
+
+func TestRedacted__none(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/verisign-google-domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var domain Domain
+	if err := json.Unmarshal(bs, &domain); err != nil {
+		t.Fatal(err)
+	}
+	fields, err := RedactedFields(&domain)
+	if err != nil || len(fields) != 0 {
+		t.Errorf("got %v (err=%v)", fields, err)
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	var doc interface{}
+	err := json.Unmarshal([]byte(`{
+  "entities": [
+    {"roles": ["registrar"], "handle": "1"},
+    {"roles": ["registrant"], "handle": "2", "vcardArray": ["vcard", [["fn", {}, "text", "Jane"], ["email", {}, "text", "j@example.com"]]]}
+  ]
+}`), &doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		expr     string
+		expected []interface{}
+	}{
+		{"$.entities[0].handle", []interface{}{"1"}},
+		{"$['entities'][-1].handle", []interface{}{"2"}},
+		{"$.entities[*].handle", []interface{}{"1", "2"}},
+		{"$.entities[?(@.roles[0]=='registrant')].handle", []interface{}{"2"}},
+		{"$.entities[?@.roles[0] != 'registrant'].handle", []interface{}{"1"}},
+		{"$.entities[?(@.vcardArray)].handle", []interface{}{"2"}},
+		{"$.entities[?(@.handle == '1' || @.handle == '2')].handle", []interface{}{"1", "2"}},
+		{"$.entities[1].vcardArray[1][?(@[0]=='email')][3]", []interface{}{"j@example.com"}},
+		{"$.entities[5].handle", nil},
+		{"$.entities[:1].handle", []interface{}{"1"}},
+		{"$.entities[-1:].handle", []interface{}{"2"}},
+	}
+	for _, tc := range cases {
+		path, err := parseJSONPath(tc.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tc.expr, err)
+			continue
+		}
+		matches := path.eval(doc)
+		if len(matches) != len(tc.expected) {
+			t.Errorf("%s: got %d matches", tc.expr, len(matches))
+			continue
+		}
+		for i := range matches {
+			if matches[i].value != tc.expected[i] {
+				t.Errorf("%s: got %v", tc.expr, matches[i].value)
+			}
+		}
+	}
+
+	for _, expr := range []string{"entities", "$..handle", "$.entities[", "$.entities[?(@.handle=='1']"} {
+		if _, err := parseJSONPath(expr); err == nil {
+			t.Errorf("%s: expected error", expr)
+		}
+	}
+}
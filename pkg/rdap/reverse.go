@@ -0,0 +1,276 @@
+package rdap
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// RFC7482 3.1.3
+// Queries for domain information are of the form /domain/XXXX/...,
+// where XXXX is a fully qualified (relative to the root) domain name
+// (as specified in [RFC0952] and [RFC1123]) in either the in-addr.arpa
+// or ip6.arpa zones (for RIRs) ...
+
+const (
+	inAddrArpa = "in-addr.arpa"
+	ip6Arpa    = "ip6.arpa"
+)
+
+// The shortest prefixes ReverseDomains looks up. Shorter prefixes cover
+// many zones, each of which takes several queries, and no RIR is
+// delegated anything larger.
+const (
+	minReversePrefixV4 = 8
+	minReversePrefixV6 = 16
+)
+
+// parsePrefix reads an IP address or CIDR prefix. Addresses are returned
+// as a prefix of their full length and IPv4 prefixes are 4 bytes long.
+func parsePrefix(s string) (*net.IPNet, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		ip, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip or cidr specified: %q", s)
+		}
+		if ip.To4() != nil {
+			ones, _ := n.Mask.Size()
+			n = &net.IPNet{IP: n.IP.To4(), Mask: net.CIDRMask(ones, 32)}
+		}
+		return n, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid ip or cidr specified: %q", s)
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// reverseZone is a reverse DNS zone and the prefix it covers.
+type reverseZone struct {
+	name   string
+	prefix *net.IPNet
+}
+
+// reverseZones splits n into the reverse DNS zones covering it.
+//
+// Reverse zones are delegated on octet boundaries for IPv4 (RFC1035
+// Section 3.5) and nibble boundaries for IPv6 (RFC3596 Section 2.5), so
+// other prefix lengths are rounded up to the next boundary and each zone
+// within the prefix is returned, e.g. 192.0.2.0/23 is 2.0.192.in-addr.arpa
+// and 3.0.192.in-addr.arpa. Prefixes longer than /24 for IPv4 or /64 for
+// IPv6, including single addresses, are looked up by the zone containing
+// them as registries don't delegate anything smaller.
+func reverseZones(n *net.IPNet) []reverseZone {
+	ones, bits := n.Mask.Size()
+	unit, longest := 4, 64
+	if bits == 32 {
+		unit, longest = 8, 24
+	}
+	if ones > longest {
+		ones = longest
+	}
+	boundary := (ones + unit - 1) / unit * unit
+	if boundary == 0 {
+		boundary = unit
+	}
+	base := n.IP.Mask(net.CIDRMask(ones, bits))
+
+	// The bits between the prefix length and the boundary all fall in the
+	// last octet or nibble of the zone.
+	count := 1 << uint(boundary-ones)
+	out := make([]reverseZone, 0, count)
+	for i := 0; i < count; i++ {
+		ip := make(net.IP, len(base))
+		copy(ip, base)
+		last := boundary - unit
+		if unit == 8 {
+			ip[last/8] += byte(i)
+		} else {
+			ip[last/8] += byte(i << uint(4-last%8))
+		}
+		out = append(out, reverseZone{
+			name:   reverseName(ip, boundary),
+			prefix: &net.IPNet{IP: ip, Mask: net.CIDRMask(boundary, bits)},
+		})
+	}
+	return out
+}
+
+// reverseName returns the in-addr.arpa or ip6.arpa name of the first ones
+// bits of ip, which must be a multiple of 8 or 4 respectively.
+func reverseName(ip net.IP, ones int) string {
+	var labels []string
+	if len(ip) == net.IPv4len {
+		for i := ones/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(ip[i])))
+		}
+		return strings.Join(append(labels, inAddrArpa), ".")
+	}
+	for i := ones/4 - 1; i >= 0; i-- {
+		nibble := ip[i/2] >> 4
+		if i%2 == 1 {
+			nibble = ip[i/2] & 0x0f
+		}
+		labels = append(labels, strconv.FormatUint(uint64(nibble), 16))
+	}
+	return strings.Join(append(labels, ip6Arpa), ".")
+}
+
+// parentZone returns the reverse zone directly above name, or an empty
+// string when name is already the top of in-addr.arpa or ip6.arpa.
+func parentZone(name string) string {
+	i := strings.IndexByte(name, '.')
+	if i < 0 {
+		return ""
+	}
+	parent := name[i+1:]
+	if parent == inAddrArpa || parent == ip6Arpa {
+		return ""
+	}
+	return parent
+}
+
+// ReverseZones returns the names of the reverse DNS zones covering an IP
+// address or CIDR prefix, e.g. "2.0.192.in-addr.arpa" for 192.0.2.0/24.
+// See reverseZones for how prefixes off an octet or nibble boundary are
+// handled.
+func ReverseZones(prefix string) ([]string, error) {
+	n, err := parsePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	zones := reverseZones(n)
+	out := make([]string, len(zones))
+	for i := range zones {
+		out[i] = zones[i].name
+	}
+	return out, nil
+}
+
+// ReverseDomain is the delegation of a reverse DNS zone.
+type ReverseDomain struct {
+	// Zone is the reverse zone queried for, derived from Prefix.
+	Zone string
+
+	// Prefix is the part of the queried address space Zone covers.
+	Prefix *net.IPNet
+
+	// Domain is the registry's reverse domain, with its nameservers. It may
+	// be a parent of Zone when the registry has no delegation for Zone
+	// itself.
+	Domain *Domain
+
+	// Network is the IP network the reverse domain is for, or nil if the
+	// registry didn't have one.
+	Network *IPNetwork
+}
+
+// Nameservers returns the names of the nameservers the reverse zone is
+// delegated to.
+func (r *ReverseDomain) Nameservers() []string {
+	if r == nil || r.Domain == nil {
+		return nil
+	}
+	var out []string
+	for i := range r.Domain.Nameservers {
+		if name := strings.TrimSuffix(r.Domain.Nameservers[i].LDHName, "."); name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// ReverseDomains looks up the reverse DNS delegations of an IP address or
+// CIDR prefix, one for each of its ReverseZones.
+//
+// When the Client has a Bootstrap registry the RIR's server is found from
+// it, otherwise BaseAddress is queried. Zones the server doesn't have are
+// retried with their parent zone until one is found, as RIRs often only
+// have the delegation for a larger block. The IP network is taken from
+// the domain's network member, or looked up on the same server if it's
+// missing. Prefixes shorter than /8 for IPv4 or /16 for IPv6 are rejected.
+func (c *Client) ReverseDomains(prefix string) ([]*ReverseDomain, error) {
+	n, err := parsePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	ones, bits := n.Mask.Size()
+	shortest := minReversePrefixV6
+	if bits == 32 {
+		shortest = minReversePrefixV4
+	}
+	if ones < shortest {
+		return nil, fmt.Errorf("prefix %s is too large, reverse domains can be looked up for a /%d or longer", n, shortest)
+	}
+	server, err := c.ipServer(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*ReverseDomain
+	seen := make(map[string]bool)
+	for _, zone := range reverseZones(n) {
+		domain, err := c.reverseDomain(server, zone.name)
+		if err != nil {
+			return out, err
+		}
+		if seen[domain.LDHName] {
+			continue
+		}
+		seen[domain.LDHName] = true
+
+		rev := &ReverseDomain{
+			Zone:    zone.name,
+			Prefix:  zone.prefix,
+			Domain:  domain,
+			Network: domain.Network,
+		}
+		if rev.Network == nil {
+			rev.Network, err = c.ipNetwork(server, zone.prefix)
+			if IsNotFound(err) {
+				err = nil
+			}
+			if err != nil {
+				return out, err
+			}
+		}
+		out = append(out, rev)
+	}
+	return out, nil
+}
+
+// reverseDomain queries for zone, walking up to its parents while the
+// server doesn't have it.
+func (c *Client) reverseDomain(server, zone string) (*Domain, error) {
+	for name := zone; name != ""; name = parentZone(name) {
+		bs, err := c.getFrom(server, ClassDomain, "/domain/"+name)
+		if IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reverse domain %s: %v", name, err)
+		}
+		var domain Domain
+		if err := c.decode(bs, &domain); err != nil {
+			return nil, fmt.Errorf("error parsing domain response: %v", err)
+		}
+		if domain.ObjectClassName != ClassDomain {
+			return &domain, fmt.Errorf("unknown objectClassName: %q", domain.ObjectClassName)
+		}
+		domain.Source = SourceRegistry
+		domain.LDHName, domain.UnicodeName = names(domain.LDHName, domain.UnicodeName, name)
+		return &domain, nil
+	}
+	return nil, &Error{
+		Code:        http.StatusNotFound,
+		Title:       http.StatusText(http.StatusNotFound),
+		Description: []string{fmt.Sprintf("no reverse domain found for %s", zone)},
+	}
+}
@@ -0,0 +1,144 @@
+package rdap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adamdecaf/rdap/pkg/rdap/bootstrap"
+)
+
+func TestReverseZones(t *testing.T) {
+	cases := map[string][]string{
+		"192.0.2.1":      {"2.0.192.in-addr.arpa"},
+		"192.0.2.0/24":   {"2.0.192.in-addr.arpa"},
+		"192.0.2.128/25": {"2.0.192.in-addr.arpa"},
+		"192.0.0.0/16":   {"0.192.in-addr.arpa"},
+		"192.0.2.0/23":   {"2.0.192.in-addr.arpa", "3.0.192.in-addr.arpa"},
+		"10.0.0.0/7":     {"10.in-addr.arpa", "11.in-addr.arpa"},
+		"2001:db8::/32":  {"8.b.d.0.1.0.0.2.ip6.arpa"},
+		"2001:db8::/31":  {"8.b.d.0.1.0.0.2.ip6.arpa", "9.b.d.0.1.0.0.2.ip6.arpa"},
+		"2001:db8::/30":  {"8.b.d.0.1.0.0.2.ip6.arpa", "9.b.d.0.1.0.0.2.ip6.arpa", "a.b.d.0.1.0.0.2.ip6.arpa", "b.b.d.0.1.0.0.2.ip6.arpa"},
+		"2001:db8::/46": {
+			"0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "1.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+			"2.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "3.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+		"2001:db8:1:2::1": {"2.0.0.0.1.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+	}
+	for prefix, expected := range cases {
+		zones, err := ReverseZones(prefix)
+		if err != nil {
+			t.Errorf("%s: %v", prefix, err)
+			continue
+		}
+		if !reflect.DeepEqual(zones, expected) {
+			t.Errorf("%s: got %v, expected %v", prefix, zones, expected)
+		}
+	}
+
+	for _, prefix := range []string{"", "192.0.2", "192.0.2.0/33", "example.com"} {
+		if _, err := ReverseZones(prefix); err == nil {
+			t.Errorf("%q: expected error", prefix)
+		}
+	}
+}
+
+func TestClient__reverseDomains(t *testing.T) {
+	var rir *httptest.Server
+	var paths []string
+	rir = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/rdap/domain/2.0.192.in-addr.arpa":
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"2.0.192.in-addr.arpa",
+  "nameservers":[{"objectClassName":"nameserver","ldhName":"ns1.example.net."},{"objectClassName":"nameserver","ldhName":"ns2.example.net"}],
+  "network":{"objectClassName":"ip network","handle":"NET-192-0-2-0-1","startAddress":"192.0.2.0","endAddress":"192.0.2.255","ipVersion":"v4"}}`))
+		case "/rdap/domain/0.192.in-addr.arpa":
+			w.Write([]byte(`{"objectClassName":"domain","ldhName":"0.192.in-addr.arpa"}`))
+		case "/rdap/ip/192.0.3.0/24":
+			w.Write([]byte(`{"objectClassName":"ip network","handle":"NET-192-0-0-0-1","startAddress":"192.0.0.0","endAddress":"192.0.255.255","ipVersion":"v4"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorCode":404,"title":"Not Found"}`))
+		}
+	}))
+	defer rir.Close()
+
+	boot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"1.0","services":[[["192.0.0.0/8"],["` + rir.URL + `/rdap/"]]]}`))
+	}))
+	defer boot.Close()
+
+	client := Client{
+		Underlying:  rir.Client(),
+		BaseAddress: "http://bad.invalid",
+		Bootstrap:   &bootstrap.Registry{IPv4Endpoint: boot.URL, Underlying: boot.Client()},
+	}
+	reverse, err := client.ReverseDomains("192.0.2.0/23")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverse) != 2 {
+		t.Fatalf("got %d reverse domains", len(reverse))
+	}
+
+	if reverse[0].Zone != "2.0.192.in-addr.arpa" || reverse[0].Prefix.String() != "192.0.2.0/24" {
+		t.Errorf("got %s %s", reverse[0].Zone, reverse[0].Prefix)
+	}
+	if ns := reverse[0].Nameservers(); !reflect.DeepEqual(ns, []string{"ns1.example.net", "ns2.example.net"}) {
+		t.Errorf("got nameservers %v", ns)
+	}
+	if reverse[0].Network == nil || reverse[0].Network.Handle != "NET-192-0-2-0-1" {
+		t.Errorf("got network %v", reverse[0].Network)
+	}
+
+	// 3.0.192.in-addr.arpa isn't delegated, so the parent is returned
+	if reverse[1].Zone != "3.0.192.in-addr.arpa" || reverse[1].Domain.LDHName != "0.192.in-addr.arpa" {
+		t.Errorf("got %s %s", reverse[1].Zone, reverse[1].Domain.LDHName)
+	}
+	if reverse[1].Network == nil || reverse[1].Network.Handle != "NET-192-0-0-0-1" {
+		t.Errorf("got network %v", reverse[1].Network)
+	}
+
+	expected := "/rdap/domain/2.0.192.in-addr.arpa /rdap/domain/3.0.192.in-addr.arpa /rdap/domain/0.192.in-addr.arpa /rdap/ip/192.0.3.0/24"
+	if got := strings.Join(paths, " "); got != expected {
+		t.Errorf("got requests %s", got)
+	}
+
+	// Nothing found at all
+	paths = nil
+	if _, err := client.ReverseDomains("192.1.0.0/24"); !IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if len(paths) != 3 {
+		t.Errorf("got requests %v", paths)
+	}
+}
+
+func TestClient__reverseDomainsTooLarge(t *testing.T) {
+	var paths []string
+	svc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer svc.Close()
+
+	client := Client{Underlying: svc.Client(), BaseAddress: svc.URL}
+	for _, prefix := range []string{"0.0.0.0/0", "10.0.0.0/7", "2001::/15", "::/0"} {
+		if _, err := client.ReverseDomains(prefix); err == nil || !strings.Contains(err.Error(), "too large") {
+			t.Errorf("%s: got %v", prefix, err)
+		}
+	}
+	if len(paths) != 0 {
+		t.Errorf("got requests %v", paths)
+	}
+
+	// The shortest allowed prefixes are looked up
+	for _, prefix := range []string{"10.0.0.0/8", "2001::/16"} {
+		if _, err := client.ReverseDomains(prefix); !IsNotFound(err) {
+			t.Errorf("%s: expected not found, got %v", prefix, err)
+		}
+	}
+}
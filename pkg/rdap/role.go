@@ -0,0 +1,192 @@
+package rdap
+
+import (
+	"errors"
+	"strings"
+)
+
+// RFC7483 Section 10.2.4
+// This section describes entity role values for the RDAP JSON Values
+// registry.
+
+// Role is the relationship an entity has with the object containing it.
+// Values which aren't registered are kept as sent.
+type Role string
+
+const (
+	RoleRegistrant     Role = "registrant"
+	RoleTechnical      Role = "technical"
+	RoleAdministrative Role = "administrative"
+	RoleAbuse          Role = "abuse"
+	RoleBilling        Role = "billing"
+	RoleRegistrar      Role = "registrar"
+	RoleReseller       Role = "reseller"
+	RoleSponsor        Role = "sponsor"
+	RoleProxy          Role = "proxy"
+	RoleNotifications  Role = "notifications"
+	RoleNOC            Role = "noc"
+)
+
+// Is compares roles ignoring case and surrounding whitespace.
+func (r Role) Is(other Role) bool {
+	return strings.EqualFold(strings.TrimSpace(string(r)), strings.TrimSpace(string(other)))
+}
+
+// HasRole returns true if the entity has the role.
+func (e *Entity) HasRole(role Role) bool {
+	for i := range e.Roles {
+		if e.Roles[i].Is(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipEntities can be returned from a WalkEntities func to skip the
+// entities nested in the current one.
+var SkipEntities = errors.New("skip nested entities")
+
+// WalkEntities calls fn for every entity of the object, depth first and
+// including nested entities. path holds the entity's parents followed by
+// the entity itself.
+//
+// Walking stops at the first error from fn, which is returned, except for
+// SkipEntities.
+func (c *Common) WalkEntities(fn func(path []*Entity) error) error {
+	return walkEntities(c.Entities, nil, fn)
+}
+
+func walkEntities(entities []Entity, parents []*Entity, fn func(path []*Entity) error) error {
+	for i := range entities {
+		path := append(parents[:len(parents):len(parents)], &entities[i])
+		err := fn(path)
+		if err == SkipEntities {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := walkEntities(entities[i].Entities, path, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AllEntities returns every entity of the object, nearest first: those of
+// the object itself, then those nested in them, and so on.
+func (c *Common) AllEntities() []*Entity {
+	var out []*Entity
+	level := make([]*Entity, 0, len(c.Entities))
+	for i := range c.Entities {
+		level = append(level, &c.Entities[i])
+	}
+	for len(level) > 0 {
+		out = append(out, level...)
+		var next []*Entity
+		for _, e := range level {
+			for i := range e.Entities {
+				next = append(next, &e.Entities[i])
+			}
+		}
+		level = next
+	}
+	return out
+}
+
+// EntitiesWithRole returns every entity with the role at any depth,
+// nearest first.
+func (c *Common) EntitiesWithRole(role Role) []*Entity {
+	var out []*Entity
+	for _, e := range c.AllEntities() {
+		if e.HasRole(role) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// FindEntities follows a path of roles through nested entities, e.g.
+// FindEntities(RoleRegistrar, RoleAbuse) returns the abuse contacts of the
+// registrar.
+func (c *Common) FindEntities(roles ...Role) []*Entity {
+	if len(roles) == 0 {
+		return nil
+	}
+	var out []*Entity
+	for i := range c.Entities {
+		out = append(out, findEntities(&c.Entities[i], roles)...)
+	}
+	return out
+}
+
+func findEntities(e *Entity, roles []Role) []*Entity {
+	if !e.HasRole(roles[0]) {
+		return nil
+	}
+	if len(roles) == 1 {
+		return []*Entity{e}
+	}
+	var out []*Entity
+	for i := range e.Entities {
+		out = append(out, findEntities(&e.Entities[i], roles[1:])...)
+	}
+	return out
+}
+
+func (c *Common) nearest(role Role) *Entity {
+	if found := c.EntitiesWithRole(role); len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+// Registrant returns the nearest registrant entity, or nil.
+func (c *Common) Registrant() *Entity {
+	return c.nearest(RoleRegistrant)
+}
+
+// Registrar returns the nearest registrar entity, or nil.
+func (c *Common) Registrar() *Entity {
+	return c.nearest(RoleRegistrar)
+}
+
+// AbuseContact returns the entity to report abuse to, or nil. That's the
+// object's own abuse contact when it has one (as RIRs return), otherwise
+// the registrar's (as the ICANN gTLD RDAP profile requires) or the
+// nearest nested abuse contact.
+func (c *Common) AbuseContact() *Entity {
+	if found := c.FindEntities(RoleAbuse); len(found) > 0 {
+		return found[0]
+	}
+	if found := c.FindEntities(RoleRegistrar, RoleAbuse); len(found) > 0 {
+		return found[0]
+	}
+	return c.nearest(RoleAbuse)
+}
+
+// AbuseEmail returns the first email address of AbuseContact, or an empty
+// string if there isn't one.
+func (c *Common) AbuseEmail() string {
+	e := c.AbuseContact()
+	if e == nil {
+		return ""
+	}
+	contact, err := e.Contact()
+	if err != nil || contact == nil || len(contact.Emails) == 0 {
+		return ""
+	}
+	return contact.Emails[0].Address
+}
+
+// TechnicalContacts returns every technical entity at any depth, nearest
+// first.
+func (c *Common) TechnicalContacts() []*Entity {
+	return c.EntitiesWithRole(RoleTechnical)
+}
+
+// AdministrativeContacts returns every administrative entity at any depth,
+// nearest first.
+func (c *Common) AdministrativeContacts() []*Entity {
+	return c.EntitiesWithRole(RoleAdministrative)
+}
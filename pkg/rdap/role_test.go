@@ -0,0 +1,97 @@
+package rdap
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+)
+
+func TestRole__domain(t *testing.T) {
+	domain := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+
+	if e := domain.Registrar(); e == nil || e.Handle != "292" {
+		t.Errorf("registrar: %#v", e)
+	}
+	if e := domain.Registrant(); e == nil || !e.HasRole("Registrant") {
+		t.Errorf("registrant: %#v", e)
+	}
+	if found := domain.FindEntities(RoleRegistrar, RoleAbuse); len(found) != 1 {
+		t.Errorf("registrar->abuse: %d", len(found))
+	}
+	if found := domain.FindEntities(RoleAbuse); len(found) != 0 {
+		t.Errorf("abuse isn't at the top level: %d", len(found))
+	}
+	if email := domain.AbuseEmail(); email != "abusecomplaints@markmonitor.com" {
+		t.Errorf("abuse email: %q", email)
+	}
+	if found := domain.TechnicalContacts(); len(found) != 1 {
+		t.Errorf("technical: %d", len(found))
+	}
+	if len(domain.AllEntities()) != 4 {
+		t.Errorf("got %d entities", len(domain.AllEntities()))
+	}
+}
+
+func TestRole__ipNetwork(t *testing.T) {
+	bs, err := ioutil.ReadFile("../../testdata/arin-ip-network.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var network IPNetwork
+	if err := json.Unmarshal(bs, &network); err != nil {
+		t.Fatal(err)
+	}
+
+	if e := network.AbuseContact(); e == nil || e.Handle != "ABUSE-ARIN" {
+		t.Errorf("abuse: %#v", e)
+	}
+	if email := network.AbuseEmail(); email != "abuse@example.net" {
+		t.Errorf("abuse email: %q", email)
+	}
+	if e := network.Registrar(); e != nil {
+		t.Errorf("registrar: %#v", e)
+	}
+}
+
+func TestRole__walk(t *testing.T) {
+	domain := readDomain(t, "../../testdata/markmonitor-google-domain.json")
+
+	var paths []string
+	err := domain.WalkEntities(func(path []*Entity) error {
+		var s string
+		for _, e := range path {
+			s += "/" + string(e.Roles[0])
+		}
+		paths = append(paths, s)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"/registrar", "/registrar/abuse", "/registrant", "/technical"}
+	if len(paths) != len(expected) {
+		t.Fatalf("got %v", paths)
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Errorf("got %v", paths)
+		}
+	}
+
+	// skipping nested entities
+	count := 0
+	domain.WalkEntities(func(path []*Entity) error {
+		count++
+		return SkipEntities
+	})
+	if count != 3 {
+		t.Errorf("got %d", count)
+	}
+
+	// stopping early
+	stop := errors.New("stop")
+	if err := domain.WalkEntities(func([]*Entity) error { return stop }); err != stop {
+		t.Errorf("got %v", err)
+	}
+}
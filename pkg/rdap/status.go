@@ -0,0 +1,251 @@
+package rdap
+
+import (
+	"sort"
+	"strings"
+)
+
+// RFC7483 Section 4.6
+// This data structure, named "status", is an array of strings indicating
+// the state of a registered object (see Section 10.2.2 for a list of
+// values).
+//
+// RFC8056 registers the values needed to map every EPP status code to an
+// RDAP status.
+
+// Status is a value from the RDAP JSON Values registry of type "status".
+// Values which aren't registered are kept as sent.
+type Status string
+
+// RFC7483 Section 10.2.2
+const (
+	StatusValidated          Status = "validated"
+	StatusRenewProhibited    Status = "renew prohibited"
+	StatusUpdateProhibited   Status = "update prohibited"
+	StatusTransferProhibited Status = "transfer prohibited"
+	StatusDeleteProhibited   Status = "delete prohibited"
+	StatusProxy              Status = "proxy"
+	StatusPrivate            Status = "private"
+	StatusRemoved            Status = "removed"
+	StatusObscured           Status = "obscured"
+	StatusAssociated         Status = "associated"
+	StatusActive             Status = "active"
+	StatusInactive           Status = "inactive"
+	StatusLocked             Status = "locked"
+	StatusPendingCreate      Status = "pending create"
+	StatusPendingRenew       Status = "pending renew"
+	StatusPendingTransfer    Status = "pending transfer"
+	StatusPendingUpdate      Status = "pending update"
+	StatusPendingDelete      Status = "pending delete"
+)
+
+// RFC8056 Section 3
+const (
+	StatusAddPeriod                Status = "add period"
+	StatusAutoRenewPeriod          Status = "auto renew period"
+	StatusClientDeleteProhibited   Status = "client delete prohibited"
+	StatusClientHold               Status = "client hold"
+	StatusClientRenewProhibited    Status = "client renew prohibited"
+	StatusClientTransferProhibited Status = "client transfer prohibited"
+	StatusClientUpdateProhibited   Status = "client update prohibited"
+	StatusPendingRestore           Status = "pending restore"
+	StatusRedemptionPeriod         Status = "redemption period"
+	StatusRenewPeriod              Status = "renew period"
+	StatusServerDeleteProhibited   Status = "server delete prohibited"
+	StatusServerRenewProhibited    Status = "server renew prohibited"
+	StatusServerTransferProhibited Status = "server transfer prohibited"
+	StatusServerUpdateProhibited   Status = "server update prohibited"
+	StatusServerHold               Status = "server hold"
+	StatusTransferPeriod           Status = "transfer period"
+)
+
+// Registered later in the RDAP JSON Values registry
+const (
+	StatusAdministrative Status = "administrative"
+	StatusReserved       Status = "reserved"
+)
+
+// RFC8056 Section 2
+// EPP status codes and the RDAP status each maps to. EPP's "ok" is
+// "active" and "linked" is "associated", the rest only differ in case and
+// spacing.
+var eppStatuses = map[string]Status{
+	"addPeriod":                StatusAddPeriod,
+	"autoRenewPeriod":          StatusAutoRenewPeriod,
+	"clientDeleteProhibited":   StatusClientDeleteProhibited,
+	"clientHold":               StatusClientHold,
+	"clientRenewProhibited":    StatusClientRenewProhibited,
+	"clientTransferProhibited": StatusClientTransferProhibited,
+	"clientUpdateProhibited":   StatusClientUpdateProhibited,
+	"inactive":                 StatusInactive,
+	"linked":                   StatusAssociated,
+	"ok":                       StatusActive,
+	"pendingCreate":            StatusPendingCreate,
+	"pendingDelete":            StatusPendingDelete,
+	"pendingRenew":             StatusPendingRenew,
+	"pendingRestore":           StatusPendingRestore,
+	"pendingTransfer":          StatusPendingTransfer,
+	"pendingUpdate":            StatusPendingUpdate,
+	"redemptionPeriod":         StatusRedemptionPeriod,
+	"renewPeriod":              StatusRenewPeriod,
+	"serverDeleteProhibited":   StatusServerDeleteProhibited,
+	"serverHold":               StatusServerHold,
+	"serverRenewProhibited":    StatusServerRenewProhibited,
+	"serverTransferProhibited": StatusServerTransferProhibited,
+	"serverUpdateProhibited":   StatusServerUpdateProhibited,
+	"transferPeriod":           StatusTransferPeriod,
+}
+
+var (
+	rdapToEPP = make(map[Status]string, len(eppStatuses))
+
+	registeredStatuses = map[Status]bool{
+		StatusValidated: true, StatusRenewProhibited: true, StatusUpdateProhibited: true,
+		StatusTransferProhibited: true, StatusDeleteProhibited: true, StatusProxy: true,
+		StatusPrivate: true, StatusRemoved: true, StatusObscured: true,
+		StatusLocked: true, StatusAdministrative: true, StatusReserved: true,
+	}
+)
+
+func init() {
+	for code, s := range eppStatuses {
+		rdapToEPP[s] = code
+		registeredStatuses[s] = true
+	}
+}
+
+// StatusFromEPP returns the RDAP status for an EPP status code (e.g.
+// "clientTransferProhibited"), and false if the code isn't one from
+// RFC5731, RFC5732, RFC5733 or RFC3915.
+func StatusFromEPP(code string) (Status, bool) {
+	if s, ok := eppStatuses[code]; ok {
+		return s, true
+	}
+	for c, s := range eppStatuses {
+		if strings.EqualFold(c, code) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// EPP returns the EPP status code for s, and false if s has no EPP
+// equivalent (e.g. "locked" or "private").
+func (s Status) EPP() (string, bool) {
+	code, ok := rdapToEPP[s.normalize()]
+	return code, ok
+}
+
+// Registered returns true if s is listed in the RDAP JSON Values registry.
+func (s Status) Registered() bool {
+	return registeredStatuses[s.normalize()]
+}
+
+// Is compares statuses ignoring case and surrounding whitespace, as some
+// servers capitalize them.
+func (s Status) Is(other Status) bool {
+	return s.normalize() == other.normalize()
+}
+
+func (s Status) normalize() Status {
+	return Status(strings.ToLower(strings.TrimSpace(string(s))))
+}
+
+// Statuses is the "status" member of an object.
+type Statuses []Status
+
+// Has returns true if any of the statuses are present.
+func (s Statuses) Has(statuses ...Status) bool {
+	for i := range s {
+		for j := range statuses {
+			if s[i].Is(statuses[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsLocked returns true if the object is "locked", or prohibits updates,
+// transfers and deletes (by either the registrar or the registry) as a
+// registrar or registry lock does.
+func (s Statuses) IsLocked() bool {
+	if s.Has(StatusLocked) {
+		return true
+	}
+	return s.IsUpdateProhibited() && s.IsTransferProhibited() && s.IsDeleteProhibited()
+}
+
+// IsRegistryLocked returns true if the registry prohibits updates,
+// transfers and deletes, which registrars can't lift on their own.
+func (s Statuses) IsRegistryLocked() bool {
+	return s.Has(StatusServerUpdateProhibited) && s.Has(StatusServerTransferProhibited) && s.Has(StatusServerDeleteProhibited)
+}
+
+func (s Statuses) IsUpdateProhibited() bool {
+	return s.Has(StatusUpdateProhibited, StatusClientUpdateProhibited, StatusServerUpdateProhibited)
+}
+
+func (s Statuses) IsTransferProhibited() bool {
+	return s.Has(StatusTransferProhibited, StatusClientTransferProhibited, StatusServerTransferProhibited)
+}
+
+func (s Statuses) IsDeleteProhibited() bool {
+	return s.Has(StatusDeleteProhibited, StatusClientDeleteProhibited, StatusServerDeleteProhibited)
+}
+
+func (s Statuses) IsRenewProhibited() bool {
+	return s.Has(StatusRenewProhibited, StatusClientRenewProhibited, StatusServerRenewProhibited)
+}
+
+// IsOnHold returns true if the domain isn't published in DNS.
+func (s Statuses) IsOnHold() bool {
+	return s.Has(StatusClientHold, StatusServerHold)
+}
+
+func (s Statuses) IsPendingDelete() bool {
+	return s.Has(StatusPendingDelete)
+}
+
+// IsRedemption returns true during the redemption grace period, when a
+// deleted domain can still be restored.
+func (s Statuses) IsRedemption() bool {
+	return s.Has(StatusRedemptionPeriod, StatusPendingRestore)
+}
+
+// EPP returns the EPP status codes for s, skipping statuses without one.
+func (s Statuses) EPP() []string {
+	var out []string
+	for i := range s {
+		if code, ok := s[i].EPP(); ok {
+			out = append(out, code)
+		}
+	}
+	return out
+}
+
+// Diff returns the statuses in s which aren't in previous (added) and
+// those in previous which aren't in s (removed).
+func (s Statuses) Diff(previous Statuses) (added, removed Statuses) {
+	for i := range s {
+		if !previous.Has(s[i]) {
+			added = append(added, s[i])
+		}
+	}
+	for i := range previous {
+		if !s.Has(previous[i]) {
+			removed = append(removed, previous[i])
+		}
+	}
+	return added, removed
+}
+
+// String returns the statuses sorted and comma separated.
+func (s Statuses) String() string {
+	out := make([]string, len(s))
+	for i := range s {
+		out[i] = string(s[i].normalize())
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,90 @@
+package rdap
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatus__fixture(t *testing.T) {
+	domain := readDomain(t, "../../testdata/verisign-google-domain.json")
+	if !domain.Status.IsLocked() || !domain.Status.IsRegistryLocked() || !domain.Status.IsTransferProhibited() {
+		t.Errorf("expected locked: %v", domain.Status)
+	}
+	if domain.Status.IsPendingDelete() || domain.Status.IsOnHold() {
+		t.Errorf("unexpected status: %v", domain.Status)
+	}
+	for _, s := range domain.Status {
+		if !s.Registered() {
+			t.Errorf("%q isn't registered", s)
+		}
+	}
+}
+
+func TestStatus__epp(t *testing.T) {
+	cases := map[string]Status{
+		"ok":                       StatusActive,
+		"linked":                   StatusAssociated,
+		"clientTransferProhibited": StatusClientTransferProhibited,
+		"pendingDelete":            StatusPendingDelete,
+		"redemptionPeriod":         StatusRedemptionPeriod,
+	}
+	for code, expected := range cases {
+		s, ok := StatusFromEPP(code)
+		if !ok || s != expected {
+			t.Errorf("%s: got %q", code, s)
+		}
+		if back, ok := s.EPP(); !ok || back != code {
+			t.Errorf("%s: got %q back", s, back)
+		}
+	}
+	if s, ok := StatusFromEPP("SERVERHOLD"); !ok || s != StatusServerHold {
+		t.Errorf("got %q", s)
+	}
+	if _, ok := StatusFromEPP("wat"); ok {
+		t.Error("expected no mapping")
+	}
+	if _, ok := StatusLocked.EPP(); ok {
+		t.Error("locked has no EPP status")
+	}
+	if code, ok := Status("Client Hold").EPP(); !ok || code != "clientHold" {
+		t.Errorf("got %q", code)
+	}
+}
+
+func TestStatus__predicates(t *testing.T) {
+	s := Statuses{StatusClientTransferProhibited, StatusClientUpdateProhibited, StatusClientDeleteProhibited}
+	if !s.IsLocked() || s.IsRegistryLocked() {
+		t.Errorf("registrar lock: %v", s)
+	}
+	if !(Statuses{StatusLocked}).IsLocked() || (Statuses{StatusClientTransferProhibited}).IsLocked() {
+		t.Error("locked")
+	}
+	if !(Statuses{"Pending Delete", StatusRedemptionPeriod}).IsPendingDelete() {
+		t.Error("pending delete")
+	}
+
+	added, removed := Statuses{StatusActive, StatusClientHold}.Diff(Statuses{StatusActive, StatusClientTransferProhibited})
+	if len(added) != 1 || added[0] != StatusClientHold || len(removed) != 1 || removed[0] != StatusClientTransferProhibited {
+		t.Errorf("added=%v removed=%v", added, removed)
+	}
+}
+
+func TestStatus__unknown(t *testing.T) {
+	var c Common
+	if err := json.Unmarshal([]byte(`{"status": ["active", "Example Custom"]}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Status[1].Registered() {
+		t.Error("custom status isn't registered")
+	}
+	bs, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != `{"status":["active","Example Custom"]}` {
+		t.Errorf("got %s", bs)
+	}
+	if v := c.Status.EPP(); len(v) != 1 || v[0] != "ok" {
+		t.Errorf("got %v", v)
+	}
+}
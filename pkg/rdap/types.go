@@ -2,68 +2,123 @@ package rdap
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 )
 
-// RFC7483 Section 4.9
-// An objectClassName is REQUIRED in all RDAP response objects so that
-// the type of the object can be interpreted.
-
 // RFC7483 Section 5.1
 // See rfc-7483-section-5-1-example.json
 type Entity struct {
-	Handle string
-	Title  string
-	// Role // RFC7483 Section 10.2.4
-	// Addresses []...
-	// Telephone []...
-	Emails []string
-	// Coordinates []..
-	// Status
-
-	// "ldhName" : "ns1.example.com" // TODO(adam): Required?
+	ObjectClassName string `json:"objectClassName,omitempty"`
+
+	Handle string `json:"handle,omitempty"`
+
+	// publicIds -- see RFC7483 Section 4.8
+	PublicIDs PublicIDs `json:"publicIds,omitempty"`
+
+	// vcardArray -- a jCard with the entity's contact information
+	VcardArray []interface{} `json:"vcardArray,omitempty"`
+
+	// roles -- an array of strings, each signifying the relationship an
+	// object would have with its closest containing object.
+	// See RFC7483 Section 10.2.4
+	Roles []Role `json:"roles,omitempty"`
+
+	// asEventActor -- an array of events in which the entity was the
+	// actor (and as such has no eventActor member).
+	AsEventActor []EventJSON `json:"asEventActor,omitempty"`
+
+	// networks and autnums are only found on entities from RIRs
+	Networks []IPNetwork `json:"networks,omitempty"`
+	Autnums  []Autnum    `json:"autnums,omitempty"`
+
+	Common
+
+	// Title and Emails are filled from the entity's contact information
+	// when it's decoded.
+	//
+	// Deprecated: Use Contact.
+	Title  string   `json:"-"`
+	Emails []string `json:"-"`
+}
+
+func (e Entity) String() string {
+	return fmt.Sprintf("Entity: %s", e.Handle)
+}
+
+// RFC7483 Section 5.2
+// See rfc-7483-section-5-2-example.json
+type Nameserver struct {
+	ObjectClassName string `json:"objectClassName,omitempty"`
+
+	Handle string `json:"handle,omitempty"`
+
+	// "ldhName" : "ns1.example.com"
 	// a string containing the LDH name of the nameserver (see Section 3)
 	//
 	// LDH names:        Textual representations of DNS names where the
 	// labels of the domain are all "letters, digits,
 	// hyphen" labels as described by [RFC5890].  Trailing
 	// periods are optional.
+	LDHName     string `json:"ldhName,omitempty"`
+	UnicodeName string `json:"unicodeName,omitempty"`
 
-	// "objectClassName" : "entity"
+	IPAddresses *IPAddresses `json:"ipAddresses,omitempty"`
+
+	Common
 }
 
-// RFC7483 Section 5.2
-// See rfc-7483-section-5-2-example.json
-type Nameserver struct {
-	// objectClassName -- the string "nameserver"
+func (n Nameserver) String() string {
+	return fmt.Sprintf("Nameserver: %s", n.LDHName)
+}
 
-	Handle string
-	// Status
-	// IPV4Addresses []net.IP
-	// IPV6Addresses []...
+// IPAddresses are the glue records of a Nameserver.
+type IPAddresses struct {
+	V4 []string `json:"v4,omitempty"`
+	V6 []string `json:"v6,omitempty"`
 }
 
 // RFC7483 Section 5.3
 // See rfc-7483-section-5-3-example.json
 type Domain struct {
-	ObjectClassName string `json:"objectClassName"`
+	ObjectClassName string `json:"objectClassName,omitempty"`
 
-	Handle string `json:"handle,omitempty"`
-	LDHName string `json:"ldhName,omitempty"`
+	Handle      string `json:"handle,omitempty"`
+	LDHName     string `json:"ldhName,omitempty"`
+	UnicodeName string `json:"unicodeName,omitempty"`
+
+	Nameservers []Nameserver `json:"nameservers,omitempty"`
+
+	// publicIds -- see RFC7483 Section 4.8
+	PublicIDs PublicIDs `json:"publicIds,omitempty"`
+
+	// network -- represents the IP network for which a reverse DNS
+	// domain is referenced.  See Section 5.4
+	Network *IPNetwork `json:"network,omitempty"`
+
+	Common
+
+	// Source records which kind of server returned this domain.
+	Source Source `json:"-"`
 
 	// TODO(adam)
 
 	// o  secureDNS -- an object with the following members:
 	//   *  zoneSigned -- true if the zone has been signed, false
 	//      otherwise.
+}
 
-	// entities -- an array of entity objects as defined by Section 5.1
+// Source is where an RDAP object was retrieved from.
+type Source string
 
-	// status -- see Section 4.6
+const (
+	// SourceRegistry is the authoritative registry, as found by bootstrapping.
+	SourceRegistry Source = "registry"
 
-	// network -- represents the IP network for which a reverse DNS
-	// domain is referenced.  See Section 5.4
-}
+	// SourceRegistrar is the sponsoring registrar, as found by following a
+	// registry's rel="related" links.
+	SourceRegistrar Source = "registrar"
+)
 
 func (d Domain) String() string {
 	return fmt.Sprintf("Domain: %s", d.LDHName)
@@ -72,58 +127,77 @@ func (d Domain) String() string {
 // RFC7483 Section 5.4
 // See rfc-7483-section-5-4-example.json
 type IPNetwork struct {
-	// "objectClassName" : "ip network",
-	// "handle" : "XXXX-RIR",
-	// "startAddress" : "2001:db8::",
-	// "endAddress" : "2001:db8:0:ffff:ffff:ffff:ffff:ffff",
+	ObjectClassName string `json:"objectClassName,omitempty"`
+
+	Handle       string `json:"handle,omitempty"`
+	StartAddress string `json:"startAddress,omitempty"`
+	EndAddress   string `json:"endAddress,omitempty"`
 
-	// "ipVersion" : "v6",
 	// ipVersion -- a string signifying the IP protocol version of the
 	// network: "v4" signifies an IPv4 network, and "v6" signifies an
 	// IPv6 network
+	IPVersion string `json:"ipVersion,omitempty"`
 
-	// "name": "NET-RTR-1",
+	Name string `json:"name,omitempty"`
 
-	// "type" : "DIRECT ALLOCATION",
 	// type -- a string containing an RIR-specific classification of the
 	// network
+	Type string `json:"type,omitempty"`
+
+	Country      string `json:"country,omitempty"`
+	ParentHandle string `json:"parentHandle,omitempty"`
 
-	// "country" : "AU",
-	// status
+	Common
+}
+
+func (n IPNetwork) String() string {
+	return fmt.Sprintf("IP Network: %s - %s", n.StartAddress, n.EndAddress)
 }
 
 // RFC7483 Section 5.5
 // See rfc-7483-section-5-5-example.json
 type Autnum struct {
-	// objectClassName -- the string "autnum"
+	ObjectClassName string `json:"objectClassName,omitempty"`
 
 	// handle -- a string representing an RIR-unique identifier of the
 	// autnum registration
+	Handle string `json:"handle,omitempty"`
 
-	// o  startAutnum -- a number representing the starting number [RFC5396]
+	// startAutnum and endAutnum are the first and last numbers [RFC5396]
 	// in the block of Autonomous System numbers
+	StartAutnum uint32 `json:"startAutnum,omitempty"`
+	EndAutnum   uint32 `json:"endAutnum,omitempty"`
 
-	// o  endAutnum -- a number representing the ending number [RFC5396] in
-	// the block of Autonomous System numbers
-
-	// o  name -- an identifier assigned to the autnum registration by the
+	// name -- an identifier assigned to the autnum registration by the
 	// registration holder
+	Name string `json:"name,omitempty"`
 
-	// o  type -- a string containing an RIR-specific classification of the
+	// type -- a string containing an RIR-specific classification of the
 	// autnum
+	Type string `json:"type,omitempty"`
 
-	// o  status -- an array of strings indicating the state of the autnum
-
-	// o  country -- a string containing the name of the two-character
+	// country -- a string containing the name of the two-character
 	// country code of the autnum
+	Country string `json:"country,omitempty"`
+
+	Common
+}
+
+func (a Autnum) String() string {
+	if a.StartAutnum == a.EndAutnum {
+		return fmt.Sprintf("Autnum: AS%d", a.StartAutnum)
+	}
+	return fmt.Sprintf("Autnum: AS%d - AS%d", a.StartAutnum, a.EndAutnum)
 }
 
 // RFC7483 Section 6
 // See rfc-7483-section-6-example.json
 type Error struct {
 	Code        int      `json:"errorCode"`
-	Title       string   `json:"title"`
-	Description []string `json:"description"`
+	Title       string   `json:"title,omitempty"`
+	Description []string `json:"description,omitempty"`
+
+	Common
 }
 
 func (e *Error) Error() string {
@@ -135,3 +209,10 @@ func (e *Error) Error() string {
 	}
 	return fmt.Sprintf("%s (Code: %d)", e.Title, e.Code)
 }
+
+// IsNotFound returns true if err is an Error from a server which doesn't
+// have the object requested.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e != nil && e.Code == http.StatusNotFound
+}
@@ -50,6 +50,57 @@ func TestError__verisignlabs(t *testing.T) {
 		}
 		t.Errorf("len(e.Description)=%d", len(e.Description))
 	}
+	if !e.Conforms("rdap_openidc_level_0") || e.Lang != "en-US" {
+		t.Errorf("conformance=%v lang=%q", e.RDAPConformance, e.Lang)
+	}
+	if tos := e.TermsOfService(); tos == nil || len(tos.Links) != 1 {
+		t.Errorf("got %v", e.Notices)
+	}
+}
+
+func TestCommon__fixtures(t *testing.T) {
+	cases := []struct {
+		path   string
+		obj    Object
+		port43 string
+	}{
+		{"rfc-7483-section-4-10-example.json", &IPNetwork{}, ""},
+		{"rfc-7483-section-5-1-example.json", &Entity{}, ""},
+		{"rfc-7483-section-5-2-example.json", &Nameserver{}, "whois.example.net"},
+		{"rfc-7483-section-5-3-example.json", &Domain{}, ""},
+		{"rfc-7483-section-5-4-example.json", &IPNetwork{}, ""},
+		{"rfc-7483-section-5-5-example.json", &Autnum{}, ""},
+		{"rfc-7483-section-6-example.json", &Error{}, ""},
+		{"verisign-google-domain.json", &Domain{}, "whois.verisign-grs.com"},
+	}
+	for i := range cases {
+		bs, err := ioutil.ReadFile("../../testdata/" + cases[i].path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(bs, cases[i].obj); err != nil {
+			t.Fatalf("%s: %v", cases[i].path, err)
+		}
+		if v := cases[i].obj.Base().Port43; v != cases[i].port43 {
+			t.Errorf("%s: port43=%q", cases[i].path, v)
+		}
+	}
+
+	ip := cases[0].obj.(*IPNetwork)
+	if !ip.Conforms("rdap_level_0") || ip.Lang != "en" || ip.ParentHandle != "YYYY-RIR" {
+		t.Errorf("got %#v", ip)
+	}
+	if n := ip.Notice("content removed"); n == nil || n.Links[0].Rel != RelAlternate {
+		t.Errorf("got %v", ip.Notices)
+	}
+	if len(ip.Remarks) != 1 || len(ip.Remarks[0].Description) != 2 {
+		t.Errorf("got %v", ip.Remarks)
+	}
+
+	autnum := cases[5].obj.(*Autnum)
+	if autnum.StartAutnum != 10 || autnum.EndAutnum != 15 || len(autnum.Entities) != 1 {
+		t.Errorf("got %#v", autnum)
+	}
 }
 
 func TestDomain__verisign_google(t *testing.T) {
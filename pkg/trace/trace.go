@@ -0,0 +1,276 @@
+// Package trace reports timing information for each phase of an RDAP lookup,
+// similar to net/http/httptrace, so slow lookups can be broken down into
+// bootstrap, connection, redirect and response handling time.
+package trace
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Phase names one step of a lookup.
+type Phase string
+
+const (
+	// BootstrapCache is a bootstrap file served from the Registry's cache.
+	BootstrapCache Phase = "bootstrap cache"
+	// BootstrapFetch covers fetching and parsing a bootstrap file.
+	BootstrapFetch Phase = "bootstrap fetch"
+
+	DNS     Phase = "dns"
+	Connect Phase = "connect"
+	TLS     Phase = "tls"
+
+	// Request covers an HTTP request until its response headers arrive,
+	// including any redirects followed.
+	Request Phase = "request"
+	// Wait is the time between writing a request and the first response
+	// byte arriving.
+	Wait Phase = "wait"
+	// Redirect is one redirect hop, from the previous request to the
+	// redirect being followed.
+	Redirect Phase = "redirect"
+
+	BodyRead Phase = "body read"
+	Decode   Phase = "json decode"
+)
+
+// Span is a timed phase of a lookup.
+type Span struct {
+	Phase Phase
+
+	// Detail describes what the phase acted on, such as a URL or host.
+	Detail string
+
+	Start    time.Time
+	Duration time.Duration
+
+	// Err is set if the phase failed.
+	Err error
+}
+
+func (s Span) String() string {
+	out := fmt.Sprintf("%s %s (%v)", s.Phase, s.Detail, s.Duration)
+	if s.Err != nil {
+		out += fmt.Sprintf(": %v", s.Err)
+	}
+	return out
+}
+
+// Tracer is called with each Span as the phase completes. Implementations
+// must be safe for concurrent use.
+type Tracer interface {
+	Span(Span)
+}
+
+// TracerFunc is an adapter to allow the use of ordinary functions as a Tracer.
+type TracerFunc func(Span)
+
+func (f TracerFunc) Span(s Span) {
+	f(s)
+}
+
+// Emit sends a Span for phase which started at start and ends now.
+// A nil Tracer is ignored.
+func Emit(t Tracer, phase Phase, detail string, start time.Time, err error) {
+	if t == nil {
+		return
+	}
+	t.Span(Span{
+		Phase:    phase,
+		Detail:   detail,
+		Start:    start,
+		Duration: time.Since(start),
+		Err:      err,
+	})
+}
+
+// WithRequest returns a shallow copy of req whose connection level events
+// (DNS, connect, TLS and time to first byte) are reported to t.
+// If t is nil req is returned unchanged.
+func WithRequest(t Tracer, req *http.Request) *http.Request {
+	if t == nil {
+		return req
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), ClientTrace(t)))
+}
+
+// ClientTrace returns an *httptrace.ClientTrace which reports to t.
+func ClientTrace(t Tracer) *httptrace.ClientTrace {
+	var (
+		mu       sync.Mutex
+		dnsStart time.Time
+		dnsHost  string
+		tlsStart time.Time
+		wrote    time.Time
+		connects = make(map[string]time.Time)
+	)
+	return &httptrace.ClientTrace{
+		DNSStart: func(info httptrace.DNSStartInfo) {
+			mu.Lock()
+			dnsStart, dnsHost = time.Now(), info.Host
+			mu.Unlock()
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			mu.Lock()
+			start, host := dnsStart, dnsHost
+			mu.Unlock()
+			Emit(t, DNS, host, start, info.Err)
+		},
+		ConnectStart: func(network, addr string) {
+			mu.Lock()
+			connects[network+" "+addr] = time.Now()
+			mu.Unlock()
+		},
+		ConnectDone: func(network, addr string, err error) {
+			mu.Lock()
+			start := connects[network+" "+addr]
+			mu.Unlock()
+			Emit(t, Connect, addr, start, err)
+		},
+		TLSHandshakeStart: func() {
+			mu.Lock()
+			tlsStart = time.Now()
+			mu.Unlock()
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			mu.Lock()
+			start := tlsStart
+			mu.Unlock()
+			Emit(t, TLS, state.ServerName, start, err)
+		},
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			mu.Lock()
+			wrote = time.Now()
+			mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			mu.Lock()
+			start := wrote
+			mu.Unlock()
+			if !start.IsZero() {
+				Emit(t, Wait, "", start, nil)
+			}
+		},
+	}
+}
+
+// FollowRedirects returns a shallow copy of client which reports each
+// redirect hop to t. The client's own CheckRedirect policy (or net/http's
+// default of 10 redirects) still applies.
+func FollowRedirects(t Tracer, client *http.Client) *http.Client {
+	if t == nil || client == nil {
+		return client
+	}
+	cp := *client
+	check := client.CheckRedirect
+	cp.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		var err error
+		if check != nil {
+			err = check(req, via)
+		} else if len(via) >= 10 {
+			err = fmt.Errorf("stopped after 10 redirects")
+		}
+		prev := via[len(via)-1]
+		start, _ := prev.Context().Value(hopStartKey{}).(time.Time)
+		if start.IsZero() {
+			start = time.Now()
+		}
+		Emit(t, Redirect, fmt.Sprintf("%s -> %s", prev.URL, req.URL), start, err)
+
+		// Mark when this hop started so the next redirect can be timed.
+		// Connection events are already reported as the context (and
+		// its ClientTrace) is carried over from the original request.
+		*req = *MarkStart(req)
+		return err
+	}
+	return &cp
+}
+
+type hopStartKey struct{}
+
+// MarkStart records the start of a request so a following redirect can
+// be timed by a client from FollowRedirects.
+func MarkStart(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), hopStartKey{}, time.Now()))
+}
+
+// Recorder is a Tracer which keeps every Span it receives.
+type Recorder struct {
+	mu    sync.Mutex
+	spans []Span
+}
+
+func (r *Recorder) Span(s Span) {
+	r.mu.Lock()
+	r.spans = append(r.spans, s)
+	r.mu.Unlock()
+}
+
+// Spans returns every recorded Span sorted by start time.
+func (r *Recorder) Spans() []Span {
+	r.mu.Lock()
+	out := make([]Span, len(r.spans))
+	copy(out, r.spans)
+	r.mu.Unlock()
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Start.Before(out[j].Start)
+	})
+	return out
+}
+
+// Waterfall writes the recorded spans to w as a text chart where each
+// bar is positioned and sized relative to the whole lookup.
+func (r *Recorder) Waterfall(w io.Writer) error {
+	const width = 40
+
+	spans := r.Spans()
+	if len(spans) == 0 {
+		return nil
+	}
+	first, last := spans[0].Start, spans[0].Start
+	for i := range spans {
+		if end := spans[i].Start.Add(spans[i].Duration); end.After(last) {
+			last = end
+		}
+	}
+	total := last.Sub(first)
+	if total <= 0 {
+		total = 1
+	}
+
+	for _, s := range spans {
+		offset := int(int64(width) * int64(s.Start.Sub(first)) / int64(total))
+		size := int(int64(width) * int64(s.Duration) / int64(total))
+		if size < 1 {
+			size = 1
+		}
+		if offset+size > width {
+			offset = width - size
+		}
+		bar := strings.Repeat(" ", offset) + strings.Repeat("=", size) + strings.Repeat(" ", width-offset-size)
+
+		line := fmt.Sprintf("%8s %8s |%s| %-15s %s", round(s.Start.Sub(first)), round(s.Duration), bar, s.Phase, s.Detail)
+		if s.Err != nil {
+			line += fmt.Sprintf(" (error: %v)", s.Err)
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "total: %v\n", round(total))
+	return err
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
@@ -0,0 +1,69 @@
+package trace
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrace__redirects(t *testing.T) {
+	var svc *httptest.Server
+	svc = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/a":
+			http.Redirect(w, r, svc.URL+"/b", http.StatusFound)
+		case "/b":
+			http.Redirect(w, r, svc.URL+"/c", http.StatusFound)
+		default:
+			w.Write([]byte("{}"))
+		}
+	}))
+	defer svc.Close()
+
+	rec := &Recorder{}
+	req, _ := http.NewRequest("GET", svc.URL+"/a", nil)
+	resp, err := FollowRedirects(rec, svc.Client()).Do(MarkStart(WithRequest(rec, req)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	phases := make(map[Phase]int)
+	for _, s := range rec.Spans() {
+		phases[s.Phase]++
+	}
+	if phases[Redirect] != 2 {
+		t.Errorf("got %d redirects", phases[Redirect])
+	}
+	if phases[Connect] == 0 || phases[Wait] != 3 {
+		t.Errorf("unexpected spans: %v", rec.Spans())
+	}
+}
+
+func TestRecorder__waterfall(t *testing.T) {
+	start := time.Now()
+	rec := &Recorder{}
+	rec.Span(Span{Phase: Decode, Start: start.Add(90 * time.Millisecond), Duration: 10 * time.Millisecond})
+	rec.Span(Span{Phase: BootstrapFetch, Detail: "https://data.iana.org/rdap/dns.json", Start: start, Duration: 50 * time.Millisecond})
+
+	var buf bytes.Buffer
+	if err := rec.Waterfall(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines:\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], "bootstrap fetch") || !strings.Contains(lines[0], "|"+strings.Repeat("=", 20)+" ") {
+		t.Errorf("lines[0]=%q", lines[0])
+	}
+	if !strings.Contains(lines[1], "json decode") || !strings.Contains(lines[1], "====|") {
+		t.Errorf("lines[1]=%q", lines[1])
+	}
+	if lines[2] != "total: 100ms" {
+		t.Errorf("lines[2]=%q", lines[2])
+	}
+}